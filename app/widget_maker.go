@@ -9,15 +9,18 @@ import (
 	"github.com/wtfutil/wtf/modules/bamboohr"
 	"github.com/wtfutil/wtf/modules/bargraph"
 	"github.com/wtfutil/wtf/modules/buildkite"
+	"github.com/wtfutil/wtf/modules/calendar"
 	cdsfavorites "github.com/wtfutil/wtf/modules/cds/favorites"
 	cdsqueue "github.com/wtfutil/wtf/modules/cds/queue"
 	cdsstatus "github.com/wtfutil/wtf/modules/cds/status"
+	"github.com/wtfutil/wtf/modules/ci"
 	"github.com/wtfutil/wtf/modules/circleci"
 	"github.com/wtfutil/wtf/modules/clocks"
 	"github.com/wtfutil/wtf/modules/cmdrunner"
 	"github.com/wtfutil/wtf/modules/covid"
 	"github.com/wtfutil/wtf/modules/cryptocurrency/bittrex"
 	"github.com/wtfutil/wtf/modules/cryptocurrency/blockfolio"
+	"github.com/wtfutil/wtf/modules/cryptocurrency/crypto"
 	"github.com/wtfutil/wtf/modules/cryptocurrency/cryptolive"
 	"github.com/wtfutil/wtf/modules/cryptocurrency/mempool"
 	"github.com/wtfutil/wtf/modules/datadog"
@@ -31,6 +34,7 @@ import (
 	"github.com/wtfutil/wtf/modules/gerrit"
 	"github.com/wtfutil/wtf/modules/git"
 	"github.com/wtfutil/wtf/modules/github"
+	"github.com/wtfutil/wtf/modules/githubactions"
 	"github.com/wtfutil/wtf/modules/gitlab"
 	"github.com/wtfutil/wtf/modules/gitlabtodo"
 	"github.com/wtfutil/wtf/modules/gitter"
@@ -49,6 +53,7 @@ import (
 	"github.com/wtfutil/wtf/modules/logger"
 	"github.com/wtfutil/wtf/modules/lunarphase"
 	"github.com/wtfutil/wtf/modules/mercurial"
+	"github.com/wtfutil/wtf/modules/mpris"
 	"github.com/wtfutil/wtf/modules/nbascore"
 	"github.com/wtfutil/wtf/modules/newrelic"
 	"github.com/wtfutil/wtf/modules/nextbus"
@@ -141,6 +146,9 @@ func MakeWidget(
 	case "buildkite":
 		settings := buildkite.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = buildkite.NewWidget(tviewApp, redrawChan, pages, settings)
+	case "calendar":
+		settings := calendar.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = calendar.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "cdsFavorites":
 		settings := cdsfavorites.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = cdsfavorites.NewWidget(tviewApp, redrawChan, pages, settings)
@@ -150,6 +158,9 @@ func MakeWidget(
 	case "cdsStatus":
 		settings := cdsstatus.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = cdsstatus.NewWidget(tviewApp, redrawChan, pages, settings)
+	case "ci":
+		settings := ci.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = ci.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "circleci":
 		settings := circleci.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = circleci.NewWidget(tviewApp, redrawChan, settings)
@@ -162,6 +173,9 @@ func MakeWidget(
 	case "cmdrunner":
 		settings := cmdrunner.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = cmdrunner.NewWidget(tviewApp, redrawChan, settings)
+	case "crypto":
+		settings := crypto.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = crypto.NewWidget(tviewApp, redrawChan, settings)
 	case "cryptolive":
 		settings := cryptolive.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = cryptolive.NewWidget(tviewApp, redrawChan, settings)
@@ -188,7 +202,7 @@ func MakeWidget(
 		widget = football.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "gcal":
 		settings := gcal.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gcal.NewWidget(tviewApp, redrawChan, settings)
+		widget = gcal.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "gerrit":
 		settings := gerrit.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = gerrit.NewWidget(tviewApp, redrawChan, pages, settings)
@@ -198,6 +212,9 @@ func MakeWidget(
 	case "github":
 		settings := github.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = github.NewWidget(tviewApp, redrawChan, pages, settings)
+	case "githubactions":
+		settings := githubactions.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = githubactions.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "gitlab":
 		settings := gitlab.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = gitlab.NewWidget(tviewApp, redrawChan, pages, settings)
@@ -239,7 +256,7 @@ func MakeWidget(
 		widget = jira.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "kubernetes":
 		settings := kubernetes.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = kubernetes.NewWidget(tviewApp, redrawChan, settings)
+		widget = kubernetes.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "krisinformation":
 		settings := krisinformation.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = krisinformation.NewWidget(tviewApp, redrawChan, settings)
@@ -255,6 +272,9 @@ func MakeWidget(
 	case "mempool":
 		settings := mempool.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = mempool.NewWidget(tviewApp, redrawChan, pages, settings)
+	case "mpris":
+		settings := mpris.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = mpris.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "nbascore":
 		settings := nbascore.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = nbascore.NewWidget(tviewApp, redrawChan, pages, settings)
@@ -266,7 +286,7 @@ func MakeWidget(
 		widget = nextbus.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "opsgenie":
 		settings := opsgenie.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = opsgenie.NewWidget(tviewApp, redrawChan, settings)
+		widget = opsgenie.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "pagerduty":
 		settings := pagerduty.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = pagerduty.NewWidget(tviewApp, redrawChan, settings)
@@ -287,7 +307,7 @@ func MakeWidget(
 		widget = pocket.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "resourceusage":
 		settings := resourceusage.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = resourceusage.NewWidget(tviewApp, redrawChan, settings)
+		widget = resourceusage.NewWidget(tviewApp, redrawChan, pages, settings)
 	case "rollbar":
 		settings := rollbar.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = rollbar.NewWidget(tviewApp, redrawChan, pages, settings)
@@ -2,6 +2,7 @@ package checklist
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -11,6 +12,8 @@ type ChecklistItem struct {
 	Checked       bool
 	CheckedIcon   string `yaml:"-"`
 	Date          *time.Time
+	Priority      int    `yaml:",omitempty"`
+	Recurrence    string `yaml:",omitempty"`
 	Tags          []string
 	Text          string
 	UncheckedIcon string `yaml:"-"`
@@ -40,16 +43,32 @@ func (item *ChecklistItem) CheckMark() string {
 	return item.UncheckedIcon
 }
 
-// EditText returns the content of the edit todo form, so includes formatted date and tags
+// EditText returns the content of the edit todo form, so includes formatted date, priority,
+// recurrence and tags
 func (item *ChecklistItem) EditText() string {
 	datePrefix := ""
 	if item.Date != nil {
 		datePrefix = fmt.Sprintf("%d-%02d-%02d", item.Date.Year(), item.Date.Month(), item.Date.Day()) + " "
 	}
 
+	priorityPrefix := ""
+	if item.Priority > 0 {
+		priorityPrefix = item.PriorityString() + " "
+	}
+
+	recurrencePrefix := ""
+	if item.Recurrence != "" {
+		recurrencePrefix = "@" + item.Recurrence + " "
+	}
+
 	tagsPrefix := item.TagString()
 
-	return datePrefix + tagsPrefix + item.Text
+	return datePrefix + priorityPrefix + recurrencePrefix + tagsPrefix + item.Text
+}
+
+// PriorityString returns the marker for the item's priority, "!" or "!!"
+func (item *ChecklistItem) PriorityString() string {
+	return strings.Repeat("!", item.Priority)
 }
 
 func (item *ChecklistItem) TagString() string {
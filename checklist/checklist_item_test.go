@@ -2,6 +2,7 @@ package checklist
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +37,14 @@ func Test_Toggle(t *testing.T) {
 	item.Toggle()
 	assert.Equal(t, false, item.Checked)
 }
+
+func Test_EditText(t *testing.T) {
+	date := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	item := NewChecklistItem(false, &date, []string{"home"}, "water plants", "", "")
+	assert.Equal(t, "2024-03-09 #home water plants", item.EditText())
+
+	item.Priority = 2
+	item.Recurrence = "every 3 days"
+	assert.Equal(t, "2024-03-09 !! @every 3 days #home water plants", item.EditText())
+}
@@ -1,24 +1,98 @@
 package azuredevops
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	azr "github.com/microsoft/azure-devops-go-api/azuredevops"
 	azrBuild "github.com/microsoft/azure-devops-go-api/azuredevops/build"
 	"github.com/pkg/errors"
 )
 
-func (widget *Widget) getBuildStats() string {
-	projName := widget.settings.projectName
+// Client fetches and queues the builds of an Azure DevOps project
+type Client struct {
+	cli         azrBuild.Client
+	connection  *azr.Connection
+	ctx         context.Context
+	projectName string
+}
+
+// NewClient creates a client for the organization and project in the settings
+func NewClient(settings *Settings) *Client {
+	client := Client{
+		connection:  azr.NewPatConnection(settings.orgURL, settings.apiToken),
+		ctx:         context.Background(),
+		projectName: settings.projectName,
+	}
+
+	return &client
+}
+
+// Builds returns the project's top most recent builds, whatever their status
+func (client *Client) Builds(top int) ([]azrBuild.Build, error) {
+	cli, err := client.buildClient()
+	if err != nil {
+		return nil, err
+	}
+
 	statusFilter := azrBuild.BuildStatusValues.All
-	top := widget.settings.maxRows
-	builds, err := widget.cli.GetBuilds(widget.ctx, azrBuild.GetBuildsArgs{Project: &projName, StatusFilter: &statusFilter, Top: &top})
+	builds, err := cli.GetBuilds(client.ctx, azrBuild.GetBuildsArgs{Project: &client.projectName, StatusFilter: &statusFilter, Top: &top})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get builds")
+	}
+
+	return builds.Value, nil
+}
+
+// QueueBuild queues a new build of a build's definition for the same branch
+func (client *Client) QueueBuild(build *azrBuild.Build) error {
+	cli, err := client.buildClient()
+	if err != nil {
+		return err
+	}
+
+	if build.Definition == nil {
+		return errors.New("build has no definition to queue")
+	}
+
+	_, err = cli.QueueBuild(client.ctx, azrBuild.QueueBuildArgs{
+		Build: &azrBuild.Build{
+			Definition:   &azrBuild.DefinitionReference{Id: build.Definition.Id},
+			SourceBranch: build.SourceBranch,
+		},
+		Project: &client.projectName,
+	})
+
+	return err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// buildClient creates the build client the first time it's needed, as doing so
+// requires a round trip to Azure DevOps
+func (client *Client) buildClient() (azrBuild.Client, error) {
+	if client.cli != nil {
+		return client.cli, nil
+	}
+
+	cli, err := azrBuild.NewClient(client.ctx, client.connection)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create client")
+	}
+
+	client.cli = cli
+	return cli, nil
+}
+
+func (widget *Widget) getBuildStats() string {
+	builds, err := widget.client.Builds(widget.settings.maxRows)
 	if err != nil {
-		return errors.Wrap(err, "could not get builds").Error()
+		return err.Error()
 	}
 
 	result := ""
-	for _, build := range builds.Value {
+	for _, build := range builds {
 		num := *build.BuildNumber
 		branch := *build.SourceBranch
 		reason := *build.Reason
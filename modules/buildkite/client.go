@@ -3,6 +3,9 @@ package buildkite
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/wtfutil/wtf/utils"
 )
@@ -12,17 +15,68 @@ type Pipeline struct {
 }
 
 type Build struct {
-	State    string   `json:"state"`
-	Pipeline Pipeline `json:"pipeline"`
-	Branch   string   `json:"branch"`
-	WebUrl   string   `json:"web_url"`
+	Number     int       `json:"number"`
+	State      string    `json:"state"`
+	Pipeline   Pipeline  `json:"pipeline"`
+	Branch     string    `json:"branch"`
+	WebUrl     string    `json:"web_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
 }
 
+// Client fetches the builds of an organization's configured pipelines
+type Client struct {
+	apiKey    string
+	orgSlug   string
+	pipelines []PipelineSettings
+}
+
+// NewClient creates a client for the organization and pipelines in the settings
+func NewClient(settings *Settings) *Client {
+	client := Client{
+		apiKey:    settings.apiKey,
+		orgSlug:   settings.orgSlug,
+		pipelines: settings.pipelines,
+	}
+
+	return &client
+}
+
+// RecentBuilds returns up to perPage of the most recent builds of each configured
+// pipeline, on the pipeline's branches. A perPage of zero uses the API's default
+func (client *Client) RecentBuilds(perPage int) ([]Build, error) {
+	builds := []Build{}
+
+	for _, pipeline := range client.pipelines {
+		buildsForPipeline, err := client.pipelineBuilds(pipeline, perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, buildsForPipeline...)
+	}
+
+	return builds, nil
+}
+
+// Rebuild rebuilds a build with the same commit, branch and environment
+func (client *Client) Rebuild(build *Build) error {
+	path := fmt.Sprintf("organizations/%s/pipelines/%s/builds/%d/rebuild", client.orgSlug, build.Pipeline.Slug, build.Number)
+	return client.buildkiteRequest("PUT", path, url.Values{}, nil)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+var (
+	buildkiteAPIURL = &url.URL{Scheme: "https", Host: "api.buildkite.com", Path: "/v2/"}
+)
+
 func (widget *Widget) getBuilds() ([]Build, error) {
 	builds := []Build{}
 
 	for _, pipeline := range widget.settings.pipelines {
-		buildsForPipeline, err := widget.recentBuilds(pipeline)
+		buildsForPipeline, err := widget.client.pipelineBuilds(pipeline, 0)
 
 		if err != nil {
 			return nil, err
@@ -35,19 +89,33 @@ func (widget *Widget) getBuilds() ([]Build, error) {
 	return builds, nil
 }
 
-func (widget *Widget) recentBuilds(pipeline PipelineSettings) ([]Build, error) {
-	url := fmt.Sprintf(
-		"https://api.buildkite.com/v2/organizations/%s/pipelines/%s/builds%s",
-		widget.settings.orgSlug,
-		pipeline.slug,
-		branchesQuery(pipeline.branches),
-	)
+func (client *Client) pipelineBuilds(pipeline PipelineSettings, perPage int) ([]Build, error) {
+	path := fmt.Sprintf("organizations/%s/pipelines/%s/builds", client.orgSlug, pipeline.slug)
 
-	req, err := http.NewRequest("GET", url, http.NoBody)
+	params := branchesQuery(pipeline.branches)
+	if perPage > 0 {
+		params.Set("per_page", strconv.Itoa(perPage))
+	}
+
+	builds := []Build{}
+	err := client.buildkiteRequest("GET", path, params, &builds)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", widget.settings.apiKey))
+
+	return builds, nil
+}
+
+// buildkiteRequest sends a request to the API and decodes the JSON response into
+// result, unless result is nil
+func (client *Client) buildkiteRequest(method, path string, params url.Values, result interface{}) error {
+	requestURL := buildkiteAPIURL.ResolveReference(&url.URL{Path: path, RawQuery: params.Encode()})
+
+	req, err := http.NewRequest(method, requestURL.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", client.apiKey))
 
 	httpClient := &http.Client{Transport: &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -55,38 +123,34 @@ func (widget *Widget) recentBuilds(pipeline PipelineSettings) ([]Build, error) {
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, fmt.Errorf("%s", resp.Status)
+		return fmt.Errorf("%s", resp.Status)
 	}
 
-	builds := []Build{}
-	err = utils.ParseJSON(&builds, resp.Body)
-	if err != nil {
-		return nil, err
+	if result == nil {
+		return nil
 	}
 
-	return builds, nil
+	return utils.ParseJSON(result, resp.Body)
 }
 
-func branchesQuery(branches []string) string {
-	if len(branches) == 0 {
-		return ""
-	}
+func branchesQuery(branches []string) url.Values {
+	params := url.Values{}
 
 	if len(branches) == 1 {
-		return fmt.Sprintf("?branch=%s", branches[0])
+		params.Set("branch", branches[0])
+		return params
 	}
 
-	queryString := fmt.Sprintf("?branch[]=%s", branches[0])
-	for _, branch := range branches[1:] {
-		queryString += fmt.Sprintf("&branch[]=%s", branch)
+	for _, branch := range branches {
+		params.Add("branch[]", branch)
 	}
 
-	return queryString
+	return params
 }
 
 func mostRecentBuildForBranches(builds []Build, branches []string) []Build {
@@ -0,0 +1,55 @@
+package buildkite
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client(t *testing.T) {
+	var rebuilt string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.Method {
+		case "GET":
+			assert.Equal(t, "/organizations/wtfutil/pipelines/wtf/builds", r.URL.Path)
+			assert.Equal(t, []string{"main", "dev"}, r.URL.Query()["branch[]"])
+			assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+			_, _ = w.Write([]byte(`[
+				{"number": 7, "state": "passed", "branch": "main", "pipeline": {"slug": "wtf"},
+				 "started_at": "2024-01-01T10:00:00Z", "finished_at": "2024-01-01T10:02:00Z"},
+				{"number": 6, "state": "scheduled", "branch": "dev", "pipeline": {"slug": "wtf"}, "started_at": null}
+			]`))
+		case "PUT":
+			rebuilt = r.URL.Path
+		}
+	}))
+	defer server.Close()
+
+	apiURL := buildkiteAPIURL
+	buildkiteAPIURL, _ = url.Parse(server.URL + "/")
+	defer func() { buildkiteAPIURL = apiURL }()
+
+	client := NewClient(&Settings{
+		apiKey:    "secret",
+		orgSlug:   "wtfutil",
+		pipelines: []PipelineSettings{{slug: "wtf", branches: []string{"main", "dev"}}},
+	})
+
+	builds, err := client.RecentBuilds(5)
+	require.NoError(t, err)
+	require.Len(t, builds, 2)
+
+	assert.Equal(t, 7, builds[0].Number)
+	assert.Equal(t, "2m0s", builds[0].FinishedAt.Sub(builds[0].StartedAt).String())
+	assert.True(t, builds[1].StartedAt.IsZero())
+
+	require.NoError(t, client.Rebuild(&builds[0]))
+	assert.Equal(t, "/organizations/wtfutil/pipelines/wtf/builds/7/rebuild", rebuilt)
+}
@@ -9,6 +9,7 @@ import (
 
 type Widget struct {
 	view.TextWidget
+	client   *Client
 	settings *Settings
 
 	builds []Build
@@ -18,6 +19,7 @@ type Widget struct {
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
 		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+		client:     NewClient(settings),
 		settings:   settings,
 	}
 
@@ -0,0 +1,194 @@
+// Package caldav reads and writes the iCalendar objects of CalDAV calendars. It's
+// shared by the calendar module, which reads their events, and the CalDAV backend of
+// the todo_plus module, which manages their to-dos
+package caldav
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	displayNamePropfind = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:displayname/></d:prop></d:propfind>`
+
+	eventQuery = `<?xml version="1.0" encoding="utf-8"?>
+<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <c:filter>
+    <c:comp-filter name="VCALENDAR">
+      <c:comp-filter name="VEVENT"><c:time-range start="%s" end="%s"/></c:comp-filter>
+    </c:comp-filter>
+  </c:filter>
+</c:calendar-query>`
+
+	todoQuery = `<?xml version="1.0" encoding="utf-8"?>
+<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VTODO"/></c:comp-filter></c:filter>
+</c:calendar-query>`
+)
+
+// Client makes requests to a CalDAV server, or any other HTTP server that serves
+// iCalendar objects. Requests are authenticated with basic auth if there's a username
+type Client struct {
+	httpClient *http.Client
+	password   string
+	username   string
+}
+
+// Object is a calendar object resource: the iCalendar data stored at a URL
+type Object struct {
+	Data string
+	ETag string
+	Href string
+}
+
+// multistatus is a WebDAV multi-status response body
+type multistatus struct {
+	Responses []struct {
+		Href     string `xml:"href"`
+		Propstat []struct {
+			Prop struct {
+				CalendarData string `xml:"calendar-data"`
+				DisplayName  string `xml:"displayname"`
+				ETag         string `xml:"getetag"`
+			} `xml:"prop"`
+		} `xml:"propstat"`
+	} `xml:"response"`
+}
+
+// NewClient creates a client that makes its requests with httpClient
+func NewClient(httpClient *http.Client, username, password string) *Client {
+	return &Client{
+		httpClient: httpClient,
+		password:   password,
+		username:   username,
+	}
+}
+
+// NewHTTPClient returns an HTTP client for CalDAV servers, which are often self-hosted
+// and may have certificates that can't be verified
+func NewHTTPClient(verifyServerCertificate bool) *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: !verifyServerCertificate,
+		},
+	}}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// DisplayName returns the display name of a calendar collection, or an empty string
+// if it has none
+func (client *Client) DisplayName(collection string) (string, error) {
+	status, err := client.multistatus("PROPFIND", collection, "0", displayNamePropfind)
+	if err != nil {
+		return "", err
+	}
+
+	for _, response := range status.Responses {
+		for _, propstat := range response.Propstat {
+			if name := strings.TrimSpace(propstat.Prop.DisplayName); name != "" {
+				return name, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// Events returns the objects of a calendar collection with events that take place
+// between start and end
+func (client *Client) Events(collection string, start, end time.Time) ([]Object, error) {
+	query := fmt.Sprintf(eventQuery, start.UTC().Format(utcFmt), end.UTC().Format(utcFmt))
+	return client.objects(collection, query)
+}
+
+// Todos returns the objects of a calendar collection with to-dos
+func (client *Client) Todos(collection string) ([]Object, error) {
+	return client.objects(collection, todoQuery)
+}
+
+// Request makes a request and returns the response body and headers. Responses
+// without a 2xx status are errors
+func (client *Client) Request(method, target, body string, headers map[string]string) ([]byte, http.Header, error) {
+	req, err := http.NewRequest(method, target, strings.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if client.username != "" {
+		req.SetBasicAuth(client.username, client.password)
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("%s %s: %s", method, target, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	return data, resp.Header, err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// objects returns the calendar objects that match a calendar query
+func (client *Client) objects(collection, query string) ([]Object, error) {
+	status, err := client.multistatus("REPORT", collection, "1", query)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []Object{}
+	for _, response := range status.Responses {
+		for _, propstat := range response.Propstat {
+			if propstat.Prop.CalendarData == "" {
+				continue
+			}
+
+			objects = append(objects, Object{
+				Data: propstat.Prop.CalendarData,
+				ETag: propstat.Prop.ETag,
+				Href: response.Href,
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// multistatus makes a WebDAV request that responds with a multi-status body
+func (client *Client) multistatus(method, target, depth, body string) (*multistatus, error) {
+	headers := map[string]string{
+		"Content-Type": "application/xml; charset=utf-8",
+		"Depth":        depth,
+	}
+
+	data, _, err := client.Request(method, target, body, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &multistatus{}
+	if err := xml.Unmarshal(data, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,64 @@
+package caldav
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+const (
+	dateFmt  = "20060102"
+	localFmt = "20060102T150405"
+	utcFmt   = "20060102T150405Z"
+)
+
+// ParseTime parses an iCalendar date or date-time, and returns whether it's a date.
+// Dates and times without a time zone, or with one that isn't known, are in loc
+func ParseTime(value, tzid string, loc *time.Location) (time.Time, bool, error) {
+	value = strings.TrimSpace(value)
+
+	if tzid != "" {
+		if zone, err := time.LoadLocation(strings.Trim(tzid, `"`)); err == nil {
+			loc = zone
+		}
+	}
+
+	switch len(value) {
+	case len(dateFmt):
+		t, err := time.ParseInLocation(dateFmt, value, loc)
+		return t, true, err
+	case len(utcFmt):
+		t, err := time.Parse(utcFmt, value)
+		return t, false, err
+	default:
+		t, err := time.ParseInLocation(localFmt, value, loc)
+		return t, false, err
+	}
+}
+
+// PropertyTime returns the time of a date or date-time property, and whether it's a
+// date
+func PropertyTime(prop *ics.IANAProperty, loc *time.Location) (time.Time, bool, error) {
+	if prop == nil {
+		return time.Time{}, false, fmt.Errorf("missing date")
+	}
+
+	return ParseTime(prop.Value, PropertyParam(prop, ics.ParameterTzid), loc)
+}
+
+// PropertyParam returns the first value of a parameter of a property
+func PropertyParam(prop *ics.IANAProperty, param ics.Parameter) string {
+	if values := prop.ICalParameters[string(param)]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// Serialize returns a calendar as an iCalendar object, with the CRLF line endings
+// that servers expect
+func Serialize(cal *ics.Calendar) string {
+	return cal.Serialize(ics.WithNewLine("\r\n"))
+}
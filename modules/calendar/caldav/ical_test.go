@@ -0,0 +1,35 @@
+package caldav
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	date, allDay, err := ParseTime("20240312", "", berlin)
+	require.NoError(t, err)
+	assert.True(t, allDay)
+	assert.Equal(t, time.Date(2024, 3, 12, 0, 0, 0, 0, berlin), date)
+
+	utc, allDay, err := ParseTime("20240312T140000Z", "Europe/Berlin", time.Local)
+	require.NoError(t, err)
+	assert.False(t, allDay)
+	assert.True(t, utc.Equal(time.Date(2024, 3, 12, 14, 0, 0, 0, time.UTC)))
+
+	zoned, _, err := ParseTime("20240312T150000", "Europe/Berlin", time.UTC)
+	require.NoError(t, err)
+	assert.True(t, zoned.Equal(time.Date(2024, 3, 12, 14, 0, 0, 0, time.UTC)))
+
+	unknown, _, err := ParseTime("20240312T150000", "Pacific Standard Time", berlin)
+	require.NoError(t, err)
+	assert.Equal(t, berlin, unknown.Location())
+
+	_, _, err = ParseTime("soon", "", time.UTC)
+	assert.Error(t, err)
+}
@@ -0,0 +1,274 @@
+package calendar
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/wtfutil/wtf/modules/calendar/caldav"
+	gcalendar "google.golang.org/api/calendar/v3"
+)
+
+// icalDurationRegex matches an iCalendar duration, e.g. PT1H30M, P1D or -P1W
+var icalDurationRegex = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// responseStatuses maps the PARTSTAT of attendees to the response statuses of the
+// gcal module
+var responseStatuses = map[string]string{
+	"ACCEPTED":     "accepted",
+	"DECLINED":     "declined",
+	"NEEDS-ACTION": "needsAction",
+	"TENTATIVE":    "tentative",
+}
+
+// vevent is an event of an iCalendar object along with its start, as read in the
+// local time zone
+type vevent struct {
+	*ics.VEvent
+
+	allDay bool
+	start  time.Time
+}
+
+// expandEvents returns the events of iCalendar objects that take place between from
+// and to, ordered by when they start. Recurring events are expanded into their
+// occurrences. Times without a known time zone are in loc. Objects that can't be
+// parsed are skipped and their errors returned, as are those of recurrence rules that
+// can't be expanded
+func expandEvents(objects []string, from, to time.Time, loc *time.Location) ([]*gcalendar.Event, []error) {
+	masters := []*vevent{}
+	overrides := map[string]map[int64]bool{}
+	events := []*gcalendar.Event{}
+	errs := []error{}
+
+	for _, object := range objects {
+		cal, err := ics.ParseCalendar(strings.NewReader(object))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, event := range cal.Events() {
+			start, allDay, err := caldav.PropertyTime(event.GetProperty(ics.ComponentPropertyDtStart), loc)
+			if err != nil {
+				continue
+			}
+
+			parsed := &vevent{VEvent: event, allDay: allDay, start: start}
+
+			// A changed occurrence of a recurring event replaces the occurrence that it
+			// identifies, and is otherwise an event in its own right
+			if prop := event.GetProperty(ics.ComponentPropertyRecurrenceId); prop != nil {
+				recurrenceID, _, err := caldav.PropertyTime(prop, loc)
+				if err != nil {
+					continue
+				}
+
+				uid := propertyValue(event, ics.ComponentPropertyUniqueId)
+				if overrides[uid] == nil {
+					overrides[uid] = map[int64]bool{}
+				}
+				overrides[uid][recurrenceID.Unix()] = true
+
+				if occurrence := parsed.occurrence(start, from, to, loc); occurrence != nil {
+					events = append(events, occurrence)
+				}
+				continue
+			}
+
+			masters = append(masters, parsed)
+		}
+	}
+
+	for _, master := range masters {
+		starts, err := master.starts(from, to, loc)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		replaced := overrides[propertyValue(master.VEvent, ics.ComponentPropertyUniqueId)]
+
+		for _, start := range starts {
+			if replaced[start.Unix()] {
+				continue
+			}
+
+			if occurrence := master.occurrence(start, from, to, loc); occurrence != nil {
+				events = append(events, occurrence)
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventStart(events[i]).Before(eventStart(events[j]))
+	})
+
+	return events, errs
+}
+
+// starts returns the start of every occurrence of the event that could take place
+// between from and to, leaving out those excluded by EXDATE. An event with a rule
+// that can't be expanded only takes place when it starts
+func (event *vevent) starts(from, to time.Time, loc *time.Location) ([]time.Time, error) {
+	starts := []time.Time{event.start}
+
+	var ruleErr error
+	if prop := event.GetProperty(ics.ComponentPropertyRrule); prop != nil {
+		rule, err := parseRRule(prop.Value, event.start.Location())
+		if err == nil {
+			// Occurrences that started before from can still be taking place
+			starts = rule.between(event.start, from.Add(-event.duration(loc)), to)
+		} else {
+			ruleErr = fmt.Errorf("%s: %w", propertyValue(event.VEvent, ics.ComponentPropertySummary), err)
+		}
+	}
+
+	for _, prop := range event.GetProperties(ics.ComponentPropertyRdate) {
+		starts = append(starts, propertyTimes(prop, event.start.Location())...)
+	}
+
+	excluded := map[int64]bool{}
+	for _, prop := range event.GetProperties(ics.ComponentPropertyExdate) {
+		for _, exdate := range propertyTimes(prop, event.start.Location()) {
+			excluded[exdate.Unix()] = true
+		}
+	}
+
+	kept := []time.Time{}
+	for _, start := range starts {
+		if !excluded[start.Unix()] {
+			kept = append(kept, start)
+		}
+	}
+
+	return kept, ruleErr
+}
+
+// duration returns how long the event lasts. All-day events without an end last a
+// day
+func (event *vevent) duration(loc *time.Location) time.Duration {
+	if end, _, err := caldav.PropertyTime(event.GetProperty(ics.ComponentPropertyDtEnd), loc); err == nil && end.After(event.start) {
+		return end.Sub(event.start)
+	}
+
+	if prop := event.GetProperty(ics.ComponentPropertyDuration); prop != nil {
+		if duration, err := parseICalDuration(prop.Value); err == nil && duration > 0 {
+			return duration
+		}
+	}
+
+	if event.allDay {
+		return 24 * time.Hour
+	}
+
+	return 0
+}
+
+// occurrence returns the occurrence of the event that starts at start, or nil if it
+// doesn't take place between from and to or has been cancelled
+func (event *vevent) occurrence(start, from, to time.Time, loc *time.Location) *gcalendar.Event {
+	if strings.EqualFold(propertyValue(event.VEvent, ics.ComponentPropertyStatus), "CANCELLED") {
+		return nil
+	}
+
+	duration := event.duration(loc)
+
+	var end time.Time
+	if event.allDay {
+		// Whole days, so that a day that's an hour shorter or longer doesn't shift the end
+		end = start.AddDate(0, 0, int((duration+12*time.Hour)/(24*time.Hour)))
+	} else {
+		end = start.Add(duration)
+	}
+
+	if !start.Before(to) || (start.Before(from) && !end.After(from)) {
+		return nil
+	}
+
+	calEvent := &gcalendar.Event{
+		Description: propertyValue(event.VEvent, ics.ComponentPropertyDescription),
+		HangoutLink: propertyValue(event.VEvent, ics.ComponentProperty("X-GOOGLE-CONFERENCE")),
+		Id:          fmt.Sprintf("%s-%d", propertyValue(event.VEvent, ics.ComponentPropertyUniqueId), start.Unix()),
+		Location:    propertyValue(event.VEvent, ics.ComponentPropertyLocation),
+		Status:      "confirmed",
+		Summary:     propertyValue(event.VEvent, ics.ComponentPropertySummary),
+	}
+
+	if event.allDay {
+		calEvent.Start = &gcalendar.EventDateTime{Date: start.Format("2006-01-02")}
+		calEvent.End = &gcalendar.EventDateTime{Date: end.Format("2006-01-02")}
+	} else {
+		calEvent.Start = &gcalendar.EventDateTime{DateTime: start.In(loc).Format(time.RFC3339)}
+		calEvent.End = &gcalendar.EventDateTime{DateTime: end.In(loc).Format(time.RFC3339)}
+	}
+
+	for _, attendee := range event.Attendees() {
+		calEvent.Attendees = append(calEvent.Attendees, &gcalendar.EventAttendee{
+			Email:          attendee.Email(),
+			ResponseStatus: responseStatuses[strings.ToUpper(string(attendee.ParticipationStatus()))],
+		})
+	}
+
+	return calEvent
+}
+
+/* -------------------- Properties -------------------- */
+
+func propertyValue(event *ics.VEvent, property ics.ComponentProperty) string {
+	if prop := event.GetProperty(property); prop != nil {
+		return prop.Value
+	}
+
+	return ""
+}
+
+// propertyTimes returns the times of a property that lists them, such as EXDATE
+func propertyTimes(prop *ics.IANAProperty, loc *time.Location) []time.Time {
+	times := []time.Time{}
+
+	for _, value := range strings.Split(prop.Value, ",") {
+		t, _, err := caldav.ParseTime(value, caldav.PropertyParam(prop, ics.ParameterTzid), loc)
+		if err == nil {
+			times = append(times, t)
+		}
+	}
+
+	return times
+}
+
+// parseICalDuration parses an iCalendar duration, e.g. PT1H30M
+func parseICalDuration(value string) (time.Duration, error) {
+	match := icalDurationRegex.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration %s", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		n, _ := strconv.Atoi(match[i+2])
+		duration += time.Duration(n) * unit
+	}
+
+	if match[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
+
+// eventStart returns when an event starts
+func eventStart(event *gcalendar.Event) time.Time {
+	if event.Start.Date != "" {
+		start, _ := time.ParseInLocation("2006-01-02", event.Start.Date, time.Local)
+		return start
+	}
+
+	start, _ := time.Parse(time.RFC3339, event.Start.DateTime)
+	return start
+}
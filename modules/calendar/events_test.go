@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gcalendar "google.golang.org/api/calendar/v3"
+)
+
+func eventTime(dateTime *gcalendar.EventDateTime) string {
+	if dateTime.Date != "" {
+		return dateTime.Date
+	}
+	return dateTime.DateTime
+}
+
+func Test_expandEvents(t *testing.T) {
+	data, err := os.ReadFile("testdata/events.ics")
+	require.NoError(t, err)
+
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	events, errs := expandEvents([]string{string(data)}, from, to, time.UTC)
+	require.Empty(t, errs)
+
+	actual := []string{}
+	for _, event := range events {
+		actual = append(actual, eventTime(event.Start)+" "+eventTime(event.End)+" "+event.Summary)
+	}
+
+	assert.Equal(t, []string{
+		"2024-03-04T08:00:00Z 2024-03-04T08:15:00Z Standup",
+		"2024-03-08 2024-03-09 Monthly review",
+		"2024-03-08T09:00:00Z 2024-03-08T09:15:00Z Standup (moved)",
+		"2024-03-11T08:00:00Z 2024-03-11T08:15:00Z Standup",
+		"2024-03-11T13:00:00Z 2024-03-11T15:00:00Z Offsite",
+		"2024-03-12T13:00:00Z 2024-03-12T15:00:00Z Offsite",
+		"2024-03-13T08:00:00Z 2024-03-13T08:15:00Z Standup",
+		"2024-03-13T13:00:00Z 2024-03-13T15:00:00Z Offsite",
+		"2024-03-14T12:00:00Z 2024-03-14T13:00:00Z Lunch",
+		"2024-03-15T08:00:00Z 2024-03-15T08:15:00Z Standup",
+	}, actual)
+
+	standup := events[0]
+	assert.Equal(t, "Room 1, 2nd floor", standup.Location)
+	require.Len(t, standup.Attendees, 2)
+	assert.Equal(t, "alice@example.com", standup.Attendees[0].Email)
+	assert.Equal(t, "accepted", standup.Attendees[0].ResponseStatus)
+	assert.Equal(t, "declined", standup.Attendees[1].ResponseStatus)
+
+	// Every occurrence is an event of its own, so that they don't conflict with each other
+	assert.NotEqual(t, events[3].Id, events[6].Id)
+}
+
+func Test_expandEvents_inProgress(t *testing.T) {
+	data, err := os.ReadFile("testdata/events.ics")
+	require.NoError(t, err)
+
+	from := time.Date(2024, 3, 13, 14, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	events, errs := expandEvents([]string{string(data)}, from, to, time.UTC)
+	require.Empty(t, errs)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Offsite", events[0].Summary)
+}
+
+func Test_expandEvents_invalid(t *testing.T) {
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	valid := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a\r\nDTSTART:20240304T100000Z\r\nSUMMARY:Valid\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	unsupported := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:b\r\nDTSTART:20240101T120000Z\r\nRRULE:FREQ=YEARLY;BYWEEKNO=10\r\nSUMMARY:Unsupported\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	events, errs := expandEvents([]string{"not a calendar", valid, unsupported}, from, from.AddDate(0, 0, 1), time.UTC)
+	assert.Len(t, errs, 2)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Valid", events[0].Summary)
+	assert.Equal(t, events[0].Start, events[0].End)
+}
+
+func Test_parseICalDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT1H30M": 90 * time.Minute,
+		"P1D":     24 * time.Hour,
+		"P1W":     7 * 24 * time.Hour,
+		"P1DT12H": 36 * time.Hour,
+		"-PT15M":  -15 * time.Minute,
+		"PT45S":   45 * time.Second,
+	}
+
+	for value, expected := range tests {
+		duration, err := parseICalDuration(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, duration, value)
+	}
+
+	_, err := parseICalDuration("1 hour")
+	assert.Error(t, err)
+}
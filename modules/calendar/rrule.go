@@ -0,0 +1,395 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/modules/calendar/caldav"
+)
+
+// maxPeriods stops the expansion of a rule that never produces another occurrence,
+// such as the 30th of February
+const maxPeriods = 100000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// weekdayNum is a BYDAY value, e.g. MO, or -1FR for the last Friday of the month
+type weekdayNum struct {
+	n   int
+	day time.Weekday
+}
+
+// rrule is a recurrence rule (RRULE) of an event. See RFC 5545, section 3.3.10.
+// Only the parts that calendars commonly use are supported: the DAILY, WEEKLY,
+// MONTHLY and YEARLY frequencies with BYDAY, BYMONTHDAY, BYMONTH and BYSETPOS
+type rrule struct {
+	freq      string
+	interval  int
+	count     int
+	until     *time.Time
+	weekStart time.Weekday
+
+	byDay      []weekdayNum
+	byMonth    []time.Month
+	byMonthDay []int
+	bySetPos   []int
+}
+
+// parseRRule parses the value of an RRULE property. UNTIL dates without a time zone
+// are in loc
+func parseRRule(value string, loc *time.Location) (*rrule, error) {
+	rule := &rrule{interval: 1, weekStart: time.Monday}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(part)), "=")
+
+		var err error
+		switch key {
+		case "":
+			continue
+		case "FREQ":
+			rule.freq = val
+		case "INTERVAL":
+			rule.interval, err = strconv.Atoi(val)
+		case "COUNT":
+			rule.count, err = strconv.Atoi(val)
+		case "UNTIL":
+			var until time.Time
+			var allDay bool
+			until, allDay, err = caldav.ParseTime(val, "", loc)
+			if allDay {
+				// A date includes all of that day
+				until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+			rule.until = &until
+		case "WKST":
+			day, ok := weekdays[val]
+			if !ok {
+				err = fmt.Errorf("invalid weekday %s", val)
+			}
+			rule.weekStart = day
+		case "BYDAY":
+			rule.byDay, err = parseByDay(val)
+		case "BYMONTH":
+			var months []int
+			months, err = parseInts(val, 1, 12)
+			for _, month := range months {
+				rule.byMonth = append(rule.byMonth, time.Month(month))
+			}
+		case "BYMONTHDAY":
+			rule.byMonthDay, err = parseInts(val, -31, 31)
+		case "BYSETPOS":
+			rule.bySetPos, err = parseInts(val, -366, 366)
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %s", key)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid recurrence rule %s: %w", value, err)
+		}
+	}
+
+	switch rule.freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	default:
+		return nil, fmt.Errorf("unsupported recurrence frequency %q", rule.freq)
+	}
+
+	if rule.interval < 1 {
+		return nil, fmt.Errorf("invalid recurrence interval %d", rule.interval)
+	}
+
+	return rule, nil
+}
+
+func parseByDay(value string) ([]weekdayNum, error) {
+	days := []weekdayNum{}
+
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %s", item)
+		}
+
+		day, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %s", item)
+		}
+
+		n := 0
+		if ordinal := item[:len(item)-2]; ordinal != "" {
+			var err error
+			if n, err = strconv.Atoi(ordinal); err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid weekday %s", item)
+			}
+		}
+
+		days = append(days, weekdayNum{n: n, day: day})
+	}
+
+	return days, nil
+}
+
+func parseInts(value string, min, max int) ([]int, error) {
+	ints := []int{}
+
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < min || n > max {
+			return nil, fmt.Errorf("invalid value %s", item)
+		}
+
+		ints = append(ints, n)
+	}
+
+	return ints, nil
+}
+
+// between returns the occurrences of the rule that start at or after from and before
+// to, for an event that starts at dtstart. The start of the event is always its first
+// occurrence
+func (rule *rrule) between(dtstart, from, to time.Time) []time.Time {
+	occurrences := []time.Time{}
+	count := 0
+
+	// add records an occurrence and returns whether there can be more
+	add := func(t time.Time) bool {
+		if !t.Before(to) || (rule.until != nil && t.After(*rule.until)) {
+			return false
+		}
+
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+
+		count++
+		return rule.count == 0 || count < rule.count
+	}
+
+	if !add(dtstart) {
+		return occurrences
+	}
+
+	for i := 0; i < maxPeriods; i++ {
+		period := rule.period(dtstart, i)
+		if !period.Before(to) {
+			break
+		}
+
+		for _, t := range rule.candidates(dtstart, period) {
+			if !t.After(dtstart) {
+				continue
+			}
+
+			if !add(t) {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// period returns the first day of the nth period of the rule, at the start time of
+// the event
+func (rule *rrule) period(dtstart time.Time, n int) time.Time {
+	step := n * rule.interval
+
+	switch rule.freq {
+	case "DAILY":
+		return dtstart.AddDate(0, 0, step)
+	case "WEEKLY":
+		offset := (int(dtstart.Weekday()) - int(rule.weekStart) + 7) % 7
+		return dtstart.AddDate(0, 0, 7*step-offset)
+	case "MONTHLY":
+		return rule.date(dtstart, dtstart.Year(), dtstart.Month()+time.Month(step), 1)
+	default:
+		return rule.date(dtstart, dtstart.Year()+step, time.January, 1)
+	}
+}
+
+// candidates returns the days of a period that match the rule, in order
+func (rule *rrule) candidates(dtstart, period time.Time) []time.Time {
+	days := []time.Time{}
+
+	switch rule.freq {
+	case "DAILY":
+		if rule.inMonth(period) && rule.matchesMonthDay(period) && rule.matchesWeekday(period) {
+			days = append(days, period)
+		}
+	case "WEEKLY":
+		for i := 0; i < 7; i++ {
+			day := period.AddDate(0, 0, i)
+
+			if len(rule.byDay) == 0 && day.Weekday() != dtstart.Weekday() {
+				continue
+			}
+
+			if rule.inMonth(day) && rule.matchesWeekday(day) {
+				days = append(days, day)
+			}
+		}
+	case "MONTHLY":
+		if rule.inMonth(period) {
+			days = rule.monthDays(dtstart, period)
+		}
+	default:
+		days = rule.yearDays(dtstart, period)
+	}
+
+	return rule.setPositions(days)
+}
+
+// monthDays returns the days of the month that starts on first that match the rule
+func (rule *rrule) monthDays(dtstart, first time.Time) []time.Time {
+	if len(rule.byDay) == 0 && len(rule.byMonthDay) == 0 {
+		day := rule.date(dtstart, first.Year(), first.Month(), dtstart.Day())
+		if day.Month() != first.Month() {
+			return nil
+		}
+		return []time.Time{day}
+	}
+
+	days := []time.Time{}
+	for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+		if rule.matchesMonthDay(day) && rule.matchesOrdinalWeekday(day, first, first.AddDate(0, 1, 0)) {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+// yearDays returns the days of the year that starts on first that match the rule
+func (rule *rrule) yearDays(dtstart, first time.Time) []time.Time {
+	// Weekdays such as 20MO are counted within the year unless months are given
+	if len(rule.byMonth) == 0 && len(rule.byMonthDay) == 0 && len(rule.byDay) > 0 {
+		days := []time.Time{}
+		for day := first; day.Year() == first.Year(); day = day.AddDate(0, 0, 1) {
+			if rule.matchesOrdinalWeekday(day, first, first.AddDate(1, 0, 0)) {
+				days = append(days, day)
+			}
+		}
+		return days
+	}
+
+	months := rule.byMonth
+	if len(months) == 0 {
+		months = []time.Month{dtstart.Month()}
+
+		if len(rule.byMonthDay) > 0 {
+			months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		}
+	}
+
+	sorted := append([]time.Month{}, months...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	days := []time.Time{}
+	for _, month := range sorted {
+		days = append(days, rule.monthDays(dtstart, rule.date(dtstart, first.Year(), month, 1))...)
+	}
+
+	return days
+}
+
+// setPositions keeps the days at the BYSETPOS positions of a period
+func (rule *rrule) setPositions(days []time.Time) []time.Time {
+	if len(rule.bySetPos) == 0 {
+		return days
+	}
+
+	kept := []time.Time{}
+	for i, day := range days {
+		for _, pos := range rule.bySetPos {
+			if pos == i+1 || pos == i-len(days) {
+				kept = append(kept, day)
+				break
+			}
+		}
+	}
+
+	return kept
+}
+
+func (rule *rrule) inMonth(day time.Time) bool {
+	if len(rule.byMonth) == 0 {
+		return true
+	}
+
+	for _, month := range rule.byMonth {
+		if day.Month() == month {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rule *rrule) matchesMonthDay(day time.Time) bool {
+	if len(rule.byMonthDay) == 0 {
+		return true
+	}
+
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	for _, monthDay := range rule.byMonthDay {
+		if monthDay == day.Day() || daysInMonth+monthDay+1 == day.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rule *rrule) matchesWeekday(day time.Time) bool {
+	if len(rule.byDay) == 0 {
+		return true
+	}
+
+	for _, weekday := range rule.byDay {
+		if weekday.day == day.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesOrdinalWeekday returns whether a day matches BYDAY, counting ordinals such
+// as 2TU or -1FR within the days from first up to end
+func (rule *rrule) matchesOrdinalWeekday(day, first, end time.Time) bool {
+	if len(rule.byDay) == 0 {
+		return true
+	}
+
+	fromStart := int(day.Sub(first).Hours()/24+0.5)/7 + 1
+	fromEnd := -(int(end.Sub(day).Hours()/24-0.5)/7 + 1)
+
+	for _, weekday := range rule.byDay {
+		if weekday.day != day.Weekday() {
+			continue
+		}
+
+		if weekday.n == 0 || weekday.n == fromStart || weekday.n == fromEnd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// date returns a day at the start time of the event, normalizing overflowing months
+func (rule *rrule) date(dtstart time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, dtstart.Location())
+}
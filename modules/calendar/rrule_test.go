@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rrule_between(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		expected []string
+	}{
+		{
+			name:     "last day of the month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart:  time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-31", "2024-02-29", "2024-03-31"},
+		},
+		{
+			name:     "months without the day are skipped",
+			rule:     "FREQ=MONTHLY",
+			dtstart:  time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-31", "2024-03-31"},
+		},
+		{
+			name:     "last weekday of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			dtstart:  time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-31", "2024-02-29", "2024-03-29"},
+		},
+		{
+			name:     "every other week",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=6",
+			dtstart:  time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-02", "2024-01-04", "2024-01-16", "2024-01-18", "2024-01-30", "2024-02-01"},
+		},
+		{
+			name:     "until a date",
+			rule:     "FREQ=DAILY;UNTIL=20240103",
+			dtstart:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-01", "2024-01-02", "2024-01-03"},
+		},
+		{
+			name:     "count",
+			rule:     "FREQ=WEEKLY;COUNT=2",
+			dtstart:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-01", "2024-01-08"},
+		},
+		{
+			name:     "yearly",
+			rule:     "FREQ=YEARLY;BYMONTH=1,3;BYDAY=-1SU",
+			dtstart:  time.Date(2023, 3, 26, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2023-03-26", "2024-01-28", "2024-03-31"},
+		},
+		{
+			name:     "start that doesn't match the rule",
+			rule:     "FREQ=MONTHLY;BYDAY=1MO",
+			dtstart:  time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+			expected: []string{"2024-01-03", "2024-02-05", "2024-03-04"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := parseRRule(tt.rule, time.UTC)
+			require.NoError(t, err)
+
+			actual := []string{}
+			for _, occurrence := range rule.between(tt.dtstart, tt.dtstart, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) {
+				actual = append(actual, occurrence.Format("2006-01-02"))
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("daylight saving time", func(t *testing.T) {
+		rule, err := parseRRule("FREQ=DAILY;COUNT=2", berlin)
+		require.NoError(t, err)
+
+		dtstart := time.Date(2024, 3, 30, 9, 0, 0, 0, berlin)
+		occurrences := rule.between(dtstart, dtstart, dtstart.AddDate(0, 0, 7))
+
+		require.Len(t, occurrences, 2)
+		assert.Equal(t, "2024-03-31 09:00 CEST", occurrences[1].Format("2006-01-02 15:04 MST"))
+	})
+
+	t.Run("only occurrences from a date", func(t *testing.T) {
+		rule, err := parseRRule("FREQ=DAILY;COUNT=5", time.UTC)
+		require.NoError(t, err)
+
+		dtstart := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+		occurrences := rule.between(dtstart, dtstart.AddDate(0, 0, 3), dtstart.AddDate(1, 0, 0))
+
+		require.Len(t, occurrences, 2)
+		assert.Equal(t, dtstart.AddDate(0, 0, 3), occurrences[0])
+	})
+}
+
+func Test_parseRRule(t *testing.T) {
+	rule, err := parseRRule("FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,+2MO,TU;WKST=SU", time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rule.interval)
+	assert.Equal(t, time.Sunday, rule.weekStart)
+	assert.Equal(t, []weekdayNum{{-1, time.Friday}, {2, time.Monday}, {0, time.Tuesday}}, rule.byDay)
+
+	for _, value := range []string{"", "FREQ=HOURLY", "FREQ=DAILY;INTERVAL=0", "FREQ=DAILY;BYDAY=XX", "FREQ=DAILY;BYMONTH=13", "FREQ=YEARLY;BYWEEKNO=20"} {
+		_, err := parseRRule(value, time.UTC)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,79 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/gcal"
+)
+
+// Settings defines the configuration options for this module. The display options
+// are those of the gcal module
+type Settings struct {
+	*gcal.Settings
+
+	days       int `help:"The number of days ahead that the list view displays calendar events for." values:"A positive integer." optional:"true"`
+	eventCount int `help:"The number of calendar events to display." values:"A positive integer, 0..n." optional:"true"`
+	location   *time.Location
+	sources    []source `help:"The calendars to display. Each is the path to an .ics file, the URL of an ICS feed, or a map with a url and an optional type (caldav), username and password."`
+	timezone   string   `help:"The time zone used to display calendar event times." values:"A valid TZ database time zone string" optional:"true"`
+
+	verifyServerCertificate bool `help:"Determines whether or not the server's certificate chain and host name are verified." values:"true or false" optional:"true"`
+}
+
+// NewSettingsFromYAML creates and returns an instance of Settings with configuration options populated
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Settings: gcal.NewSettingsFromYAML(name, ymlConfig, globalConfig),
+
+		days:       ymlConfig.UInt("days", 30),
+		eventCount: ymlConfig.UInt("eventCount", 10),
+		location:   time.Local,
+		sources:    buildSources(ymlConfig),
+		timezone:   ymlConfig.UString("timezone", ""),
+
+		verifyServerCertificate: ymlConfig.UBool("verifyServerCertificate", true),
+	}
+
+	if settings.timezone != "" {
+		if location, err := time.LoadLocation(settings.timezone); err == nil {
+			settings.location = location
+		}
+	}
+
+	settings.SetDocumentationPath("calendar")
+
+	return &settings
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// buildSources reads the configured calendars. An entry is either a path or URL, or a
+// map with a url and its options
+func buildSources(ymlConfig *config.Config) []source {
+	sources := []source{}
+
+	for i := range ymlConfig.UList("sources") {
+		key := fmt.Sprintf("sources.%d", i)
+
+		if location, err := ymlConfig.String(key); err == nil {
+			sources = append(sources, newSource(location, "", "", ""))
+			continue
+		}
+
+		sourceConfig, err := ymlConfig.Get(key)
+		if err != nil {
+			continue
+		}
+
+		sources = append(sources, newSource(
+			sourceConfig.UString("url", sourceConfig.UString("path")),
+			sourceConfig.UString("type"),
+			sourceConfig.UString("username"),
+			sourceConfig.UString("password"),
+		))
+	}
+
+	return sources
+}
@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/modules/calendar/caldav"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	sourceCalDAV = "caldav"
+	sourceFile   = "file"
+	sourceHTTP   = "http"
+)
+
+// source is a calendar to read events from: a local .ics file, an ICS feed or a
+// CalDAV calendar collection
+type source struct {
+	kind     string
+	location string
+	password string
+	username string
+}
+
+// newSource creates a source. Unless its kind is given, URLs are ICS feeds and
+// anything else is a file. webcal:// URLs are fetched over HTTPS
+func newSource(location, kind, username, password string) source {
+	if strings.HasPrefix(location, "webcal://") {
+		location = "https://" + strings.TrimPrefix(location, "webcal://")
+	}
+
+	if kind == "" {
+		kind = sourceFile
+		if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+			kind = sourceHTTP
+		}
+	}
+
+	return source{
+		kind:     strings.ToLower(kind),
+		location: location,
+		password: password,
+		username: username,
+	}
+}
+
+// load returns the iCalendar objects of the source. A CalDAV collection is only asked
+// for the objects with events between start and end
+func (src source) load(client *http.Client, start, end time.Time) ([]string, error) {
+	switch src.kind {
+	case sourceFile:
+		path, err := utils.ExpandHomeDir(src.location)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{string(data)}, nil
+	case sourceHTTP:
+		data, _, err := src.client(client).Request(http.MethodGet, src.location, "", nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{string(data)}, nil
+	case sourceCalDAV:
+		calendarObjects, err := src.client(client).Events(src.location, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		objects := []string{}
+		for _, object := range calendarObjects {
+			objects = append(objects, object.Data)
+		}
+
+		return objects, nil
+	default:
+		return nil, fmt.Errorf("%s is not a supported calendar type, use one of: caldav, file, http", src.kind)
+	}
+}
+
+func (src source) client(httpClient *http.Client) *caldav.Client {
+	return caldav.NewClient(httpClient, src.username, src.password)
+}
@@ -0,0 +1,110 @@
+package calendar
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newSource(t *testing.T) {
+	assert.Equal(t, source{kind: sourceFile, location: "~/calendars/home.ics"}, newSource("~/calendars/home.ics", "", "", ""))
+	assert.Equal(t, source{kind: sourceHTTP, location: "https://example.com/feed.ics"}, newSource("https://example.com/feed.ics", "", "", ""))
+	assert.Equal(t, source{kind: sourceHTTP, location: "https://example.com/feed.ics"}, newSource("webcal://example.com/feed.ics", "", "", ""))
+	assert.Equal(t, source{kind: sourceCalDAV, location: "https://dav.example.com/work/", username: "alice", password: "secret"}, newSource("https://dav.example.com/work/", "CalDAV", "alice", "secret"))
+}
+
+func Test_buildSources(t *testing.T) {
+	ymlConfig, err := config.ParseYaml(`
+sources:
+  - ~/calendars/home.ics
+  - url: https://example.com/feed.ics
+    username: alice
+  - type: caldav
+    url: https://dav.example.com/work/
+`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []source{
+		{kind: sourceFile, location: "~/calendars/home.ics"},
+		{kind: sourceHTTP, location: "https://example.com/feed.ics", username: "alice"},
+		{kind: sourceCalDAV, location: "https://dav.example.com/work/"},
+	}, buildSources(ymlConfig))
+}
+
+func Test_source_load(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/events.ics")
+	require.NoError(t, err)
+
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 14)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "alice", user)
+		assert.Equal(t, "secret", pass)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/feed.ics":
+			_, _ = w.Write(fixture)
+		case r.Method == "REPORT" && r.URL.Path == "/dav/work/":
+			body, _ := io.ReadAll(r.Body)
+			assert.Contains(t, string(body), `<c:time-range start="20240304T000000Z" end="20240318T000000Z"/>`)
+			assert.Equal(t, "1", r.Header.Get("Depth"))
+
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(`<d:multistatus xmlns:d="DAV:" xmlns:cal="urn:ietf:params:xml:ns:caldav">
+  <d:response><d:href>/dav/work/a.ics</d:href><d:propstat><d:prop><cal:calendar-data>BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:a
+DTSTART:20240305T100000Z
+SUMMARY:Planning
+END:VEVENT
+END:VCALENDAR
+</cal:calendar-data></d:prop></d:propstat></d:response>
+  <d:response><d:href>/dav/work/b.ics</d:href><d:propstat><d:prop><cal:calendar-data>BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:b
+DTSTART;VALUE=DATE:20240306
+SUMMARY:Holiday
+END:VEVENT
+END:VCALENDAR
+</cal:calendar-data></d:prop></d:propstat></d:response>
+</d:multistatus>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	file, err := newSource("testdata/events.ics", "", "", "").load(server.Client(), from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(fixture)}, file)
+
+	feed, err := newSource(server.URL+"/feed.ics", "", "alice", "secret").load(server.Client(), from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(fixture)}, feed)
+
+	objects, err := newSource(server.URL+"/dav/work/", sourceCalDAV, "alice", "secret").load(server.Client(), from, to)
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	events, errs := expandEvents(objects, from, to, time.UTC)
+	require.Empty(t, errs)
+	require.Len(t, events, 2)
+	assert.Equal(t, "Planning", events[0].Summary)
+	assert.Equal(t, "2024-03-06", events[1].Start.Date)
+
+	_, err = newSource(server.URL+"/missing.ics", "", "alice", "secret").load(server.Client(), from, to)
+	assert.Error(t, err)
+
+	_, err = newSource("https://example.com", "exchange", "", "").load(server.Client(), from, to)
+	assert.EqualError(t, err, "exchange is not a supported calendar type, use one of: caldav, file, http")
+}
@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/modules/calendar/caldav"
+	"github.com/wtfutil/wtf/modules/gcal"
+	"golang.org/x/sync/errgroup"
+)
+
+// fetchLimit is how many calendars are read at once
+const fetchLimit = 4
+
+// Widget displays the events of iCalendar files, feeds and CalDAV calendars the way
+// the gcal module displays those of Google calendars
+type Widget struct {
+	*gcal.Widget
+
+	httpClient *http.Client
+	settings   *Settings
+}
+
+// NewWidget creates a new instance of the widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		httpClient: caldav.NewHTTPClient(settings.verifyServerCertificate),
+		settings:   settings,
+	}
+
+	widget.Widget = gcal.NewEventWidget(tviewApp, redrawChan, pages, settings.Settings, widget.Fetch)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fetch reads the events of every calendar that take place in the current view: the
+// current week or month, or for the list view from midnight today until the number of
+// days configured. A calendar that can't be read doesn't prevent the others' events
+// from being displayed, and is logged
+func (widget *Widget) Fetch() ([]*gcal.CalEvent, error) {
+	from, to := widget.Period()
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, widget.settings.location)
+
+	isList := to.IsZero()
+	if isList {
+		to = from.AddDate(0, 0, widget.settings.days)
+	}
+
+	results := make([][]string, len(widget.settings.sources))
+	errs := make([]error, len(widget.settings.sources))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, src := range widget.settings.sources {
+		group.Go(func() error {
+			results[i], errs[i] = src.load(widget.httpClient, from, to)
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	objects := []string{}
+	failed := []error{}
+	for i, result := range results {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Errorf("%s: %w", widget.settings.sources[i].location, errs[i]))
+			continue
+		}
+
+		objects = append(objects, result...)
+	}
+
+	events, expandErrs := expandEvents(objects, from, to, widget.settings.location)
+	failed = append(failed, expandErrs...)
+
+	for _, err := range failed {
+		logger.Log(fmt.Sprintf("[calendar] %s", err))
+	}
+
+	// Errors are only displayed if there are no events to display instead
+	if len(events) == 0 && len(failed) > 0 {
+		return nil, failed[0]
+	}
+
+	if isList && len(events) > widget.settings.eventCount {
+		events = events[:widget.settings.eventCount]
+	}
+
+	calEvents := []*gcal.CalEvent{}
+	for _, event := range events {
+		calEvents = append(calEvents, gcal.NewCalEvent(event))
+	}
+
+	return calEvents, nil
+}
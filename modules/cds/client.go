@@ -0,0 +1,32 @@
+// Package cds holds the pieces shared by the CDS widgets
+package cds
+
+import (
+	"github.com/ovh/cds/sdk"
+	"github.com/ovh/cds/sdk/cdsclient"
+)
+
+// NewClient creates a client for the CDS API at apiURL
+func NewClient(apiURL, token string) cdsclient.Interface {
+	return cdsclient.New(cdsclient.Config{
+		Host:                               apiURL,
+		BuiltinConsumerAuthenticationToken: token,
+	})
+}
+
+// FavoriteWorkflows returns the workflows the user has marked as favorites
+func FavoriteWorkflows(client cdsclient.Interface) ([]sdk.Workflow, error) {
+	data, err := client.Navbar()
+	if err != nil {
+		return nil, err
+	}
+
+	workflows := []sdk.Workflow{}
+	for _, v := range data {
+		if v.Favorite && v.WorkflowName != "" {
+			workflows = append(workflows, sdk.Workflow{ProjectKey: v.Key, Name: v.WorkflowName})
+		}
+	}
+
+	return workflows, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ovh/cds/sdk"
 	"github.com/ovh/cds/sdk/cdsclient"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/cds"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -41,10 +42,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 	widget.Unselect()
 
-	widget.client = cdsclient.New(cdsclient.Config{
-		Host:                               settings.apiURL,
-		BuiltinConsumerAuthenticationToken: settings.token,
-	})
+	widget.client = cds.NewClient(settings.apiURL, settings.token)
 
 	config, _ := widget.client.ConfigUser()
 
@@ -111,13 +109,7 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) buildWorkflowsCollection() []sdk.Workflow {
-	workflows := []sdk.Workflow{}
-	data, _ := widget.client.Navbar()
-	for _, v := range data {
-		if v.Favorite && v.WorkflowName != "" {
-			workflows = append(workflows, sdk.Workflow{ProjectKey: v.Key, Name: v.WorkflowName})
-		}
-	}
+	workflows, _ := cds.FavoriteWorkflows(widget.client)
 	return workflows
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/ovh/cds/sdk"
 	"github.com/ovh/cds/sdk/cdsclient"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/cds"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -42,10 +43,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	widget.Unselect()
 	widget.filters = []string{sdk.StatusWaiting, sdk.StatusBuilding}
 
-	widget.client = cdsclient.New(cdsclient.Config{
-		Host:                               settings.apiURL,
-		BuiltinConsumerAuthenticationToken: settings.token,
-	})
+	widget.client = cds.NewClient(settings.apiURL, settings.token)
 
 	config, _ := widget.client.ConfigUser()
 
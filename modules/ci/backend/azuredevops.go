@@ -0,0 +1,133 @@
+package backend
+
+import (
+	"strings"
+
+	azrBuild "github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/azuredevops"
+)
+
+// AzureDevOps lists the recent builds of an Azure DevOps project
+type AzureDevOps struct {
+	client *azuredevops.Client
+}
+
+func (azure *AzureDevOps) Title() string {
+	return "Azure DevOps"
+}
+
+// Setup reads the same settings as the azuredevops module: the orgURL, apiToken and
+// projectName
+func (azure *AzureDevOps) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	azure.client = azuredevops.NewClient(azuredevops.NewSettingsFromYAML(name, config, globalConfig))
+}
+
+func (azure *AzureDevOps) Runs(count int) ([]*Run, error) {
+	builds, err := azure.client.Builds(count)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*Run, 0, len(builds))
+	for _, build := range builds {
+		runs = append(runs, azure.toRun(build))
+	}
+
+	return runs, nil
+}
+
+// Rerun queues a new build of the run's definition for the same branch
+func (azure *AzureDevOps) Rerun(run *Run) error {
+	build := run.ref.(azrBuild.Build)
+	return azure.client.QueueBuild(&build)
+}
+
+func (azure *AzureDevOps) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (azure *AzureDevOps) toRun(build azrBuild.Build) *Run {
+	run := &Run{
+		Provider: azure.Title(),
+		Status:   azureStatus(build),
+		URL:      azureWebURL(build),
+
+		backend: azure,
+		ref:     build,
+	}
+
+	if build.Definition != nil && build.Definition.Name != nil {
+		run.Pipeline = *build.Definition.Name
+	}
+
+	if build.BuildNumber != nil {
+		run.Number = *build.BuildNumber
+	}
+
+	if build.SourceBranch != nil {
+		run.Branch = strings.TrimPrefix(*build.SourceBranch, "refs/heads/")
+	}
+
+	if build.Reason != nil && *build.Reason == azrBuild.BuildReasonValues.PullRequest && build.TriggerInfo != nil {
+		run.Branch = strings.TrimPrefix((*build.TriggerInfo)["pr.sourceBranch"], "refs/heads/")
+	}
+
+	if build.StartTime != nil {
+		run.StartedAt = build.StartTime.Time
+	} else if build.QueueTime != nil {
+		run.StartedAt = build.QueueTime.Time
+	}
+
+	if build.StartTime != nil && build.FinishTime != nil {
+		run.Duration = build.FinishTime.Time.Sub(build.StartTime.Time)
+	}
+
+	return run
+}
+
+func azureStatus(build azrBuild.Build) Status {
+	if build.Status == nil {
+		return StatusUnknown
+	}
+
+	switch *build.Status {
+	case azrBuild.BuildStatusValues.InProgress, azrBuild.BuildStatusValues.Cancelling:
+		return StatusRunning
+	case azrBuild.BuildStatusValues.Postponed, azrBuild.BuildStatusValues.NotStarted:
+		return StatusPending
+	case azrBuild.BuildStatusValues.Completed:
+		if build.Result == nil {
+			return StatusUnknown
+		}
+
+		switch *build.Result {
+		case azrBuild.BuildResultValues.Succeeded:
+			return StatusPassed
+		case azrBuild.BuildResultValues.Failed, azrBuild.BuildResultValues.PartiallySucceeded:
+			return StatusFailed
+		case azrBuild.BuildResultValues.Canceled:
+			return StatusCanceled
+		}
+	}
+
+	return StatusUnknown
+}
+
+// azureWebURL returns the link to a build's results page
+func azureWebURL(build azrBuild.Build) string {
+	links, ok := build.Links.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	web, ok := links["web"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	href, _ := web["href"].(string)
+	return href
+}
@@ -0,0 +1,101 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// Backend is a CI provider that runs pipelines, can be asked to run them again and
+// can open them in the browser. Backends adapt the clients of the provider modules,
+// and are set up from the same settings as those modules
+type Backend interface {
+	Title() string
+	Setup(name string, config *config.Config, globalConfig *config.Config)
+	Runs(count int) ([]*Run, error)
+	Rerun(*Run) error
+	Open(*Run) error
+}
+
+// Status is the state of a run, normalized across providers
+type Status string
+
+const (
+	StatusPassed   Status = "passed"
+	StatusFailed   Status = "failed"
+	StatusRunning  Status = "running"
+	StatusPending  Status = "pending"
+	StatusCanceled Status = "canceled"
+	StatusSkipped  Status = "skipped"
+	StatusUnknown  Status = "unknown"
+)
+
+// Run is a single run of a pipeline, as reported by any provider
+type Run struct {
+	Provider  string
+	Pipeline  string
+	Branch    string
+	Number    string
+	Status    Status
+	StartedAt time.Time
+	Duration  time.Duration
+	URL       string
+
+	backend Backend
+	// ref holds whatever the backend needs to rerun the run
+	ref interface{}
+}
+
+// Rerun asks the provider the run came from to run it again
+func (run *Run) Rerun() error {
+	return run.backend.Rerun(run)
+}
+
+// Open opens the run in the browser
+func (run *Run) Open() error {
+	return run.backend.Open(run)
+}
+
+// Elapsed returns how long the run took, or how long it has been running so far
+func (run *Run) Elapsed(now time.Time) time.Duration {
+	if run.Status == StatusRunning && !run.StartedAt.IsZero() {
+		return now.Sub(run.StartedAt).Truncate(time.Second)
+	}
+
+	return run.Duration.Truncate(time.Second)
+}
+
+// New returns an unconfigured backend of the given type
+func New(backendType string) (Backend, error) {
+	switch backendType {
+	case "azuredevops":
+		return &AzureDevOps{}, nil
+	case "buildkite":
+		return &Buildkite{}, nil
+	case "cds":
+		return &CDS{}, nil
+	case "circleci":
+		return &CircleCI{}, nil
+	case "jenkins":
+		return &Jenkins{}, nil
+	case "travisci":
+		return &TravisCI{}, nil
+	default:
+		return nil, fmt.Errorf("%s is not a supported CI provider", backendType)
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// openURL opens a run's page in the browser
+func openURL(run *Run) error {
+	if run.URL == "" {
+		return errors.New("run has no page to open")
+	}
+
+	utils.OpenFile(run.URL)
+	return nil
+}
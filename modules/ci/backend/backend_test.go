@@ -0,0 +1,176 @@
+package backend
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtfutil/wtf/modules/buildkite"
+	"github.com/wtfutil/wtf/modules/circleci"
+	"github.com/wtfutil/wtf/modules/travisci"
+)
+
+func Test_New(t *testing.T) {
+	for _, backendType := range []string{"azuredevops", "buildkite", "cds", "circleci", "jenkins", "travisci"} {
+		ciBackend, err := New(backendType)
+		require.NoError(t, err, backendType)
+		assert.NotNil(t, ciBackend, backendType)
+	}
+
+	_, err := New("gocd")
+	assert.EqualError(t, err, "gocd is not a supported CI provider")
+}
+
+func Test_Run_Elapsed(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	running := &Run{Status: StatusRunning, StartedAt: now.Add(-90 * time.Second)}
+	assert.Equal(t, 90*time.Second, running.Elapsed(now))
+
+	finished := &Run{Status: StatusPassed, StartedAt: now.Add(-time.Hour), Duration: 75*time.Second + time.Millisecond}
+	assert.Equal(t, 75*time.Second, finished.Elapsed(now))
+}
+
+func Test_Run_Open(t *testing.T) {
+	run := &Run{backend: &CircleCI{}}
+	assert.EqualError(t, run.Open(), "run has no page to open")
+}
+
+func Test_CircleCI_toRun(t *testing.T) {
+	circle := &CircleCI{}
+	circle.Setup("circleci", newConfig(t, "apiKey: secret"), newConfig(t, "wtf: {}"))
+
+	run := circle.toRun(&circleci.Build{
+		BuildNum:        12,
+		BuildTimeMillis: 65000,
+		BuildURL:        "https://circleci.com/gh/wtfutil/wtf/12",
+		Branch:          "main",
+		Reponame:        "wtf",
+		StartTime:       time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		Status:          "infrastructure_fail",
+		Username:        "wtfutil",
+	})
+
+	assert.Equal(t, "CircleCI", run.Provider)
+	assert.Equal(t, "wtfutil/wtf", run.Pipeline)
+	assert.Equal(t, "main", run.Branch)
+	assert.Equal(t, "12", run.Number)
+	assert.Equal(t, StatusFailed, run.Status)
+	assert.Equal(t, 65*time.Second, run.Duration)
+	assert.Equal(t, "https://circleci.com/gh/wtfutil/wtf/12", run.URL)
+
+	assert.Equal(t, StatusPending, circleStatus("queued"))
+	assert.Equal(t, StatusSkipped, circleStatus("not_run"))
+}
+
+func Test_TravisCI_toRun(t *testing.T) {
+	travis := &TravisCI{}
+	travis.Setup("travisci", newConfig(t, "apiKey: secret\npro: true"), newConfig(t, "wtf: {}"))
+
+	build := &travisci.Build{ID: 99, Number: "41", State: "errored", Duration: 120}
+	build.Branch.Name = "main"
+	build.Repository.Slug = "wtfutil/wtf"
+
+	run := travis.toRun(build)
+
+	assert.Equal(t, "wtfutil/wtf", run.Pipeline)
+	assert.Equal(t, "main", run.Branch)
+	assert.Equal(t, "41", run.Number)
+	assert.Equal(t, StatusFailed, run.Status)
+	assert.Equal(t, 2*time.Minute, run.Duration)
+	assert.Equal(t, "https://travis-ci.com/wtfutil/wtf/builds/99", run.URL)
+
+	assert.Equal(t, StatusRunning, travisStatus("started"))
+	assert.Equal(t, StatusPending, travisStatus("created"))
+}
+
+func Test_Buildkite_toRun(t *testing.T) {
+	kite := &Buildkite{}
+	queued := kite.toRun(&buildkite.Build{
+		Number:    7,
+		State:     "scheduled",
+		CreatedAt: time.Date(2024, 1, 1, 9, 59, 0, 0, time.UTC),
+	})
+
+	assert.Equal(t, "7", queued.Number)
+	assert.Equal(t, StatusPending, queued.Status)
+	assert.Equal(t, time.Date(2024, 1, 1, 9, 59, 0, 0, time.UTC), queued.StartedAt)
+	assert.Zero(t, queued.Duration)
+
+	finished := kite.toRun(&buildkite.Build{
+		State:      "passed",
+		StartedAt:  time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+		FinishedAt: time.Date(2024, 1, 1, 8, 5, 0, 0, time.UTC),
+	})
+
+	assert.Equal(t, StatusPassed, finished.Status)
+	assert.Equal(t, 5*time.Minute, finished.Duration)
+
+	assert.Equal(t, StatusRunning, buildkiteStatus("canceling"))
+	assert.Equal(t, StatusSkipped, buildkiteStatus("not_run"))
+}
+
+func Test_Jenkins(t *testing.T) {
+	var triggered []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "secret", pass)
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/json":
+			assert.Contains(t, r.URL.Query().Get("tree"), "{0,3}")
+			_, _ = w.Write([]byte(`{"jobs": [
+				{"name": "deploy", "url": "` + "http://" + r.Host + `/job/deploy/",
+				 "builds": [{"number": 5, "url": "http://jenkins/job/deploy/5/", "building": true, "timestamp": 1704103200000}]},
+				{"name": "test", "url": "` + "http://" + r.Host + `/job/test/",
+				 "builds": [{"number": 9, "result": "ABORTED", "duration": 30000, "timestamp": 1704099600000}]},
+				{"name": "ignored", "url": "http://jenkins/job/ignored/", "builds": [{"number": 1, "result": "SUCCESS"}]}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/job/deploy/api/json":
+			_, _ = w.Write([]byte(`{"property": [{"parameterDefinitions": [{"name": "ENV"}]}]}`))
+		case r.Method == "GET" && r.URL.Path == "/job/test/api/json":
+			_, _ = w.Write([]byte(`{"property": [{}]}`))
+		case r.Method == "POST":
+			triggered = append(triggered, r.URL.Path)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	jenkins := &Jenkins{}
+	jenkins.Setup(
+		"jenkins",
+		newConfig(t, "url: "+server.URL+"\nuser: admin\napiKey: secret\njobNameRegex: ^(deploy|test)$"),
+		newConfig(t, "wtf: {}"),
+	)
+
+	runs, err := jenkins.Runs(3)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, "deploy", runs[0].Pipeline)
+	assert.Equal(t, StatusRunning, runs[0].Status)
+	assert.Equal(t, time.UnixMilli(1704103200000), runs[0].StartedAt)
+	assert.Equal(t, StatusCanceled, runs[1].Status)
+	assert.Equal(t, 30*time.Second, runs[1].Duration)
+
+	require.NoError(t, runs[0].Rerun())
+	require.NoError(t, runs[1].Rerun())
+	assert.Equal(t, []string{"/job/deploy/buildWithParameters", "/job/test/build"}, triggered)
+}
+
+func newConfig(t *testing.T, yml string) *config.Config {
+	cfg, err := config.ParseYaml(yml)
+	require.NoError(t, err)
+
+	return cfg
+}
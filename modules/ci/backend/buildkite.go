@@ -0,0 +1,92 @@
+package backend
+
+import (
+	"strconv"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/buildkite"
+)
+
+// Buildkite lists the recent builds of an organization's configured pipelines
+type Buildkite struct {
+	client *buildkite.Client
+}
+
+func (kite *Buildkite) Title() string {
+	return "Buildkite"
+}
+
+// Setup reads the same settings as the buildkite module: the organizationSlug and
+// the pipelines, each with the branches to list builds of
+func (kite *Buildkite) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	kite.client = buildkite.NewClient(buildkite.NewSettingsFromYAML(name, config, globalConfig))
+}
+
+func (kite *Buildkite) Runs(count int) ([]*Run, error) {
+	builds, err := kite.client.RecentBuilds(count)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*Run, 0, len(builds))
+	for i := range builds {
+		runs = append(runs, kite.toRun(&builds[i]))
+	}
+
+	return runs, nil
+}
+
+// Rerun rebuilds a build with the same commit, branch and environment
+func (kite *Buildkite) Rerun(run *Run) error {
+	return kite.client.Rebuild(run.ref.(*buildkite.Build))
+}
+
+func (kite *Buildkite) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (kite *Buildkite) toRun(build *buildkite.Build) *Run {
+	run := &Run{
+		Provider:  kite.Title(),
+		Pipeline:  build.Pipeline.Slug,
+		Branch:    build.Branch,
+		Number:    strconv.Itoa(build.Number),
+		Status:    buildkiteStatus(build.State),
+		StartedAt: build.StartedAt,
+		URL:       build.WebUrl,
+
+		backend: kite,
+		ref:     build,
+	}
+
+	if run.StartedAt.IsZero() {
+		run.StartedAt = build.CreatedAt
+	}
+
+	if !build.FinishedAt.IsZero() && !run.StartedAt.IsZero() {
+		run.Duration = build.FinishedAt.Sub(run.StartedAt)
+	}
+
+	return run
+}
+
+func buildkiteStatus(state string) Status {
+	switch state {
+	case "passed":
+		return StatusPassed
+	case "failed", "failing":
+		return StatusFailed
+	case "running", "canceling":
+		return StatusRunning
+	case "scheduled", "blocked", "creating", "waiting":
+		return StatusPending
+	case "canceled":
+		return StatusCanceled
+	case "skipped", "not_run":
+		return StatusSkipped
+	default:
+		return StatusUnknown
+	}
+}
@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/olebedev/config"
+	"github.com/ovh/cds/sdk"
+	"github.com/ovh/cds/sdk/cdsclient"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/cds"
+	"github.com/wtfutil/wtf/utils"
+	"golang.org/x/sync/errgroup"
+)
+
+// CDS lists the recent runs of CDS workflows, either those configured as
+// "PROJECT/workflow" or, by default, the user's favorites
+type CDS struct {
+	client    cdsclient.Interface
+	uiURL     string
+	workflows []sdk.Workflow
+}
+
+func (server *CDS) Title() string {
+	return "CDS"
+}
+
+func (server *CDS) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	apiURL := config.UString("apiURL", os.Getenv("CDS_API_URL"))
+	token := config.UString("token", os.Getenv("CDS_TOKEN"))
+	cfg.ModuleSecret(name, globalConfig, &token).Service(apiURL).Load()
+
+	server.client = cds.NewClient(apiURL, token)
+
+	for _, workflow := range utils.ToStrs(config.UList("workflows")) {
+		if projectKey, name, ok := strings.Cut(workflow, "/"); ok {
+			server.workflows = append(server.workflows, sdk.Workflow{ProjectKey: projectKey, Name: name})
+		}
+	}
+}
+
+func (server *CDS) Runs(count int) ([]*Run, error) {
+	if server.uiURL == "" {
+		userConfig, err := server.client.ConfigUser()
+		if err != nil {
+			return nil, err
+		}
+		server.uiURL = userConfig.URLUI
+	}
+
+	workflows, err := server.workflowList()
+	if err != nil {
+		return nil, err
+	}
+
+	var mutex sync.Mutex
+	runs := []*Run{}
+
+	group := errgroup.Group{}
+	for _, workflow := range workflows {
+		group.Go(func() error {
+			workflowRuns, err := server.client.WorkflowRunList(workflow.ProjectKey, workflow.Name, 0, int64(count))
+			if err != nil {
+				return err
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for _, workflowRun := range workflowRuns {
+				runs = append(runs, server.toRun(workflow, workflowRun))
+			}
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// Rerun starts a new manual run of the run's workflow
+func (server *CDS) Rerun(run *Run) error {
+	workflow := run.ref.(sdk.Workflow)
+	_, err := server.client.WorkflowRunFromManual(workflow.ProjectKey, workflow.Name, sdk.WorkflowNodeRunManual{}, 0, 0)
+	return err
+}
+
+func (server *CDS) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// workflowList returns the configured workflows, or the user's favorites if there
+// are none
+func (server *CDS) workflowList() ([]sdk.Workflow, error) {
+	if len(server.workflows) > 0 {
+		return server.workflows, nil
+	}
+
+	return cds.FavoriteWorkflows(server.client)
+}
+
+func (server *CDS) toRun(workflow sdk.Workflow, workflowRun sdk.WorkflowRun) *Run {
+	run := &Run{
+		Provider:  server.Title(),
+		Pipeline:  workflow.ProjectKey + "/" + workflow.Name,
+		Number:    strconv.FormatInt(workflowRun.Number, 10),
+		Status:    cdsStatus(workflowRun.Status),
+		StartedAt: workflowRun.Start,
+		URL: fmt.Sprintf("%s/project/%s/workflow/%s/run/%d",
+			server.uiURL, workflow.ProjectKey, workflow.Name, workflowRun.Number),
+
+		backend: server,
+		ref:     workflow,
+	}
+
+	for _, tag := range workflowRun.Tags {
+		if tag.Tag == "git.branch" {
+			run.Branch = tag.Value
+		}
+	}
+
+	if run.Status != StatusRunning && run.Status != StatusPending {
+		run.Duration = workflowRun.LastModified.Sub(workflowRun.Start)
+	}
+
+	return run
+}
+
+func cdsStatus(status string) Status {
+	switch status {
+	case sdk.StatusSuccess:
+		return StatusPassed
+	case sdk.StatusFail:
+		return StatusFailed
+	case sdk.StatusBuilding, sdk.StatusCrafting:
+		return StatusRunning
+	case sdk.StatusWaiting, sdk.StatusPending:
+		return StatusPending
+	case sdk.StatusStopped:
+		return StatusCanceled
+	case sdk.StatusSkipped, sdk.StatusDisabled, sdk.StatusNeverBuilt:
+		return StatusSkipped
+	default:
+		return StatusUnknown
+	}
+}
@@ -0,0 +1,91 @@
+package backend
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/circleci"
+)
+
+// CircleCI lists the recent builds of every project the token's user follows
+type CircleCI struct {
+	client *circleci.Client
+}
+
+func (circle *CircleCI) Title() string {
+	return "CircleCI"
+}
+
+func (circle *CircleCI) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	apiKey := config.UString("apiKey", os.Getenv("WTF_CIRCLE_API_KEY"))
+	cfg.ModuleSecret(name, globalConfig, &apiKey).Load()
+
+	circle.client = circleci.NewClient(apiKey)
+}
+
+func (circle *CircleCI) Runs(count int) ([]*Run, error) {
+	builds, err := circle.client.BuildsFor()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(builds) > count {
+		builds = builds[:count]
+	}
+
+	runs := make([]*Run, 0, len(builds))
+	for _, build := range builds {
+		runs = append(runs, circle.toRun(build))
+	}
+
+	return runs, nil
+}
+
+// Rerun retries a build with the same revision and configuration
+func (circle *CircleCI) Rerun(run *Run) error {
+	return circle.client.RetryBuild(run.ref.(*circleci.Build))
+}
+
+func (circle *CircleCI) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (circle *CircleCI) toRun(build *circleci.Build) *Run {
+	return &Run{
+		Provider:  circle.Title(),
+		Pipeline:  build.Username + "/" + build.Reponame,
+		Branch:    build.Branch,
+		Number:    strconv.Itoa(build.BuildNum),
+		Status:    circleStatus(build.Status),
+		StartedAt: build.StartTime,
+		Duration:  time.Duration(build.BuildTimeMillis) * time.Millisecond,
+		URL:       build.BuildURL,
+
+		backend: circle,
+		ref:     build,
+	}
+}
+
+func circleStatus(status string) Status {
+	switch status {
+	case "success", "fixed", "no_tests":
+		return StatusPassed
+	case "failed", "infrastructure_fail", "timedout":
+		return StatusFailed
+	case "running":
+		return StatusRunning
+	case "queued", "scheduled", "not_running":
+		return StatusPending
+	case "canceled":
+		return StatusCanceled
+	case "not_run", "retried":
+		return StatusSkipped
+	default:
+		return StatusUnknown
+	}
+}
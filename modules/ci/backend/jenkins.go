@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/jenkins"
+)
+
+// Jenkins lists the recent builds of the jobs in a Jenkins view
+type Jenkins struct {
+	client *jenkins.Client
+}
+
+func (server *Jenkins) Title() string {
+	return "Jenkins"
+}
+
+// Setup reads the same settings as the jenkins module, such as the url of the view,
+// the user and the jobNameRegex
+func (server *Jenkins) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	server.client = jenkins.NewClient(jenkins.NewSettingsFromYAML(name, config, globalConfig))
+}
+
+func (server *Jenkins) Runs(count int) ([]*Run, error) {
+	jobs, err := server.client.JobsWithBuilds(count)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []*Run{}
+	for i := range jobs {
+		for j := range jobs[i].Builds {
+			runs = append(runs, server.toRun(&jobs[i], &jobs[i].Builds[j]))
+		}
+	}
+
+	return runs, nil
+}
+
+// Rerun queues a new build of the run's job. Parameterized jobs are built with their
+// default parameters
+func (server *Jenkins) Rerun(run *Run) error {
+	job := run.ref.(*jenkins.Job)
+
+	params, err := server.client.Parameters(job)
+	if err != nil {
+		return err
+	}
+
+	var values url.Values
+	if len(params) > 0 {
+		values = url.Values{}
+	}
+
+	return server.client.TriggerBuild(job, values)
+}
+
+func (server *Jenkins) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (server *Jenkins) toRun(job *jenkins.Job, build *jenkins.Build) *Run {
+	status := jenkinsStatus(build.Result)
+	if build.Building {
+		status = StatusRunning
+	}
+
+	return &Run{
+		Provider:  server.Title(),
+		Pipeline:  job.DisplayName(),
+		Number:    strconv.Itoa(build.Number),
+		Status:    status,
+		StartedAt: build.StartedAt(),
+		Duration:  time.Duration(build.Duration) * time.Millisecond,
+		URL:       build.Url,
+
+		backend: server,
+		ref:     job,
+	}
+}
+
+func jenkinsStatus(result string) Status {
+	switch result {
+	case "SUCCESS":
+		return StatusPassed
+	case "FAILURE", "UNSTABLE":
+		return StatusFailed
+	case "ABORTED":
+		return StatusCanceled
+	case "NOT_BUILT":
+		return StatusSkipped
+	case "":
+		return StatusPending
+	default:
+		return StatusUnknown
+	}
+}
@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/travisci"
+)
+
+// TravisCI lists the recent builds of the repositories the token's user can see
+type TravisCI struct {
+	client *travisci.Client
+}
+
+func (travis *TravisCI) Title() string {
+	return "TravisCI"
+}
+
+// Setup reads the same settings as the travisci module: pro picks travis-ci.com and
+// an Enterprise host can be set with baseURL
+func (travis *TravisCI) Setup(name string, config *config.Config, globalConfig *config.Config) {
+	travis.client = travisci.NewClient(travisci.NewSettingsFromYAML(name, config, globalConfig))
+}
+
+func (travis *TravisCI) Runs(count int) ([]*Run, error) {
+	builds, err := travis.client.Builds(count, "id:desc")
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*Run, 0, len(builds.Builds))
+	for i := range builds.Builds {
+		runs = append(runs, travis.toRun(&builds.Builds[i]))
+	}
+
+	return runs, nil
+}
+
+// Rerun restarts a build
+func (travis *TravisCI) Rerun(run *Run) error {
+	return travis.client.RestartBuild(run.ref.(*travisci.Build))
+}
+
+func (travis *TravisCI) Open(run *Run) error {
+	return openURL(run)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (travis *TravisCI) toRun(build *travisci.Build) *Run {
+	return &Run{
+		Provider:  travis.Title(),
+		Pipeline:  build.Repository.Slug,
+		Branch:    build.Branch.Name,
+		Number:    build.Number,
+		Status:    travisStatus(build.State),
+		StartedAt: build.StartedAt,
+		Duration:  time.Duration(build.Duration) * time.Second,
+		URL:       travis.client.BuildURL(build),
+
+		backend: travis,
+		ref:     build,
+	}
+}
+
+func travisStatus(state string) Status {
+	switch state {
+	case "passed":
+		return StatusPassed
+	case "failed", "errored":
+		return StatusFailed
+	case "started":
+		return StatusRunning
+	case "created", "received":
+		return StatusPending
+	case "canceled":
+		return StatusCanceled
+	default:
+		return StatusUnknown
+	}
+}
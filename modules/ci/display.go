@@ -0,0 +1,97 @@
+package ci
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/ci/backend"
+	"github.com/wtfutil/wtf/utils"
+)
+
+var statusIcons = map[backend.Status]string{
+	backend.StatusPassed:   "[green]✓",
+	backend.StatusFailed:   "[red]✗",
+	backend.StatusRunning:  "[yellow]●",
+	backend.StatusPending:  "[grey]○",
+	backend.StatusCanceled: "[grey]⊘",
+	backend.StatusSkipped:  "[grey]-",
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		return title, widget.err.Error(), true
+	}
+
+	str := ""
+	for _, err := range widget.errs {
+		str += fmt.Sprintf(" [red]%s[white]\n", tview.Escape(err.Error()))
+	}
+
+	if len(widget.runs) == 0 {
+		return title, str + " [grey]no runs[white]", false
+	}
+
+	now := time.Now()
+
+	for idx, run := range widget.runs {
+		str += widget.runRow(idx, run, now)
+	}
+
+	return title, str, false
+}
+
+// runRow formats a run with its status, provider, pipeline, number, branch, duration
+// and age
+func (widget *Widget) runRow(idx int, run *backend.Run, now time.Time) string {
+	icon, ok := statusIcons[run.Status]
+	if !ok {
+		icon = "[grey]?"
+	}
+
+	branch := ""
+	if run.Branch != "" {
+		branch = fmt.Sprintf(" [blue]%s", tview.Escape(run.Branch))
+	}
+
+	age := ""
+	if !run.StartedAt.IsZero() {
+		age = fmt.Sprintf(" %s %s ago", formatDuration(run.Elapsed(now)), formatDuration(now.Sub(run.StartedAt)))
+	}
+
+	row := fmt.Sprintf(
+		" %s [grey]%s [%s]%s #%s%s[grey]%s",
+		icon,
+		tview.Escape(run.Provider),
+		widget.RowColor(idx),
+		tview.Escape(run.Pipeline),
+		tview.Escape(run.Number),
+		branch,
+		age,
+	)
+
+	return utils.HighlightableHelper(widget.View, row, idx, tview.TaggedStringWidth(row))
+}
+
+// formatDuration formats a duration with its two most significant units, e.g. 3m12s
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration < time.Minute:
+		return fmt.Sprintf("%ds", int(duration.Seconds()))
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(duration.Minutes()), int(duration.Seconds())%60)
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(duration.Hours()), int(duration.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%02dh", int(duration.Hours()/24), int(duration.Hours())%24)
+	}
+}
@@ -0,0 +1,20 @@
+package ci
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next run")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous run")
+	widget.SetKeyboardChar("o", widget.Open, "Open run in browser")
+	widget.SetKeyboardChar("R", widget.Rerun, "Re-run run")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next run")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous run")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.Open, "Open run in browser")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
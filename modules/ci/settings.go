@@ -0,0 +1,69 @@
+package ci
+
+import (
+	"sort"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "CI"
+)
+
+// provider is a configured CI backend. The backend type defaults to the provider's
+// name, so that several instances of the same backend can be configured with a type
+type provider struct {
+	name        string
+	backendType string
+	config      *config.Config
+}
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	*cfg.Common
+
+	globalConfig *config.Config
+	providers    []provider `help:"The CI providers to aggregate runs from, keyed by name, each with the settings of the provider's own module."`
+	runCount     int        `help:"The number of runs to fetch from each provider." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		globalConfig: globalConfig,
+		providers:    buildProviders(ymlConfig),
+		runCount:     ymlConfig.UInt("runCount", 5),
+	}
+
+	return &settings
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func buildProviders(ymlConfig *config.Config) []provider {
+	names := []string{}
+	for name := range ymlConfig.UMap("providers") {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	providers := []provider{}
+	for _, name := range names {
+		providerConfig, err := ymlConfig.Get("providers." + name)
+		if err != nil {
+			continue
+		}
+
+		providers = append(providers, provider{
+			name:        name,
+			backendType: providerConfig.UString("type", name),
+			config:      providerConfig,
+		})
+	}
+
+	return providers
+}
@@ -0,0 +1,190 @@
+package ci
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/ci/backend"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/sync/errgroup"
+)
+
+// fetchLimit is how many providers are fetched from at once
+const fetchLimit = 4
+
+// configuredBackend is a backend along with the name it was configured under
+type configuredBackend struct {
+	name    string
+	backend backend.Backend
+}
+
+// Widget aggregates the recent runs of several CI providers into a single list
+type Widget struct {
+	view.ScrollableWidget
+
+	backends  []configuredBackend
+	err       error
+	errs      []error
+	mutex     sync.Mutex
+	runs      []*backend.Run
+	settings  *Settings
+	setupErrs []error
+}
+
+// NewWidget creates a new instance of the widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		settings: settings,
+	}
+
+	for _, provider := range settings.providers {
+		ciBackend, err := backend.New(provider.backendType)
+		if err != nil {
+			widget.setupErrs = append(widget.setupErrs, fmt.Errorf("%s: %w", provider.name, err))
+			continue
+		}
+
+		ciBackend.Setup(provider.name, provider.config, settings.globalConfig)
+		widget.backends = append(widget.backends, configuredBackend{provider.name, ciBackend})
+	}
+
+	widget.errs = widget.setupErrs
+
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh reloads the runs of every provider
+func (widget *Widget) Refresh() {
+	if widget.Disabled() {
+		return
+	}
+
+	runs, errs := widget.fetchRuns()
+
+	widget.mutex.Lock()
+	widget.runs = runs
+	widget.errs = errs
+	widget.err = nil
+	widget.SetItemCount(len(runs))
+	widget.mutex.Unlock()
+
+	widget.display()
+}
+
+// Rerun asks the selected run's provider to run it again
+func (widget *Widget) Rerun() {
+	run := widget.selectedRun()
+	if run == nil {
+		return
+	}
+
+	widget.performAction(run.Rerun)
+}
+
+// Open opens the selected run in the browser
+func (widget *Widget) Open() {
+	run := widget.selectedRun()
+	if run == nil {
+		return
+	}
+
+	if err := run.Open(); err != nil {
+		widget.mutex.Lock()
+		widget.err = err
+		widget.mutex.Unlock()
+
+		widget.display()
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetchRuns loads the runs of every provider, newest first. A provider that fails
+// doesn't prevent the others' runs from being displayed
+func (widget *Widget) fetchRuns() ([]*backend.Run, []error) {
+	results := make([][]*backend.Run, len(widget.backends))
+	errs := make([]error, len(widget.backends))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, configured := range widget.backends {
+		group.Go(func() error {
+			runs, err := configured.backend.Runs(widget.settings.runCount)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", configured.name, err)
+			}
+			results[i] = runs
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	// Providers that couldn't be set up are reported along with those that failed
+	failed := append([]error{}, widget.setupErrs...)
+
+	runs := []*backend.Run{}
+	for i, result := range results {
+		runs = append(runs, result...)
+
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+		}
+	}
+
+	sortRuns(runs, time.Now())
+
+	return runs, failed
+}
+
+// performAction runs an action against a provider and refreshes the widget. If the
+// action fails, its error is displayed instead of the runs
+func (widget *Widget) performAction(action func() error) {
+	if err := action(); err != nil {
+		widget.mutex.Lock()
+		widget.err = err
+		widget.mutex.Unlock()
+
+		widget.display()
+		return
+	}
+
+	widget.Refresh()
+}
+
+func (widget *Widget) selectedRun() *backend.Run {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if widget.Selected < 0 || widget.Selected >= len(widget.runs) {
+		return nil
+	}
+
+	return widget.runs[widget.Selected]
+}
+
+// sortRuns sorts runs newest first. Runs that haven't started yet are the newest
+func sortRuns(runs []*backend.Run, now time.Time) {
+	startedAt := func(run *backend.Run) time.Time {
+		if run.StartedAt.IsZero() {
+			return now
+		}
+		return run.StartedAt
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return startedAt(runs[i]).After(startedAt(runs[j]))
+	})
+}
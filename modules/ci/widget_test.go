@@ -0,0 +1,54 @@
+package ci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtfutil/wtf/modules/ci/backend"
+)
+
+func Test_buildProviders(t *testing.T) {
+	cfg, err := config.ParseYaml(`
+providers:
+  travisci:
+    pro: true
+  jenkins-prod:
+    type: jenkins
+    url: https://jenkins.example.com
+  circleci: {}
+`)
+	require.NoError(t, err)
+
+	providers := buildProviders(cfg)
+	require.Len(t, providers, 3)
+
+	assert.Equal(t, "circleci", providers[0].name)
+	assert.Equal(t, "circleci", providers[0].backendType)
+	assert.Equal(t, "jenkins-prod", providers[1].name)
+	assert.Equal(t, "jenkins", providers[1].backendType)
+	assert.Equal(t, "https://jenkins.example.com", providers[1].config.UString("url"))
+	assert.Equal(t, "travisci", providers[2].name)
+	assert.True(t, providers[2].config.UBool("pro"))
+}
+
+func Test_sortRuns(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	runs := []*backend.Run{
+		{Number: "old", StartedAt: now.Add(-time.Hour)},
+		{Number: "queued"},
+		{Number: "new", StartedAt: now.Add(-time.Minute)},
+	}
+
+	sortRuns(runs, now)
+
+	numbers := []string{}
+	for _, run := range runs {
+		numbers = append(numbers, run.Number)
+	}
+
+	assert.Equal(t, []string{"queued", "new", "old"}, numbers)
+}
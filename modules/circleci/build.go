@@ -1,10 +1,17 @@
 package circleci
 
+import "time"
+
 type Build struct {
-	AuthorEmail string `json:"author_email"`
-	AuthorName  string `json:"author_name"`
-	Branch      string `json:"branch"`
-	BuildNum    int    `json:"build_num"`
-	Reponame    string `json:"reponame"`
-	Status      string `json:"status"`
+	AuthorEmail     string    `json:"author_email"`
+	AuthorName      string    `json:"author_name"`
+	Branch          string    `json:"branch"`
+	BuildNum        int       `json:"build_num"`
+	BuildTimeMillis int64     `json:"build_time_millis"`
+	BuildURL        string    `json:"build_url"`
+	Reponame        string    `json:"reponame"`
+	StartTime       time.Time `json:"start_time"`
+	Status          string    `json:"status"`
+	Username        string    `json:"username"`
+	VcsType         string    `json:"vcs_type"`
 }
@@ -25,7 +25,7 @@ func NewClient(apiKey string) *Client {
 func (client *Client) BuildsFor() ([]*Build, error) {
 	builds := []*Build{}
 
-	resp, err := client.circleRequest("recent-builds")
+	resp, err := client.circleRequest("GET", "recent-builds")
 	if err != nil {
 		return builds, err
 	}
@@ -38,19 +38,27 @@ func (client *Client) BuildsFor() ([]*Build, error) {
 	return builds, nil
 }
 
+// RetryBuild retries a build with the same revision and configuration
+func (client *Client) RetryBuild(build *Build) error {
+	path := fmt.Sprintf("project/%s/%s/%s/%d/retry", build.VcsType, build.Username, build.Reponame, build.BuildNum)
+
+	_, err := client.circleRequest("POST", path)
+	return err
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 var (
-	circleAPIURL = &url.URL{Scheme: "https", Host: "circleci.com", Path: "/api/v1/"}
+	circleAPIURL = &url.URL{Scheme: "https", Host: "circleci.com", Path: "/api/v1.1/"}
 )
 
-func (client *Client) circleRequest(path string) ([]byte, error) {
+func (client *Client) circleRequest(method, path string) ([]byte, error) {
 	params := url.Values{}
 	params.Add("circle-token", client.apiKey)
 
 	url := circleAPIURL.ResolveReference(&url.URL{Path: path, RawQuery: params.Encode()})
 
-	req, err := http.NewRequest("GET", url.String(), http.NoBody)
+	req, err := http.NewRequest(method, url.String(), http.NoBody)
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 	if err != nil {
@@ -0,0 +1,55 @@
+package circleci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client(t *testing.T) {
+	var retried string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.URL.Query().Get("circle-token"))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/recent-builds":
+			_, _ = w.Write([]byte(`[
+				{"build_num": 12, "branch": "main", "reponame": "wtf", "username": "wtfutil", "vcs_type": "github",
+				 "status": "failed", "start_time": "2024-01-01T10:00:00.000Z", "build_time_millis": 65000,
+				 "build_url": "https://circleci.com/gh/wtfutil/wtf/12"},
+				{"build_num": 13, "branch": "dev", "reponame": "wtf", "username": "wtfutil", "vcs_type": "github",
+				 "status": "queued", "start_time": null}
+			]`))
+		case r.Method == "POST":
+			retried = r.URL.Path
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiURL := circleAPIURL
+	circleAPIURL, _ = url.Parse(server.URL + "/")
+	defer func() { circleAPIURL = apiURL }()
+
+	client := NewClient("secret")
+
+	builds, err := client.BuildsFor()
+	require.NoError(t, err)
+	require.Len(t, builds, 2)
+
+	assert.Equal(t, "wtfutil", builds[0].Username)
+	assert.Equal(t, int64(65000), builds[0].BuildTimeMillis)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), builds[0].StartTime)
+	assert.True(t, builds[1].StartTime.IsZero())
+
+	require.NoError(t, client.RetryBuild(builds[0]))
+	assert.Equal(t, "/project/github/wtfutil/wtf/12/retry", retried)
+}
@@ -0,0 +1,160 @@
+package crypto
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+)
+
+const coinGeckoURL = "https://api.coingecko.com/api/v3"
+
+// coinGeckoIDs are the IDs of common coins, which CoinGecko identifies by name rather
+// than by symbol
+var coinGeckoIDs = map[string]string{
+	"ADA":  "cardano",
+	"AVAX": "avalanche-2",
+	"BNB":  "binancecoin",
+	"BTC":  "bitcoin",
+	"DOGE": "dogecoin",
+	"DOT":  "polkadot",
+	"ETH":  "ethereum",
+	"LINK": "chainlink",
+	"LTC":  "litecoin",
+	"SOL":  "solana",
+	"TRX":  "tron",
+	"USDC": "usd-coin",
+	"USDT": "tether",
+	"XMR":  "monero",
+	"XRP":  "ripple",
+}
+
+func init() {
+	registerProvider("coingecko", func(httpClient *http.Client, settings *Settings) Provider {
+		baseURL := settings.priceURL
+		if baseURL == "" {
+			baseURL = coinGeckoURL
+		}
+
+		return &coinGecko{
+			apiKey:     settings.apiKey,
+			baseURL:    strings.TrimSuffix(baseURL, "/"),
+			httpClient: httpClient,
+			ids:        settings.ids,
+		}
+	})
+}
+
+// coinGecko reads prices from the public CoinGecko API, or one that's compatible
+// with it
+type coinGecko struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+	ids        map[string]string
+}
+
+// Quotes returns the prices of coins from the simple price endpoint
+func (provider *coinGecko) Quotes(symbols []string, currency string) (map[string]*portfolio.Quote, error) {
+	quotes := map[string]*portfolio.Quote{}
+	if len(symbols) == 0 {
+		return quotes, nil
+	}
+
+	ids := []string{}
+	symbolsByID := map[string][]string{}
+	for _, symbol := range symbols {
+		id := provider.id(symbol)
+		if symbolsByID[id] == nil {
+			ids = append(ids, id)
+		}
+		symbolsByID[id] = append(symbolsByID[id], symbol)
+	}
+
+	vs := strings.ToLower(currency)
+
+	params := url.Values{}
+	params.Set("ids", strings.Join(ids, ","))
+	params.Set("vs_currencies", vs)
+	params.Set("include_24hr_change", "true")
+
+	prices := map[string]map[string]float64{}
+	if err := getJSON(provider.httpClient, provider.baseURL+"/simple/price?"+params.Encode(), provider.headers(), &prices); err != nil {
+		return nil, err
+	}
+
+	for id, price := range prices {
+		value, ok := price[vs]
+		if !ok {
+			continue
+		}
+
+		pct := price[vs+"_24h_change"]
+
+		for _, symbol := range symbolsByID[id] {
+			quotes[symbol] = &portfolio.Quote{
+				Symbol:    symbol,
+				Currency:  currency,
+				Price:     value,
+				Change:    value - value/(1+pct/100),
+				ChangePct: pct,
+			}
+		}
+	}
+
+	return quotes, nil
+}
+
+// ExchangeRate returns how much one unit of a currency is worth in another, from the
+// exchange rates of bitcoin
+func (provider *coinGecko) ExchangeRate(from, to string) (float64, error) {
+	var response struct {
+		Rates map[string]struct {
+			Value float64 `json:"value"`
+		} `json:"rates"`
+	}
+
+	if err := getJSON(provider.httpClient, provider.baseURL+"/exchange_rates", provider.headers(), &response); err != nil {
+		return 0, err
+	}
+
+	fromRate, ok := response.Rates[strings.ToLower(from)]
+	if !ok || fromRate.Value == 0 {
+		return 0, fmt.Errorf("no rate for %s", from)
+	}
+
+	toRate, ok := response.Rates[strings.ToLower(to)]
+	if !ok {
+		return 0, fmt.Errorf("no rate for %s", to)
+	}
+
+	return toRate.Value / fromRate.Value, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// id returns the CoinGecko ID of a coin. Coins that aren't configured or known are
+// looked up by their symbol
+func (provider *coinGecko) id(symbol string) string {
+	symbol = strings.ToUpper(symbol)
+
+	if id, ok := provider.ids[symbol]; ok {
+		return id
+	}
+
+	if id, ok := coinGeckoIDs[symbol]; ok {
+		return id
+	}
+
+	return strings.ToLower(symbol)
+}
+
+func (provider *coinGecko) headers() map[string]string {
+	if provider.apiKey == "" {
+		return nil
+	}
+
+	return map[string]string{"x-cg-demo-api-key": provider.apiKey}
+}
@@ -0,0 +1,147 @@
+package crypto
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+)
+
+func testSettings(t *testing.T, yml string) *Settings {
+	globalConfig, err := config.ParseYaml("wtf: {}")
+	require.NoError(t, err)
+
+	ymlConfig, err := config.ParseYaml(yml)
+	require.NoError(t, err)
+
+	return NewSettingsFromYAML("crypto", ymlConfig, globalConfig)
+}
+
+func Test_newProvider(t *testing.T) {
+	settings := testSettings(t, "provider: coingecko")
+	provider, err := newProvider(http.DefaultClient, settings)
+	require.NoError(t, err)
+	assert.IsType(t, &coinGecko{}, provider)
+
+	settings = testSettings(t, "provider: bittrex")
+	_, err = newProvider(http.DefaultClient, settings)
+	assert.EqualError(t, err, "bittrex is not a supported provider, use one of: coingecko")
+}
+
+func Test_coinGecko_Quotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/simple/price", r.URL.Path)
+		assert.Equal(t, "bitcoin,ethereum,dogecoin,shiba-inu", r.URL.Query().Get("ids"))
+		assert.Equal(t, "eur", r.URL.Query().Get("vs_currencies"))
+		assert.Equal(t, "secret", r.Header.Get("x-cg-demo-api-key"))
+
+		_, _ = w.Write([]byte(`{
+			"bitcoin": {"eur": 60000, "eur_24h_change": 20},
+			"ethereum": {"eur": 2000, "eur_24h_change": -20},
+			"shiba-inu": {"eur": 0.00001}
+		}`))
+	}))
+	defer server.Close()
+
+	settings := testSettings(t, `
+apiKey: secret
+priceURL: `+server.URL+`/api/v3/
+ids:
+  shib: shiba-inu
+`)
+
+	provider, err := newProvider(server.Client(), settings)
+	require.NoError(t, err)
+
+	quotes, err := provider.Quotes([]string{"BTC", "eth", "DOGE", "SHIB", "BTC"}, "EUR")
+	require.NoError(t, err)
+
+	assert.Len(t, quotes, 3)
+	assert.InDelta(t, 60000, quotes["BTC"].Price, 0.001)
+	assert.InDelta(t, 10000, quotes["BTC"].Change, 0.001)
+	assert.Equal(t, "EUR", quotes["BTC"].Currency)
+	assert.InDelta(t, -500, quotes["eth"].Change, 0.001)
+	assert.InDelta(t, -20, quotes["eth"].ChangePct, 0.001)
+	assert.Zero(t, quotes["SHIB"].Change)
+	assert.NotContains(t, quotes, "DOGE")
+}
+
+func Test_coinGecko_ExchangeRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/exchange_rates", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"rates": {"btc": {"value": 1}, "usd": {"value": 60000}, "eur": {"value": 50000}}}`))
+	}))
+	defer server.Close()
+
+	settings := testSettings(t, "priceURL: "+server.URL)
+	provider, err := newProvider(server.Client(), settings)
+	require.NoError(t, err)
+
+	rate, err := provider.ExchangeRate("EUR", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.2, rate, 0.0001)
+
+	_, err = provider.ExchangeRate("EUR", "XYZ")
+	assert.EqualError(t, err, "no rate for XYZ")
+}
+
+func Test_balances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/btc/address/bc1qgood":
+			_, _ = w.Write([]byte(`{"chain_stats": {"funded_txo_sum": 150000000, "spent_txo_sum": 25000000}}`))
+		case "/eth/api/v2/addresses/0xgood":
+			_, _ = w.Write([]byte(`{"coin_balance": "2500000000000000000000"}`))
+		case "/eth/api/v2/addresses/0xunused":
+			_, _ = w.Write([]byte(`{"coin_balance": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	settings := testSettings(t, `
+explorers:
+  btc: `+server.URL+`/btc/
+  eth: `+server.URL+`/eth
+holdings:
+  - symbol: BTC
+    address: bc1qgood
+    costBasis: 20000
+  - symbol: ETH
+    address: 0xgood
+  - symbol: ETH
+    address: 0xunused
+  - symbol: BTC
+    address: bc1qbad
+  - symbol: SOL
+    address: solana
+  - symbol: SOL
+    quantity: 12
+`)
+
+	holdings, errs := balances(server.Client(), settings, settings.holdings)
+
+	assert.Equal(t, []portfolio.Holding{
+		{Symbol: "BTC", Quantity: 1.25, CostBasis: 20000, Address: "bc1qgood"},
+		{Symbol: "ETH", Quantity: 2500, Address: "0xgood"},
+		{Symbol: "ETH", Quantity: 0, Address: "0xunused"},
+		{Symbol: "SOL", Quantity: 12},
+	}, holdings)
+
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "BTC bc1qbad: 404 Not Found")
+	assert.EqualError(t, errs[1], "SOL solana: the balances of SOL wallets can't be looked up, use one of: BTC, ETH")
+}
+
+func Test_formatPrice(t *testing.T) {
+	assert.Equal(t, "67012.35", formatPrice(67012.3456))
+	assert.Equal(t, "0.1234567", formatPrice(0.1234567))
+	assert.Equal(t, "0.00001235", formatPrice(0.0000123456789))
+	assert.Equal(t, "0.00", formatPrice(0))
+}
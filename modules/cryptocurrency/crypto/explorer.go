@@ -0,0 +1,117 @@
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Explorer looks up the balances of the wallets of a coin
+type Explorer interface {
+	// Balance returns how many coins an address holds
+	Balance(address string) (float64, error)
+}
+
+// explorers maps coin symbols to the public explorers that know their wallets, and
+// the URLs that they're at by default
+var explorers = map[string]struct {
+	url     string
+	factory func(*http.Client, string) Explorer
+}{
+	"BTC": {url: "https://mempool.space/api", factory: newEsploraExplorer},
+	"ETH": {url: "https://eth.blockscout.com", factory: newBlockscoutExplorer},
+}
+
+// newExplorer creates the explorer of a coin, at the URL that the settings give for
+// it if there's one
+func newExplorer(httpClient *http.Client, settings *Settings, symbol string) (Explorer, error) {
+	symbol = strings.ToUpper(symbol)
+
+	known, ok := explorers[symbol]
+	if !ok {
+		return nil, fmt.Errorf("the balances of %s wallets can't be looked up, use one of: BTC, ETH", symbol)
+	}
+
+	baseURL := known.url
+	if configured, ok := settings.explorers[symbol]; ok && configured != "" {
+		baseURL = configured
+	}
+
+	return known.factory(httpClient, strings.TrimSuffix(baseURL, "/")), nil
+}
+
+/* -------------------- Esplora -------------------- */
+
+const satoshisPerBitcoin = 1e8
+
+// esploraExplorer reads bitcoin balances from an Esplora API, such as the one of
+// mempool.space or blockstream.info
+type esploraExplorer struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newEsploraExplorer(httpClient *http.Client, baseURL string) Explorer {
+	return &esploraExplorer{baseURL: baseURL, httpClient: httpClient}
+}
+
+// Balance returns the confirmed balance of an address
+func (explorer *esploraExplorer) Balance(address string) (float64, error) {
+	var response struct {
+		ChainStats struct {
+			FundedTxoSum int64 `json:"funded_txo_sum"`
+			SpentTxoSum  int64 `json:"spent_txo_sum"`
+		} `json:"chain_stats"`
+	}
+
+	if err := getJSON(explorer.httpClient, explorer.baseURL+"/address/"+url.PathEscape(address), nil, &response); err != nil {
+		return 0, err
+	}
+
+	satoshis := response.ChainStats.FundedTxoSum - response.ChainStats.SpentTxoSum
+
+	return float64(satoshis) / satoshisPerBitcoin, nil
+}
+
+/* -------------------- Blockscout -------------------- */
+
+// weiPerEther is 10^18
+var weiPerEther = new(big.Float).SetFloat64(1e18)
+
+// blockscoutExplorer reads ether balances from a Blockscout API
+type blockscoutExplorer struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newBlockscoutExplorer(httpClient *http.Client, baseURL string) Explorer {
+	return &blockscoutExplorer{baseURL: baseURL, httpClient: httpClient}
+}
+
+// Balance returns the balance of an address
+func (explorer *blockscoutExplorer) Balance(address string) (float64, error) {
+	var response struct {
+		CoinBalance *string `json:"coin_balance"`
+	}
+
+	if err := getJSON(explorer.httpClient, explorer.baseURL+"/api/v2/addresses/"+url.PathEscape(address), nil, &response); err != nil {
+		return 0, err
+	}
+
+	// Addresses that have never been used have no balance
+	if response.CoinBalance == nil {
+		return 0, nil
+	}
+
+	// Balances in wei don't fit in an int64
+	wei, ok := new(big.Float).SetString(*response.CoinBalance)
+	if !ok {
+		return 0, fmt.Errorf("invalid balance %s", *response.CoinBalance)
+	}
+
+	ether, _ := new(big.Float).Quo(wei, weiPerEther).Float64()
+
+	return ether, nil
+}
@@ -0,0 +1,76 @@
+package crypto
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+)
+
+// Provider looks up the prices of coins
+type Provider interface {
+	// Quotes returns the prices of coins in a currency and how much they changed in
+	// the last 24 hours, keyed by symbol. Coins that the provider doesn't know are
+	// left out
+	Quotes(symbols []string, currency string) (map[string]*portfolio.Quote, error)
+
+	// ExchangeRate returns how much one unit of a currency is worth in another
+	ExchangeRate(from, to string) (float64, error)
+}
+
+// providers maps the names of providers to functions that create them
+var providers = map[string]func(*http.Client, *Settings) Provider{}
+
+// registerProvider makes a provider available under a name. Providers register
+// themselves from an init function
+func registerProvider(name string, factory func(*http.Client, *Settings) Provider) {
+	if _, exists := providers[name]; exists {
+		panic("crypto: provider registered twice: " + name)
+	}
+
+	providers[name] = factory
+}
+
+// newProvider creates the provider that the settings name
+func newProvider(httpClient *http.Client, settings *Settings) (Provider, error) {
+	factory, ok := providers[settings.provider]
+	if !ok {
+		names := make([]string, 0, len(providers))
+		for name := range providers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("%s is not a supported provider, use one of: %s", settings.provider, strings.Join(names, ", "))
+	}
+
+	return factory(httpClient, settings), nil
+}
+
+// getJSON decodes the JSON response to a GET request
+func getJSON(httpClient *http.Client, url string, headers map[string]string, result interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,79 @@
+package crypto
+
+import (
+	"os"
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	defaultFocusable = false
+	defaultTitle     = "Crypto"
+)
+
+type colors struct {
+	up   string
+	down string
+}
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	*cfg.Common
+
+	colors
+
+	apiKey       string              `help:"An optional API key of the price provider." optional:"true"`
+	baseCurrency string              `help:"The currency that prices and the portfolio totals are in." optional:"true"`
+	explorers    map[string]string   `help:"The URLs of the block explorers that wallet balances are read from, keyed by coin (btc, eth)." optional:"true"`
+	holdings     []portfolio.Holding `help:"An array of holdings, each with a symbol and either a quantity or the address of a wallet, and optionally a costBasis and its currency. The same holdings config works with the stock modules." optional:"true"`
+	ids          map[string]string   `help:"The provider IDs of coins, keyed by symbol (for example, DOGE: dogecoin)." optional:"true"`
+	priceURL     string              `help:"The base URL of the price API, for providers that are compatible with it." optional:"true"`
+	provider     string              `help:"The provider of prices." values:"coingecko" optional:"true"`
+	sort         bool                `help:"Whether or not to order holdings by their share of the portfolio." optional:"true"`
+	symbols      []string            `help:"An array of coins to show the price of, such as BTC or ETH." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		apiKey:       ymlConfig.UString("apiKey", os.Getenv("WTF_COINGECKO_API_KEY")),
+		baseCurrency: strings.ToUpper(ymlConfig.UString("baseCurrency", "USD")),
+		explorers:    upperKeys(ymlConfig.UMap("explorers")),
+		holdings:     portfolio.HoldingsFromYAML(ymlConfig),
+		ids:          upperKeys(ymlConfig.UMap("ids")),
+		priceURL:     ymlConfig.UString("priceURL", ""),
+		provider:     ymlConfig.UString("provider", "coingecko"),
+		sort:         ymlConfig.UBool("sort", false),
+		symbols:      utils.ToStrs(ymlConfig.UList("symbols")),
+	}
+
+	settings.up = ymlConfig.UString("colors.up", "green")
+	settings.down = ymlConfig.UString("colors.down", "red")
+
+	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).Load()
+
+	settings.SetDocumentationPath("cryptocurrencies/crypto")
+
+	return &settings
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// upperKeys returns a map of strings keyed by coin symbols, which are upper case
+func upperKeys(values map[string]interface{}) map[string]string {
+	upper := map[string]string{}
+
+	for key, value := range values {
+		if str, ok := value.(string); ok {
+			upper[strings.ToUpper(key)] = str
+		}
+	}
+
+	return upper
+}
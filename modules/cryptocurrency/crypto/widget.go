@@ -0,0 +1,191 @@
+package crypto
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// fetchLimit is how many wallet balances are looked up at once
+	fetchLimit = 4
+
+	requestTimeout = 15 * time.Second
+)
+
+// Widget is the container for your module's data
+type Widget struct {
+	view.TextWidget
+
+	httpClient  *http.Client
+	provider    Provider
+	providerErr error
+	settings    *Settings
+}
+
+// NewWidget creates and returns an instance of Widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(tviewApp, redrawChan, nil, settings.Common),
+
+		httpClient: &http.Client{Timeout: requestTimeout},
+		settings:   settings,
+	}
+
+	widget.provider, widget.providerErr = newProvider(widget.httpClient, settings)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh updates the onscreen contents of the widget
+func (widget *Widget) Refresh() {
+	if widget.Disabled() {
+		return
+	}
+
+	widget.Redraw(widget.content)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.providerErr != nil {
+		return title, widget.providerErr.Error(), true
+	}
+
+	holdings, balanceErrs := balances(widget.httpClient, widget.settings, widget.settings.holdings)
+
+	symbols := append([]string{}, widget.settings.symbols...)
+	symbols = append(symbols, portfolio.Symbols(holdings)...)
+
+	quotes, err := widget.provider.Quotes(symbols, widget.settings.baseCurrency)
+	if err != nil {
+		return title, err.Error(), true
+	}
+
+	str := widget.priceContent(quotes)
+
+	if len(widget.settings.holdings) > 0 {
+		if str != "" {
+			str += "\n"
+		}
+
+		holdingsPortfolio := portfolio.New(holdings, quotes, widget.settings.baseCurrency, widget.provider.ExchangeRate)
+		if widget.settings.sort {
+			holdingsPortfolio.Sort()
+		}
+
+		str += holdingsPortfolio.Render(nil, widget.color)
+	}
+
+	for _, err := range balanceErrs {
+		str += fmt.Sprintf("[red]%s[white]\n", tview.Escape(err.Error()))
+	}
+
+	return title, str, false
+}
+
+// priceContent displays the price of each coin in the symbols and how much it changed
+// in the last 24 hours
+func (widget *Widget) priceContent(quotes map[string]*portfolio.Quote) string {
+	width := 0
+	for _, symbol := range widget.settings.symbols {
+		width = max(width, len(symbol))
+	}
+
+	str := ""
+	for _, symbol := range widget.settings.symbols {
+		quote, ok := quotes[symbol]
+		if !ok {
+			str += fmt.Sprintf("%-*s [red]unknown coin[white]\n", width, symbol)
+			continue
+		}
+
+		str += fmt.Sprintf(
+			"%-*s %14s %-3s [%s]%+6.2f%%[white]\n",
+			width,
+			symbol,
+			formatPrice(quote.Price),
+			quote.Currency,
+			widget.color(quote.ChangePct),
+			quote.ChangePct,
+		)
+	}
+
+	return str
+}
+
+func (widget *Widget) color(pct float64) string {
+	if pct < 0 {
+		return widget.settings.down
+	}
+
+	return widget.settings.up
+}
+
+// balances returns the holdings with the quantity of those with an address set to the
+// balance of their wallet. Holdings with balances that can't be looked up are left
+// out, and their errors returned
+func balances(httpClient *http.Client, settings *Settings, holdings []portfolio.Holding) ([]portfolio.Holding, []error) {
+	updated := make([]portfolio.Holding, len(holdings))
+	errs := make([]error, len(holdings))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, holding := range holdings {
+		updated[i] = holding
+
+		if holding.Address == "" {
+			continue
+		}
+
+		group.Go(func() error {
+			explorer, err := newExplorer(httpClient, settings, holding.Symbol)
+			if err == nil {
+				updated[i].Quantity, err = explorer.Balance(holding.Address)
+			}
+
+			if err != nil {
+				errs[i] = fmt.Errorf("%s %s: %w", holding.Symbol, holding.Address, err)
+			}
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	kept := []portfolio.Holding{}
+	failed := []error{}
+	for i, holding := range updated {
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+			continue
+		}
+
+		kept = append(kept, holding)
+	}
+
+	return kept, failed
+}
+
+// formatPrice shows the significant digits of coins that are worth less than one
+// unit of the currency
+func formatPrice(price float64) string {
+	if price > 0 && price < 1 {
+		return strings.TrimRight(fmt.Sprintf("%.8f", price), "0")
+	}
+
+	return fmt.Sprintf("%.2f", price)
+}
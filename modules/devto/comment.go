@@ -0,0 +1,72 @@
+package devto
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/VictorAvelar/devto-api-go/devto"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+)
+
+// commentsTimeout is how long loading the comments of an article may take
+const commentsTimeout = 10 * time.Second
+
+var (
+	commentsClient = &http.Client{Timeout: commentsTimeout}
+	commentsURL    = devto.BaseURL + "/api/comments"
+)
+
+// Comment is a comment on an article, with its replies
+type Comment struct {
+	BodyHTML  string     `json:"body_html"`
+	Children  []*Comment `json:"children"`
+	CreatedAt time.Time  `json:"created_at"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+// GetComments fetches the comments of an article as a thread
+func GetComments(article *devto.ListedArticle) ([]*view.Comment, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s?a_id=%d", commentsURL, article.ID), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := commentsClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s", resp.Status)
+	}
+
+	var comments []*Comment
+	err = utils.ParseJSON(&comments, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return threadComments(comments), nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func threadComments(comments []*Comment) []*view.Comment {
+	thread := []*view.Comment{}
+
+	for _, comment := range comments {
+		thread = append(thread, &view.Comment{
+			Author:  comment.User.Username,
+			Replies: threadComments(comment.Children),
+			Text:    view.CommentText(comment.BodyHTML),
+			Time:    comment.CreatedAt,
+		})
+	}
+
+	return thread
+}
@@ -0,0 +1,41 @@
+package devto
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VictorAvelar/devto-api-go/devto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "42", r.URL.Query().Get("a_id"))
+		_, _ = w.Write([]byte(`[
+			{
+				"body_html": "<p>Great &amp; useful</p>",
+				"created_at": "2023-11-14T22:13:20Z",
+				"user": {"username": "alice"},
+				"children": [
+					{"body_html": "<p>Thanks</p>", "created_at": "2023-11-14T23:00:00Z", "user": {"username": "bob"}, "children": []}
+				]
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	url := commentsURL
+	commentsURL = server.URL
+	defer func() { commentsURL = url }()
+
+	comments, err := GetComments(&devto.ListedArticle{ID: 42})
+	assert.NoError(t, err)
+
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "alice", comments[0].Author)
+	assert.Equal(t, "Great & useful", comments[0].Text)
+	assert.Equal(t, int64(1700000000), comments[0].Time.Unix())
+	assert.Len(t, comments[0].Replies, 1)
+	assert.Equal(t, "bob", comments[0].Replies[0].Author)
+}
@@ -9,6 +9,7 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("d", widget.Next, "Select next item")
 	widget.SetKeyboardChar("a", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("o", widget.openStory, "Open story in browser")
+	widget.SetKeyboardChar("t", widget.showComments, "Show comment thread")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
@@ -117,3 +117,13 @@ func (widget *Widget) openStory() {
 		utils.OpenFile(article.URL.String())
 	}
 }
+
+func (widget *Widget) showComments() {
+	sel := widget.GetSelected()
+	if sel >= 0 && widget.articles != nil && sel < len(widget.articles) {
+		article := &widget.articles[sel]
+		widget.ShowComments(article.Title, func() ([]*view.Comment, error) {
+			return GetComments(article)
+		})
+	}
+}
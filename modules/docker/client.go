@@ -10,6 +10,7 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"github.com/wtfutil/wtf/utils"
 )
 
 func (widget *Widget) getSystemInfo() string {
@@ -108,57 +109,48 @@ func (widget *Widget) getSystemInfo() string {
 	return result
 }
 
-func (widget *Widget) getContainerStates() string {
-	cntrs, err := widget.cli.ContainerList(context.Background(), container.ListOptions{All: true})
-	if err != nil {
-		return errors.Wrapf(err, " could not get container list").Error()
-	}
+// containerInfo is a single container as displayed in the widget
+type containerInfo struct {
+	ID      string
+	Name    string
+	State   string
+	Project string
+}
 
-	if len(cntrs) == 0 {
-		return " no containers"
-	}
+// composeProjectLabel is the label Docker Compose sets to the name of a container's project
+const composeProjectLabel = "com.docker.compose.project"
 
-	colorMap := map[string]string{
-		"created":    "green",
-		"running":    "lime",
-		"paused":     "yellow",
-		"restarting": "yellow",
-		"removing":   "yellow",
-		"exited":     "red",
-		"dead":       "red",
+// getContainers returns all containers, sorted by name and filtered to the configured
+// Compose projects if there are any
+func (widget *Widget) getContainers() ([]containerInfo, error) {
+	cntrs, err := widget.cli.ContainerList(context.Background(), container.ListOptions{All: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, " could not get container list")
 	}
 
-	containers := []struct {
-		name  string
-		state string
-	}{}
+	containers := []containerInfo{}
 	for _, c := range cntrs {
-		container := struct {
-			name  string
-			state string
-		}{
-			name:  c.Names[0],
-			state: c.State,
+		project := c.Labels[composeProjectLabel]
+		if len(widget.settings.composeProjects) > 0 && !utils.Includes(widget.settings.composeProjects, project) {
+			continue
 		}
 
-		container.name = strings.ReplaceAll(container.name, "/", "")
-		containers = append(containers, container)
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.ReplaceAll(c.Names[0], "/", "")
+		}
+
+		containers = append(containers, containerInfo{
+			ID:      c.ID,
+			Name:    name,
+			State:   c.State,
+			Project: project,
+		})
 	}
 
 	sort.Slice(containers, func(i, j int) bool {
-		return containers[i].name < containers[j].name
-	})
-
-	padSlice(false, containers, func(i int) string {
-		return containers[i].name
-	}, func(i int, val string) {
-		containers[i].name = val
+		return containers[i].Name < containers[j].Name
 	})
 
-	result := ""
-	for _, c := range containers {
-		result += fmt.Sprintf("[white]%s [%s]%s\n", c.name, colorMap[c.state], c.state)
-	}
-
-	return result
+	return containers, nil
 }
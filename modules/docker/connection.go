@@ -0,0 +1,192 @@
+package docker
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/tlsconfig"
+	"github.com/pkg/errors"
+)
+
+const defaultContextName = "default"
+
+// contextEndpoint is the daemon that a Docker CLI context connects to
+type contextEndpoint struct {
+	host      string
+	tlsConfig *tls.Config
+}
+
+// contextMeta is the subset of a Docker CLI context's meta.json that WTF needs
+type contextMeta struct {
+	Name      string `json:"Name"`
+	Endpoints map[string]struct {
+		Host          string `json:"Host"`
+		SkipTLSVerify bool   `json:"SkipTLSVerify"`
+	} `json:"Endpoints"`
+}
+
+// newDockerClient creates a Docker API client. The daemon is chosen, in order of
+// preference, from the module's host setting, the module's context setting, the
+// DOCKER_HOST environment variable, the DOCKER_CONTEXT environment variable and
+// finally the current context in the Docker CLI config file
+func newDockerClient(settings *Settings) (*client.Client, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+
+	switch {
+	case settings.host != "":
+		opts = append(opts, client.WithHost(settings.host))
+	case settings.context != "":
+		contextOpts, err := contextClientOpts(settings.context)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, contextOpts...)
+	case os.Getenv("DOCKER_HOST") != "":
+		// Already handled by client.FromEnv
+	default:
+		contextOpts, err := contextClientOpts(currentContextName())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, contextOpts...)
+	}
+
+	return client.NewClientWithOpts(opts...)
+}
+
+// contextClientOpts returns the client options needed to connect to the daemon
+// described by the named Docker CLI context
+func contextClientOpts(name string) ([]client.Opt, error) {
+	endpoint, err := readContextEndpoint(name)
+	if err != nil || endpoint == nil {
+		return []client.Opt{}, err
+	}
+
+	// The HTTP client has to be in place before the host configures its transport
+	opts := []client.Opt{}
+	if endpoint.tlsConfig != nil {
+		opts = append(opts, withTLSConfig(endpoint.tlsConfig))
+	}
+
+	return append(opts, client.WithHost(endpoint.host)), nil
+}
+
+// readContextEndpoint reads the daemon of the named Docker CLI context and the TLS
+// configuration to connect to it with. The default context has no endpoint of its own
+func readContextEndpoint(name string) (*contextEndpoint, error) {
+	if name == "" || name == defaultContextName {
+		return nil, nil
+	}
+
+	dir := dockerConfigDir()
+	hash := contextDirName(name)
+
+	data, err := os.ReadFile(filepath.Join(dir, "contexts", "meta", hash, "meta.json"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read docker context %q", name)
+	}
+
+	meta := contextMeta{}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, errors.Wrapf(err, "could not parse docker context %q", name)
+	}
+
+	docker, ok := meta.Endpoints["docker"]
+	if !ok || docker.Host == "" {
+		return nil, errors.Errorf("docker context %q has no docker endpoint", name)
+	}
+
+	endpoint := &contextEndpoint{host: docker.Host}
+
+	tlsDir := filepath.Join(dir, "contexts", "tls", hash, "docker")
+	options := tlsconfig.Options{
+		InsecureSkipVerify: docker.SkipTLSVerify,
+		ExclusiveRootPools: true,
+	}
+
+	if caPath := filepath.Join(tlsDir, "ca.pem"); fileExists(caPath) {
+		options.CAFile = caPath
+	}
+
+	if certPath := filepath.Join(tlsDir, "cert.pem"); fileExists(certPath) {
+		options.CertFile = certPath
+		options.KeyFile = filepath.Join(tlsDir, "key.pem")
+	}
+
+	// Like the Docker CLI, a context uses TLS when it has TLS material or when it
+	// skips verifying the daemon's certificate
+	if options.CAFile == "" && options.CertFile == "" && !docker.SkipTLSVerify {
+		return endpoint, nil
+	}
+
+	endpoint.tlsConfig, err = tlsconfig.Client(options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load TLS configuration of docker context %q", name)
+	}
+
+	return endpoint, nil
+}
+
+// withTLSConfig makes the client connect to the daemon over TLS with the given
+// configuration
+func withTLSConfig(config *tls.Config) client.Opt {
+	return func(cli *client.Client) error {
+		return client.WithHTTPClient(&http.Client{
+			Transport:     &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config},
+			CheckRedirect: client.CheckRedirect,
+		})(cli)
+	}
+}
+
+// currentContextName returns the name of the Docker CLI context that is currently in use
+func currentContextName() string {
+	if name := os.Getenv("DOCKER_CONTEXT"); name != "" {
+		return name
+	}
+
+	data, err := os.ReadFile(filepath.Join(dockerConfigDir(), "config.json"))
+	if err != nil {
+		return defaultContextName
+	}
+
+	config := struct {
+		CurrentContext string `json:"currentContext"`
+	}{}
+	if err := json.Unmarshal(data, &config); err != nil || config.CurrentContext == "" {
+		return defaultContextName
+	}
+
+	return config.CurrentContext
+}
+
+// dockerConfigDir returns the Docker CLI configuration directory
+func dockerConfigDir() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return dir
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".docker"
+	}
+
+	return filepath.Join(home, ".docker")
+}
+
+// contextDirName returns the directory name the Docker CLI stores a context under,
+// which is the hex-encoded SHA-256 digest of the context name
+func contextDirName(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:])
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,154 @@
+package docker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeContext stores a Docker CLI context in the config directory the way the CLI does
+func writeContext(t *testing.T, configDir, name, meta string) {
+	dir := filepath.Join(configDir, "contexts", "meta", contextDirName(name))
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "meta.json"), []byte(meta), 0o600))
+}
+
+func newTestConfigDir(t *testing.T) string {
+	configDir := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", configDir)
+	t.Setenv("DOCKER_CONTEXT", "")
+	t.Setenv("DOCKER_HOST", "")
+
+	writeContext(t, configDir, "remote", `{"Name": "remote", "Endpoints": {"docker": {"Host": "tcp://10.0.0.2:2375"}}}`)
+	writeContext(t, configDir, "insecure", `{"Name": "insecure", "Endpoints": {"docker": {"Host": "tcp://10.0.0.3:2376", "SkipTLSVerify": true}}}`)
+	writeContext(t, configDir, "kubernetes", `{"Name": "kubernetes", "Endpoints": {"kubernetes": {"Host": "https://10.0.0.4"}}}`)
+	writeContext(t, configDir, "broken", `{"Name": "broken", "Endpoints": `)
+
+	return configDir
+}
+
+func Test_currentContextName(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        string
+		dockerContext string
+		expected      string
+	}{
+		{name: "without config", expected: "default"},
+		{name: "with current context", config: `{"currentContext": "remote"}`, expected: "remote"},
+		{name: "with DOCKER_CONTEXT", config: `{"currentContext": "remote"}`, dockerContext: "insecure", expected: "insecure"},
+		{name: "without current context", config: `{"auths": {}}`, expected: "default"},
+		{name: "with invalid config", config: `{"currentContext": `, expected: "default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configDir := newTestConfigDir(t)
+			t.Setenv("DOCKER_CONTEXT", tt.dockerContext)
+
+			if tt.config != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(configDir, "config.json"), []byte(tt.config), 0o600))
+			}
+
+			assert.Equal(t, tt.expected, currentContextName())
+		})
+	}
+}
+
+func Test_readContextEndpoint(t *testing.T) {
+	newTestConfigDir(t)
+
+	tests := []struct {
+		name         string
+		context      string
+		expectedHost string
+		insecureTLS  bool
+		expectedErr  string
+	}{
+		{name: "default context", context: "default"},
+		{name: "no context", context: ""},
+		{name: "plain TCP", context: "remote", expectedHost: "tcp://10.0.0.2:2375"},
+		{name: "skipping TLS verification", context: "insecure", expectedHost: "tcp://10.0.0.3:2376", insecureTLS: true},
+		{name: "missing context", context: "missing", expectedErr: `could not read docker context "missing"`},
+		{name: "without docker endpoint", context: "kubernetes", expectedErr: `docker context "kubernetes" has no docker endpoint`},
+		{name: "invalid meta", context: "broken", expectedErr: `could not parse docker context "broken"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, err := readContextEndpoint(tt.context)
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectedHost == "" {
+				assert.Nil(t, endpoint)
+				return
+			}
+
+			assert.Equal(t, tt.expectedHost, endpoint.host)
+			if tt.insecureTLS {
+				require.NotNil(t, endpoint.tlsConfig)
+				assert.True(t, endpoint.tlsConfig.InsecureSkipVerify)
+			} else {
+				assert.Nil(t, endpoint.tlsConfig)
+			}
+		})
+	}
+}
+
+func Test_newDockerClient(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      Settings
+		dockerHost    string
+		dockerContext string
+		expected      string
+	}{
+		{name: "host setting", settings: Settings{host: "tcp://10.0.0.9:2375", context: "remote"}, dockerHost: "tcp://10.0.0.8:2375", expected: "tcp://10.0.0.9:2375"},
+		{name: "context setting", settings: Settings{context: "remote"}, dockerHost: "tcp://10.0.0.8:2375", expected: "tcp://10.0.0.2:2375"},
+		{name: "DOCKER_HOST", dockerHost: "tcp://10.0.0.8:2375", dockerContext: "remote", expected: "tcp://10.0.0.8:2375"},
+		{name: "DOCKER_CONTEXT", dockerContext: "remote", expected: "tcp://10.0.0.2:2375"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestConfigDir(t)
+			t.Setenv("DOCKER_HOST", tt.dockerHost)
+			t.Setenv("DOCKER_CONTEXT", tt.dockerContext)
+
+			cli, err := newDockerClient(&tt.settings)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cli.DaemonHost())
+		})
+	}
+}
+
+func Test_newDockerClient_skipTLSVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Api-Version", "1.47")
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	configDir := newTestConfigDir(t)
+	host := "tcp://" + strings.TrimPrefix(server.URL, "https://")
+	writeContext(t, configDir, "selfsigned", `{"Name": "selfsigned", "Endpoints": {"docker": {"Host": "`+host+`", "SkipTLSVerify": true}}}`)
+
+	cli, err := newDockerClient(&Settings{context: "selfsigned"})
+	require.NoError(t, err)
+
+	// The daemon's self-signed certificate is accepted, and only over TLS
+	_, err = cli.Ping(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+package docker
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/wtfutil/wtf/utils"
+)
+
+var stateColors = map[string]string{
+	"created":    "green",
+	"running":    "lime",
+	"paused":     "yellow",
+	"restarting": "yellow",
+	"removing":   "yellow",
+	"exited":     "red",
+	"dead":       "red",
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		return title, widget.err.Error(), true
+	}
+
+	str := ""
+
+	if widget.settings.showSystemInfo {
+		str += fmt.Sprintf("[%s] System[white]\n", widget.settings.Colors.Subheading)
+		str += widget.systemInfo
+		str += "\n"
+	}
+
+	str += fmt.Sprintf("[%s] Containers[white]\n", widget.settings.Colors.Subheading)
+	str += widget.containerRows()
+
+	return title, str, false
+}
+
+func (widget *Widget) containerRows() string {
+	if len(widget.containers) == 0 {
+		return " no containers"
+	}
+
+	names := make([]string, len(widget.containers))
+	for i, c := range widget.containers {
+		names[i] = c.Name
+	}
+
+	padSlice(false, names, func(i int) string {
+		return names[i]
+	}, func(i int, val string) {
+		names[i] = val
+	})
+
+	result := ""
+	for idx, c := range widget.containers {
+		state, stateColor := c.State, stateColors[c.State]
+		if status, ok := widget.actions[c.ID]; ok {
+			state, stateColor = status, "yellow"
+		}
+
+		row := fmt.Sprintf("[%s]%s [%s]%-10s", widget.RowColor(idx), names[idx], stateColor, state)
+		length := len(names[idx]) + 11
+
+		if widget.settings.showStats && widget.stats != nil {
+			if stats, ok := widget.stats.get(c.ID); ok {
+				usage := fmt.Sprintf(
+					"%5.1f%% %s",
+					stats.CPUPercent,
+					humanize.Bytes(stats.MemoryUsage),
+				)
+				row += fmt.Sprintf(" [%s]%s", widget.RowColor(idx), usage)
+				length += len(usage) + 1
+			}
+		}
+
+		result += utils.HighlightableHelper(widget.View, row, idx, length)
+	}
+
+	return result
+}
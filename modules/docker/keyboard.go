@@ -0,0 +1,21 @@
+package docker
+
+import "github.com/gdamore/tcell/v2"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next container")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous container")
+	widget.SetKeyboardChar("s", widget.startSelected, "Start container")
+	widget.SetKeyboardChar("x", widget.stopSelected, "Stop container")
+	widget.SetKeyboardChar("R", widget.restartSelected, "Restart container")
+	widget.SetKeyboardChar("d", widget.removeSelected, "Remove container")
+	widget.SetKeyboardChar("l", widget.showLogs, "Tail container logs")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next container")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous container")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.showLogs, "Tail container logs")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
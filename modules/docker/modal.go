@@ -0,0 +1,86 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+const (
+	logsModalHeight = 30
+	logsModalWidth  = 120
+)
+
+// showLogs follows the logs of the selected container in a modal until it is closed
+func (widget *Widget) showLogs() {
+	c := widget.selectedContainer()
+	if c == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	textView := tview.NewTextView()
+	textView.SetDynamicColors(false)
+	textView.SetScrollable(true)
+	textView.SetWrap(true)
+	textView.SetChangedFunc(func() {
+		textView.ScrollToEnd()
+		widget.tviewApp.Draw()
+	})
+
+	frame := view.NewModalFrame(textView, logsModalWidth, logsModalHeight)
+	frame.SetTitle(fmt.Sprintf("  %s logs (Esc to close)  ", c.Name))
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			cancel()
+			widget.pages.RemovePage("logs")
+			widget.tviewApp.SetFocus(widget.View)
+			return nil
+		}
+		return event
+	})
+
+	widget.pages.AddPage("logs", frame, false, true)
+	widget.tviewApp.SetFocus(textView)
+
+	go widget.streamLogs(ctx, c.ID, textView)
+}
+
+// streamLogs copies the logs of a container into the given text view until the context
+// is cancelled or the container stops
+func (widget *Widget) streamLogs(ctx context.Context, id string, textView *tview.TextView) {
+	inspect, err := widget.cli.ContainerInspect(ctx, id)
+	if err != nil {
+		_, _ = fmt.Fprintln(textView, err.Error())
+		return
+	}
+
+	logs, err := widget.cli.ContainerLogs(ctx, id, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Tail:       strconv.Itoa(widget.settings.logLines),
+	})
+	if err != nil {
+		_, _ = fmt.Fprintln(textView, err.Error())
+		return
+	}
+	defer func() { _ = logs.Close() }()
+
+	// Containers without a TTY multiplex stdout and stderr into a single stream
+	if inspect.Config != nil && inspect.Config.Tty {
+		_, _ = io.Copy(textView, logs)
+		return
+	}
+
+	_, _ = stdcopy.StdCopy(textView, textView, logs)
+}
@@ -3,10 +3,11 @@ package docker
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
-	defaultFocusable = false
+	defaultFocusable = true
 	defaultTitle     = "docker"
 )
 
@@ -14,14 +15,26 @@ const (
 type Settings struct {
 	*cfg.Common
 
-	labelColor string
+	labelColor      string   `help:"The color of the labels in the system section." optional:"true"`
+	host            string   `help:"The Docker daemon to connect to, for example tcp://10.0.0.2:2376. Overrides DOCKER_HOST and any context." optional:"true"`
+	context         string   `help:"The name of the Docker CLI context to use. Overrides DOCKER_HOST and the current context." optional:"true"`
+	composeProjects []string `help:"Only show containers that belong to these Docker Compose projects." optional:"true"`
+	showStats       bool     `help:"Whether to show live CPU and memory usage of running containers." optional:"true"`
+	showSystemInfo  bool     `help:"Whether to show the system information and disk usage section." optional:"true"`
+	logLines        int      `help:"The number of existing log lines to show when tailing container logs." optional:"true"`
 }
 
 // NewSettingsFromYAML creates and returns an instance of Settings with configuration options populated
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
 	settings := Settings{
-		Common:     cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
-		labelColor: ymlConfig.UString("labelColor", "white"),
+		Common:          cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+		labelColor:      ymlConfig.UString("labelColor", "white"),
+		host:            ymlConfig.UString("host"),
+		context:         ymlConfig.UString("context"),
+		composeProjects: utils.ToStrs(ymlConfig.UList("composeProjects")),
+		showStats:       ymlConfig.UBool("showStats", true),
+		showSystemInfo:  ymlConfig.UBool("showSystemInfo", true),
+		logLines:        ymlConfig.UInt("logLines", 100),
 	}
 
 	return &settings
@@ -0,0 +1,142 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// containerStats is the most recent resource usage reported for a container
+type containerStats struct {
+	CPUPercent  float64
+	MemoryUsage uint64
+	MemoryLimit uint64
+}
+
+// statsCollector keeps one stats stream open per running container and records
+// the latest values it reports
+type statsCollector struct {
+	cli *client.Client
+
+	mutex   sync.Mutex
+	cancels map[string]context.CancelFunc
+	stats   map[string]containerStats
+}
+
+func newStatsCollector(cli *client.Client) *statsCollector {
+	return &statsCollector{
+		cli:     cli,
+		cancels: make(map[string]context.CancelFunc),
+		stats:   make(map[string]containerStats),
+	}
+}
+
+// sync starts streams for running containers that don't have one yet, and stops
+// the streams of containers that are no longer running
+func (collector *statsCollector) sync(containers []containerInfo) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	running := map[string]bool{}
+	for _, c := range containers {
+		if c.State != "running" {
+			continue
+		}
+
+		running[c.ID] = true
+
+		if _, ok := collector.cancels[c.ID]; !ok {
+			ctx, cancel := context.WithCancel(context.Background())
+			collector.cancels[c.ID] = cancel
+			go collector.stream(ctx, c.ID)
+		}
+	}
+
+	for id, cancel := range collector.cancels {
+		if !running[id] {
+			cancel()
+			delete(collector.cancels, id)
+			delete(collector.stats, id)
+		}
+	}
+}
+
+// get returns the latest stats for a container, if any have been received
+func (collector *statsCollector) get(id string) (containerStats, bool) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	stats, ok := collector.stats[id]
+	return stats, ok
+}
+
+// stream reads the stats stream of a container until the context is cancelled or the
+// stream ends, for example because the container stopped
+func (collector *statsCollector) stream(ctx context.Context, id string) {
+	defer func() {
+		// A cancelled stream has already been cleaned up by sync, and the container
+		// may already have a new stream
+		if ctx.Err() != nil {
+			return
+		}
+
+		collector.mutex.Lock()
+		if cancel, ok := collector.cancels[id]; ok {
+			cancel()
+		}
+		delete(collector.cancels, id)
+		delete(collector.stats, id)
+		collector.mutex.Unlock()
+	}()
+
+	resp, err := collector.cli.ContainerStats(ctx, id, true)
+	if err != nil {
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var stats container.StatsResponse
+		if err := decoder.Decode(&stats); err != nil {
+			return
+		}
+
+		collector.mutex.Lock()
+		collector.stats[id] = calculateStats(&stats)
+		collector.mutex.Unlock()
+	}
+}
+
+// calculateStats turns a raw stats sample into CPU and memory usage, using the same
+// calculations as `docker stats`
+func calculateStats(stats *container.StatsResponse) containerStats {
+	result := containerStats{
+		MemoryUsage: stats.MemoryStats.Usage,
+		MemoryLimit: stats.MemoryStats.Limit,
+	}
+
+	// Page cache isn't counted as used memory. The key differs between cgroup v1 and v2
+	if cache, ok := stats.MemoryStats.Stats["total_inactive_file"]; ok && cache < result.MemoryUsage {
+		result.MemoryUsage -= cache
+	} else if cache, ok := stats.MemoryStats.Stats["inactive_file"]; ok && cache < result.MemoryUsage {
+		result.MemoryUsage -= cache
+	}
+
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	if cpuDelta > 0 && systemDelta > 0 {
+		result.CPUPercent = (cpuDelta / systemDelta) * onlineCPUs * 100.0
+	}
+
+	return result
+}
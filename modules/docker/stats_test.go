@@ -0,0 +1,44 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_calculateStats(t *testing.T) {
+	stats := &container.StatsResponse{}
+	stats.CPUStats.CPUUsage.TotalUsage = 300
+	stats.CPUStats.SystemUsage = 2000
+	stats.CPUStats.OnlineCPUs = 4
+	stats.PreCPUStats.CPUUsage.TotalUsage = 100
+	stats.PreCPUStats.SystemUsage = 1000
+	stats.MemoryStats.Usage = 1000
+	stats.MemoryStats.Limit = 4000
+	stats.MemoryStats.Stats = map[string]uint64{"inactive_file": 200}
+
+	result := calculateStats(stats)
+
+	assert.InDelta(t, 80.0, result.CPUPercent, 0.001)
+	assert.Equal(t, uint64(800), result.MemoryUsage)
+	assert.Equal(t, uint64(4000), result.MemoryLimit)
+}
+
+func Test_calculateStats_firstSample(t *testing.T) {
+	stats := &container.StatsResponse{}
+	stats.CPUStats.CPUUsage.TotalUsage = 300
+	stats.CPUStats.CPUUsage.PercpuUsage = []uint64{150, 150}
+
+	result := calculateStats(stats)
+
+	assert.Equal(t, 0.0, result.CPUPercent)
+}
+
+func Test_contextDirName(t *testing.T) {
+	assert.Equal(
+		t,
+		"fe9c6bd7a66301f49ca9b6a70b217107cd1284598bfc254700c989b916da791e",
+		contextDirName("desktop-linux"),
+	)
+}
@@ -1,8 +1,10 @@
 package docker
 
 import (
-	"fmt"
+	"context"
+	"sync"
 
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
 	"github.com/pkg/errors"
 	"github.com/rivo/tview"
@@ -10,28 +12,43 @@ import (
 )
 
 type Widget struct {
-	view.TextWidget
-	cli           *client.Client
-	settings      *Settings
-	displayBuffer string
+	view.ScrollableWidget
+
+	// actions are the containers that an action is being performed on, and what's
+	// being done to them
+	actions    map[string]string
+	cli        *client.Client
+	containers []containerInfo
+	err        error
+	mutex      sync.Mutex
+	pages      *tview.Pages
+	settings   *Settings
+	stats      *statsCollector
+	systemInfo string
+	tviewApp   *tview.Application
 }
 
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
-		settings:   settings,
-	}
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
-	widget.View.SetScrollable(true)
+		actions:  map[string]string{},
+		pages:    pages,
+		settings: settings,
+		tviewApp: tviewApp,
+	}
 
-	cli, err := client.NewClientWithOpts(client.FromEnv)
+	cli, err := newDockerClient(settings)
 	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client").Error()
+		widget.err = errors.Wrap(err, "could not create client")
 	} else {
 		widget.cli = cli
+		widget.stats = newStatsCollector(cli)
 	}
 
-	widget.refreshDisplayBuffer()
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
 
 	return &widget
 }
@@ -39,28 +56,123 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.refreshDisplayBuffer()
-	widget.Redraw(widget.display)
+	if widget.cli == nil {
+		widget.display()
+		return
+	}
+
+	systemInfo := ""
+	if widget.settings.showSystemInfo {
+		systemInfo = widget.getSystemInfo()
+	}
+
+	containers, err := widget.getContainers()
+	if err == nil && widget.settings.showStats {
+		widget.stats.sync(containers)
+	}
+
+	widget.mutex.Lock()
+	widget.systemInfo = systemInfo
+	widget.containers = containers
+	widget.err = err
+	widget.SetItemCount(len(containers))
+	widget.mutex.Unlock()
+
+	widget.display()
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) display() (string, string, bool) {
-	return widget.CommonSettings().Title, widget.displayBuffer, true
+// selectedContainer returns the currently-selected container, if there is one
+func (widget *Widget) selectedContainer() *containerInfo {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if widget.Selected < 0 || widget.Selected >= len(widget.containers) {
+		return nil
+	}
+
+	c := widget.containers[widget.Selected]
+	return &c
 }
 
-func (widget *Widget) refreshDisplayBuffer() {
-	if widget.cli == nil {
+func (widget *Widget) startSelected() {
+	c := widget.selectedContainer()
+	if c == nil {
+		return
+	}
+
+	widget.performAction(c, "starting", func() error {
+		return widget.cli.ContainerStart(context.Background(), c.ID, container.StartOptions{})
+	})
+}
+
+func (widget *Widget) stopSelected() {
+	c := widget.selectedContainer()
+	if c == nil {
+		return
+	}
+
+	widget.performAction(c, "stopping", func() error {
+		return widget.cli.ContainerStop(context.Background(), c.ID, container.StopOptions{})
+	})
+}
+
+func (widget *Widget) restartSelected() {
+	c := widget.selectedContainer()
+	if c == nil {
 		return
 	}
 
-	widget.displayBuffer = ""
+	widget.performAction(c, "restarting", func() error {
+		return widget.cli.ContainerRestart(context.Background(), c.ID, container.StopOptions{})
+	})
+}
+
+func (widget *Widget) removeSelected() {
+	c := widget.selectedContainer()
+	if c == nil {
+		return
+	}
+
+	widget.ShowConfirm("Remove container "+c.Name+"?", func() {
+		widget.performAction(c, "removing", func() error {
+			return widget.cli.ContainerRemove(context.Background(), c.ID, container.RemoveOptions{Force: true})
+		})
+	})
+}
+
+// performAction runs a container action in the background, as stopping a container
+// can take a while, and then refreshes the widget. Until it's done, the container
+// shows what's being done to it. If the action fails, the error is displayed in place
+// of the container list until the next refresh
+func (widget *Widget) performAction(c *containerInfo, status string, action func() error) {
+	widget.mutex.Lock()
+	if _, busy := widget.actions[c.ID]; busy {
+		widget.mutex.Unlock()
+		return
+	}
+	widget.actions[c.ID] = status
+	widget.mutex.Unlock()
+
+	widget.display()
+
+	go func() {
+		err := action()
+
+		widget.mutex.Lock()
+		delete(widget.actions, c.ID)
+		widget.mutex.Unlock()
 
-	widget.displayBuffer += fmt.Sprintf("[%s] System[white]\n", widget.settings.Colors.Subheading)
-	widget.displayBuffer += widget.getSystemInfo()
+		if err != nil {
+			widget.mutex.Lock()
+			widget.err = err
+			widget.mutex.Unlock()
 
-	widget.displayBuffer += "\n"
+			widget.display()
+			return
+		}
 
-	widget.displayBuffer += fmt.Sprintf("[%s] Containers[white]\n", widget.settings.Colors.Subheading)
-	widget.displayBuffer += widget.getContainerStates()
+		widget.Refresh()
+	}()
 }
@@ -0,0 +1,137 @@
+package docker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDaemon answers the Docker API requests the widget makes. Stopping a container
+// takes until release is closed
+type fakeDaemon struct {
+	mutex    sync.Mutex
+	requests []string
+	release  chan struct{}
+	failStop bool
+}
+
+func (daemon *fakeDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if idx := strings.Index(path[1:], "/"); strings.HasPrefix(path, "/v1.") && idx > 0 {
+		path = path[idx+1:]
+	}
+
+	daemon.mutex.Lock()
+	daemon.requests = append(daemon.requests, r.Method+" "+path)
+	daemon.mutex.Unlock()
+
+	w.Header().Set("Api-Version", "1.47")
+
+	switch {
+	case path == "/_ping":
+		_, _ = w.Write([]byte("OK"))
+	case path == "/containers/json":
+		_, _ = w.Write([]byte(`[
+			{"Id": "b", "Names": ["/web"], "State": "running"},
+			{"Id": "a", "Names": ["/db"], "State": "running"}
+		]`))
+	case path == "/containers/a/stop":
+		<-daemon.release
+		if daemon.failStop {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"message": "cannot stop container"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (daemon *fakeDaemon) count(request string) int {
+	daemon.mutex.Lock()
+	defer daemon.mutex.Unlock()
+
+	count := 0
+	for _, r := range daemon.requests {
+		if r == request {
+			count++
+		}
+	}
+	return count
+}
+
+func newTestWidget(t *testing.T, daemon *fakeDaemon) *Widget {
+	server := httptest.NewServer(daemon)
+	t.Cleanup(server.Close)
+
+	ymlConfig, _ := config.ParseYaml("host: tcp://" + strings.TrimPrefix(server.URL, "http://") + "\nshowStats: false\nshowSystemInfo: false")
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+	settings := NewSettingsFromYAML("docker", ymlConfig, globalConfig)
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+	require.NoError(t, widget.err)
+
+	widget.Refresh()
+	waitForRedraw(t, widget)
+	require.NoError(t, widget.err)
+	require.Len(t, widget.containers, 2)
+
+	return widget
+}
+
+// waitForRedraw waits until the widget has been displayed again
+func waitForRedraw(t *testing.T, widget *Widget) {
+	select {
+	case <-widget.RedrawChan:
+	case <-time.After(time.Second):
+		t.Fatal("the widget wasn't redrawn")
+	}
+}
+
+func Test_stopSelected(t *testing.T) {
+	daemon := &fakeDaemon{release: make(chan struct{})}
+	widget := newTestWidget(t, daemon)
+
+	widget.Selected = 0
+	assert.Equal(t, "db", widget.selectedContainer().Name)
+
+	// The key handler returns while the container is being stopped
+	widget.stopSelected()
+	waitForRedraw(t, widget)
+
+	_, content, _ := widget.content()
+	assert.Contains(t, content, "stopping")
+
+	close(daemon.release)
+	waitForRedraw(t, widget)
+
+	assert.Equal(t, 1, daemon.count("POST /containers/a/stop"))
+	assert.Equal(t, 2, daemon.count("GET /containers/json"))
+
+	_, content, _ = widget.content()
+	assert.NotContains(t, content, "stopping")
+}
+
+func Test_stopSelected_error(t *testing.T) {
+	daemon := &fakeDaemon{release: make(chan struct{}), failStop: true}
+	close(daemon.release)
+	widget := newTestWidget(t, daemon)
+
+	widget.Selected = 0
+	widget.stopSelected()
+	waitForRedraw(t, widget)
+	waitForRedraw(t, widget)
+
+	_, content, _ := widget.content()
+	assert.Contains(t, content, "cannot stop container")
+	assert.Equal(t, 1, daemon.count("GET /containers/json"))
+}
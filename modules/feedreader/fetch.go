@@ -0,0 +1,158 @@
+package feedreader
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/sync/errgroup"
+)
+
+// fetchLimit is how many feeds are fetched at once
+const fetchLimit = 4
+
+// cachedFeed is the last response of a feed. Its ETag and Last-Modified headers are
+// sent with the next request, so that the feed is only downloaded again once it has
+// changed
+type cachedFeed struct {
+	etag         string
+	lastModified string
+	feed         *gofeed.Feed
+}
+
+// Fetch retrieves RSS and Atom feed data. A feed that fails doesn't prevent the
+// others' stories from being displayed: its error is returned along with them, and
+// the stories it had the last time it was fetched are kept
+func (widget *Widget) Fetch(feedURLs []string) ([]*FeedItem, []error) {
+	results := make([][]*FeedItem, len(feedURLs))
+	errs := make([]error, len(feedURLs))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, feedURL := range feedURLs {
+		group.Go(func() error {
+			results[i], errs[i] = widget.fetchForFeed(feedURL)
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	data := []*FeedItem{}
+	failed := []error{}
+
+	for i, result := range results {
+		data = append(data, result...)
+
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+		}
+	}
+
+	data = widget.sort(data)
+
+	return data, failed
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) fetchForFeed(feedURL string) ([]*FeedItem, error) {
+	cached := widget.cachedFeed(feedURL)
+
+	feed, err := widget.fetchFeed(feedURL, cached)
+	if err != nil {
+		name := feedURL
+		if cached != nil && cached.feed.Title != "" {
+			name = cached.feed.Title
+		}
+
+		err = fmt.Errorf("%s: %w", name, err)
+
+		if cached == nil {
+			return nil, err
+		}
+
+		feed = cached.feed
+	}
+
+	var feedItems []*FeedItem
+
+	for idx, gofeedItem := range feed.Items {
+		if widget.settings.feedLimit >= 1 && idx >= widget.settings.feedLimit {
+			// We only want to get the widget.settings.feedLimit latest articles,
+			// not all of them. To get all, set feedLimit to < 1
+			break
+		}
+
+		feedItem := &FeedItem{
+			item:        gofeedItem,
+			sourceTitle: feed.Title,
+			viewed:      false,
+		}
+
+		feedItems = append(feedItems, feedItem)
+	}
+
+	return feedItems, err
+}
+
+// fetchFeed downloads and parses a feed, unless it hasn't changed since it was cached
+func (widget *Widget) fetchFeed(feedURL string, cached *cachedFeed) (*gofeed.Feed, error) {
+	req, err := http.NewRequest(http.MethodGet, feedURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", widget.settings.userAgent)
+
+	if auth, isPrivateRSS := widget.settings.credentials[feedURL]; isPrivateRSS {
+		req.SetBasicAuth(auth.username, auth.password)
+	}
+
+	if cached != nil {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	resp, err := widget.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return cached.feed, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("fetching the feed failed: %s", resp.Status)
+	}
+
+	// A parser isn't safe to share between goroutines, so each feed gets its own
+	feed, err := gofeed.NewParser().Parse(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	widget.cacheMutex.Lock()
+	widget.cache[feedURL] = &cachedFeed{
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+		feed:         feed,
+	}
+	widget.cacheMutex.Unlock()
+
+	return feed, nil
+}
+
+func (widget *Widget) cachedFeed(feedURL string) *cachedFeed {
+	widget.cacheMutex.Lock()
+	defer widget.cacheMutex.Unlock()
+
+	return widget.cache[feedURL]
+}
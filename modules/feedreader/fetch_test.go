@@ -0,0 +1,111 @@
+package feedreader
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+const testFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Test Feed</title>
+    <item>
+      <title>First story</title>
+      <link>https://example.com/1</link>
+      <pubDate>Mon, 02 Jan 2006 15:04:05 GMT</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+func testWidget(settings *Settings) *Widget {
+	return &Widget{
+		cache:    map[string]*cachedFeed{},
+		client:   http.DefaultClient,
+		settings: settings,
+	}
+}
+
+func Test_Fetch_conditional(t *testing.T) {
+	downloads := 0
+	fail := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		downloads++
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testFeed))
+	}))
+	defer server.Close()
+
+	widget := testWidget(&Settings{feedLimit: -1})
+
+	stories, errs := widget.Fetch([]string{server.URL})
+	assert.Equal(t, 0, len(errs))
+	assert.Equal(t, 1, len(stories))
+	assert.Equal(t, "Test Feed", stories[0].sourceTitle)
+
+	stories, errs = widget.Fetch([]string{server.URL})
+	assert.Equal(t, 0, len(errs))
+	assert.Equal(t, 1, len(stories))
+	assert.Equal(t, 1, downloads)
+
+	// A feed that fails keeps the stories it had, and is marked as failed
+	fail = true
+
+	stories, errs = widget.Fetch([]string{server.URL})
+	assert.Equal(t, 1, len(stories))
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorContains(t, errs[0], "Test Feed: fetching the feed failed: 503")
+}
+
+func Test_Fetch_failedFeed(t *testing.T) {
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testFeed))
+	}))
+	defer good.Close()
+
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not a feed"))
+	}))
+	defer bad.Close()
+
+	widget := testWidget(&Settings{feedLimit: -1})
+
+	stories, errs := widget.Fetch([]string{bad.URL, good.URL})
+	assert.Equal(t, 1, len(stories))
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorContains(t, errs[0], bad.URL)
+}
+
+func Test_feedURLs(t *testing.T) {
+	widget := testWidget(&Settings{
+		feeds: []string{"https://news.ycombinator.com/rss", "https://example.com/feed"},
+		opml:  "testdata/feeds.opml",
+	})
+
+	feeds, err := widget.feedURLs()
+	assert.NilError(t, err)
+	assert.DeepEqual(
+		t,
+		[]string{"https://news.ycombinator.com/rss", "https://example.com/feed", "https://go.dev/blog/feed.atom"},
+		feeds,
+	)
+
+	widget.settings.opml = "testdata/missing.opml"
+
+	feeds, err = widget.feedURLs()
+	assert.ErrorContains(t, err, "importing feeds failed")
+	assert.Equal(t, 2, len(feeds))
+}
@@ -10,9 +10,11 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("k", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("o", widget.openStory, "Open story in browser")
 	widget.SetKeyboardChar("t", widget.toggleDisplayText, "Toggle display between title, link and title+content")
+	widget.SetKeyboardChar("m", widget.toggleRead, "Mark story as read or unread")
+	widget.SetKeyboardChar("R", widget.markAllRead, "Mark all stories as read")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
-	widget.SetKeyboardKey(tcell.KeyEnter, widget.openStory, "Open story in browser")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.showReader, "Read story")
 	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
 }
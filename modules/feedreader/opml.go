@@ -0,0 +1,84 @@
+package feedreader
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+// opmlOutline is an entry of an OPML subscription list. Feeds have an xmlUrl,
+// folders have outlines of their own
+type opmlOutline struct {
+	XMLURL   string        `xml:"xmlUrl,attr"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+type opmlDocument struct {
+	Outlines []opmlOutline `xml:"body>outline"`
+}
+
+// feedsFromOPML reads the feed URLs of an OPML subscription list, such as one
+// exported from another feed reader
+func feedsFromOPML(path string) ([]string, error) {
+	path, err := utils.ExpandHomeDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := opmlDocument{}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s is not a valid OPML file: %w", path, err)
+	}
+
+	return opmlFeeds(doc.Outlines), nil
+}
+
+func opmlFeeds(outlines []opmlOutline) []string {
+	feeds := []string{}
+
+	for _, outline := range outlines {
+		if outline.XMLURL != "" {
+			feeds = append(feeds, outline.XMLURL)
+		}
+
+		feeds = append(feeds, opmlFeeds(outline.Outlines)...)
+	}
+
+	return feeds
+}
+
+// feedURLs returns the configured feeds followed by those of the OPML file, once each
+func (widget *Widget) feedURLs() ([]string, error) {
+	feeds := widget.settings.feeds
+
+	var err error
+	if widget.settings.opml != "" {
+		var imported []string
+		imported, err = feedsFromOPML(widget.settings.opml)
+		if err != nil {
+			err = fmt.Errorf("importing feeds failed: %w", err)
+		}
+
+		feeds = append(append([]string{}, feeds...), imported...)
+	}
+
+	seen := map[string]bool{}
+	urls := []string{}
+	for _, feed := range feeds {
+		if seen[feed] {
+			continue
+		}
+
+		seen[feed] = true
+		urls = append(urls, feed)
+	}
+
+	return urls, err
+}
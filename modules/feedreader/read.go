@@ -0,0 +1,110 @@
+package feedreader
+
+import (
+	"fmt"
+	"strings"
+)
+
+// applyReadState marks the stories that have been read before as viewed
+func (widget *Widget) applyReadState() {
+	if widget.readState == nil {
+		return
+	}
+
+	for _, story := range widget.stories {
+		story.viewed = widget.readState.isRead(story.key())
+	}
+}
+
+// setRead marks stories as read or unread, and remembers it
+func (widget *Widget) setRead(read bool, stories ...*FeedItem) {
+	keys := []string{}
+	for _, story := range stories {
+		story.viewed = read
+		keys = append(keys, story.key())
+	}
+
+	if widget.readState == nil {
+		return
+	}
+
+	widget.readState.setRead(read, keys...)
+
+	current := map[string]bool{}
+	for _, story := range widget.stories {
+		current[story.key()] = true
+	}
+	widget.readState.prune(current)
+
+	widget.stateErr = widget.readState.save()
+}
+
+// toggleRead marks the selected story as read, or as unread if it has been read
+func (widget *Widget) toggleRead() {
+	story := widget.selectedStory()
+	if story == nil {
+		return
+	}
+
+	widget.setRead(!story.viewed, story)
+	widget.Render()
+}
+
+// markAllRead marks every story as read
+func (widget *Widget) markAllRead() {
+	widget.setRead(true, widget.stories...)
+	widget.Render()
+}
+
+func (widget *Widget) selectedStory() *FeedItem {
+	sel := widget.GetSelected()
+	if sel < 0 || sel >= len(widget.stories) {
+		return nil
+	}
+
+	return widget.stories[sel]
+}
+
+func (widget *Widget) title() string {
+	return unreadTitle(widget.CommonSettings().Title, widget.stories)
+}
+
+// unreadTitle adds how many stories are unread to a title, in total and in each feed
+func unreadTitle(title string, stories []*FeedItem) string {
+	sources := []string{}
+	unread := map[string]int{}
+	total := 0
+
+	for _, story := range stories {
+		if story.viewed {
+			continue
+		}
+
+		if _, ok := unread[story.sourceTitle]; !ok {
+			sources = append(sources, story.sourceTitle)
+		}
+		unread[story.sourceTitle]++
+		total++
+	}
+
+	if total == 0 {
+		return title
+	}
+
+	title = fmt.Sprintf("%s - %d unread", title, total)
+
+	if len(sources) < 2 {
+		return title
+	}
+
+	counts := []string{}
+	for _, source := range sources {
+		name := source
+		if name == "" {
+			name = "Untitled"
+		}
+		counts = append(counts, fmt.Sprintf("%s: %d", name, unread[source]))
+	}
+
+	return fmt.Sprintf("%s (%s)", title, strings.Join(counts, ", "))
+}
@@ -0,0 +1,115 @@
+package feedreader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// readStateRetention is how long items that are no longer in any feed are
+	// remembered as read
+	readStateRetention = 90 * 24 * time.Hour
+)
+
+// readState remembers which feed items have been read, and when, so that they stay
+// read between refreshes and runs. Items are identified by their GUID, or their link
+// if they don't have one
+type readState struct {
+	path string
+	Read map[string]time.Time `yaml:"read"`
+}
+
+// readStatePath returns where the read state of a module is stored in the config
+// directory. Each module has its own, as they read different feeds
+func readStatePath(name string) (string, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, filepath.Base(name)+".data"), nil
+}
+
+// loadReadState reads the read state that's stored at path. A state that hasn't been
+// stored yet has no items read. Without a path, the state isn't stored
+func loadReadState(path string) (*readState, error) {
+	state := &readState{path: path, Read: map[string]time.Time{}}
+	if path == "" {
+		return state, nil
+	}
+
+	fileData, err := utils.ReadFileBytes(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+		return state, err
+	}
+
+	if err := yaml.Unmarshal(fileData, state); err != nil {
+		return state, err
+	}
+
+	if state.Read == nil {
+		state.Read = map[string]time.Time{}
+	}
+
+	return state, nil
+}
+
+func (state *readState) isRead(key string) bool {
+	_, ok := state.Read[key]
+	return ok
+}
+
+// setRead marks items as read or unread
+func (state *readState) setRead(read bool, keys ...string) {
+	now := time.Now()
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+
+		if !read {
+			delete(state.Read, key)
+			continue
+		}
+
+		if !state.isRead(key) {
+			state.Read[key] = now
+		}
+	}
+}
+
+// prune forgets the items that were read long ago and are no longer in any feed, so
+// that the state doesn't keep growing
+func (state *readState) prune(current map[string]bool) {
+	cutoff := time.Now().Add(-readStateRetention)
+
+	for key, readAt := range state.Read {
+		if !current[key] && readAt.Before(cutoff) {
+			delete(state.Read, key)
+		}
+	}
+}
+
+// save stores the read state
+func (state *readState) save() error {
+	if state.path == "" {
+		return nil
+	}
+
+	fileData, err := yaml.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(state.path, fileData, 0600)
+}
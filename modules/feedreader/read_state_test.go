@@ -0,0 +1,72 @@
+package feedreader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func Test_readState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feedreader.data")
+
+	state, err := loadReadState(path)
+	assert.NilError(t, err)
+	assert.Equal(t, false, state.isRead("https://example.com/1"))
+
+	state.setRead(true, "https://example.com/1", "guid-2", "")
+	assert.Equal(t, true, state.isRead("https://example.com/1"))
+	assert.Equal(t, true, state.isRead("guid-2"))
+	assert.Equal(t, false, state.isRead(""))
+	assert.NilError(t, state.save())
+
+	loaded, err := loadReadState(path)
+	assert.NilError(t, err)
+	assert.Equal(t, true, loaded.isRead("https://example.com/1"))
+	assert.Equal(t, true, loaded.isRead("guid-2"))
+
+	loaded.setRead(false, "guid-2")
+	assert.Equal(t, false, loaded.isRead("guid-2"))
+}
+
+func Test_readStatePath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/config")
+
+	path, err := readStatePath("feedreader")
+	assert.NilError(t, err)
+	assert.Equal(t, "/config/wtf/feedreader.data", path)
+
+	path, err = readStatePath("news")
+	assert.NilError(t, err)
+	assert.Equal(t, "/config/wtf/news.data", path)
+}
+
+func Test_readState_invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feedreader.data")
+	assert.NilError(t, os.WriteFile(path, []byte("read: [not, a, map"), 0600))
+
+	state, err := loadReadState(path)
+	assert.Assert(t, err != nil)
+	assert.Equal(t, 0, len(state.Read))
+}
+
+func Test_readState_prune(t *testing.T) {
+	state, err := loadReadState("")
+	assert.NilError(t, err)
+
+	old := time.Now().Add(-readStateRetention - time.Hour)
+	state.Read["old-gone"] = old
+	state.Read["old-current"] = old
+	state.Read["recent-gone"] = time.Now()
+
+	state.prune(map[string]bool{"old-current": true})
+
+	assert.Equal(t, false, state.isRead("old-gone"))
+	assert.Equal(t, true, state.isRead("old-current"))
+	assert.Equal(t, true, state.isRead("recent-gone"))
+
+	// Without a path, nothing is stored
+	assert.NilError(t, state.save())
+}
@@ -0,0 +1,64 @@
+package feedreader
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"gotest.tools/assert"
+)
+
+func Test_unreadTitle(t *testing.T) {
+	story := func(source string, viewed bool) *FeedItem {
+		return &FeedItem{item: &gofeed.Item{}, sourceTitle: source, viewed: viewed}
+	}
+
+	assert.Equal(t, "Feeds", unreadTitle("Feeds", nil))
+	assert.Equal(t, "Feeds", unreadTitle("Feeds", []*FeedItem{story("HN", true)}))
+	assert.Equal(t, "Feeds - 2 unread", unreadTitle("Feeds", []*FeedItem{story("HN", false), story("HN", false)}))
+	assert.Equal(
+		t,
+		"Feeds - 3 unread (Lobsters: 2, HN: 1)",
+		unreadTitle("Feeds", []*FeedItem{story("Lobsters", false), story("HN", false), story("HN", true), story("Lobsters", false)}),
+	)
+}
+
+func Test_setRead(t *testing.T) {
+	state, err := loadReadState(filepath.Join(t.TempDir(), "feedreader.data"))
+	assert.NilError(t, err)
+
+	first := &FeedItem{item: &gofeed.Item{GUID: "guid-1", Link: "https://example.com/1"}}
+	second := &FeedItem{item: &gofeed.Item{Link: "https://example.com/2"}}
+
+	widget := &Widget{readState: state, stories: []*FeedItem{first, second}}
+
+	widget.setRead(true, widget.stories...)
+	assert.Equal(t, true, first.viewed)
+	assert.Equal(t, true, state.isRead("guid-1"))
+	assert.Equal(t, true, state.isRead("https://example.com/2"))
+
+	widget.setRead(false, second)
+	assert.Equal(t, false, second.viewed)
+
+	// Stories that are fetched again keep their read state
+	reloaded, err := loadReadState(state.path)
+	assert.NilError(t, err)
+
+	widget = &Widget{
+		readState: reloaded,
+		stories: []*FeedItem{
+			{item: &gofeed.Item{GUID: "guid-1", Link: "https://example.com/1"}},
+			{item: &gofeed.Item{Link: "https://example.com/2"}},
+		},
+	}
+	widget.applyReadState()
+
+	assert.Equal(t, true, widget.stories[0].viewed)
+	assert.Equal(t, false, widget.stories[1].viewed)
+}
+
+func Test_plainText(t *testing.T) {
+	assert.Equal(t, "", plainText(""))
+	assert.Equal(t, "Hello\n\nWorld", plainText("<p>Hello</p>\n\n\n\n<p>World</p>"))
+	assert.Equal(t, "Some *bold* text", plainText("Some <b>bold</b> text"))
+}
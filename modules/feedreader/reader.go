@@ -0,0 +1,130 @@
+package feedreader
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+	"jaytaylor.com/html2text"
+)
+
+const (
+	readerPage = "reader"
+
+	// readerScale is how much of the screen the reader covers
+	readerScale = 0.8
+)
+
+// blankLines matches the runs of empty lines that converted HTML is left with
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// showReader displays the selected story as plain text in a modal, and marks it
+// as read
+func (widget *Widget) showReader() {
+	story := widget.selectedStory()
+	if story == nil || widget.pages == nil {
+		return
+	}
+
+	widget.setRead(true, story)
+
+	textView := tview.NewTextView()
+	textView.SetDynamicColors(true)
+	textView.SetWordWrap(true)
+	textView.SetScrollable(true)
+	textView.SetBackgroundColor(wtf.ColorFor(widget.settings.Colors.Background))
+	textView.SetTextColor(wtf.ColorFor(widget.settings.Colors.Text))
+	textView.SetText(widget.readerText(story))
+
+	frame := tview.NewFrame(textView)
+	frame.SetBorder(true)
+	frame.SetBorders(0, 0, 0, 1, 1, 1)
+	frame.SetTitle(" " + tview.Escape(story.sourceTitle) + " ")
+	frame.AddText("Esc: close  o: open in browser  j/k: scroll", false, tview.AlignCenter, tcell.ColorGray)
+
+	frame.SetDrawFunc(func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		w, h := screen.Size()
+		readerWidth, readerHeight := int(float64(w)*readerScale), int(float64(h)*readerScale)
+		frame.SetRect((w-readerWidth)/2, (h-readerHeight)/2, readerWidth, readerHeight)
+		// Within the border
+		return x + 1, y + 1, width - 2, height - 2
+	})
+
+	closeFn := func() {
+		widget.pages.RemovePage(readerPage)
+		widget.tviewApp.SetFocus(widget.View)
+		widget.Render()
+	}
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEsc, event.Rune() == 'q':
+			closeFn()
+			return nil
+		case event.Rune() == 'o':
+			utils.OpenFile(story.item.Link)
+			return nil
+		}
+
+		return event
+	})
+
+	widget.pages.AddPage(readerPage, frame, false, true)
+	widget.tviewApp.SetFocus(textView)
+
+	// Tell the app to force redraw the screen
+	widget.RedrawChan <- true
+}
+
+// readerText renders a story as plain text: its title, when it was published and by
+// whom, its content or else its description, and its link
+func (widget *Widget) readerText(story *FeedItem) string {
+	item := story.item
+
+	str := fmt.Sprintf("[::b]%s[::-]\n", tview.Escape(html.UnescapeString(strings.TrimSpace(item.Title))))
+
+	byline := []string{}
+	if item.PublishedParsed != nil {
+		byline = append(byline, item.PublishedParsed.Format(widget.settings.dateFormat))
+	}
+	for _, author := range item.Authors {
+		if author != nil && author.Name != "" {
+			byline = append(byline, author.Name)
+		}
+	}
+	if len(byline) > 0 {
+		str += fmt.Sprintf("[%s]%s[-]\n", widget.settings.publishDate, tview.Escape(strings.Join(byline, " · ")))
+	}
+
+	body := item.Content
+	if strings.TrimSpace(body) == "" {
+		body = item.Description
+	}
+
+	text := plainText(body)
+	if text == "" {
+		text = "This story has no content."
+	}
+	str += "\n" + tview.Escape(text) + "\n"
+
+	if item.Link != "" {
+		str += fmt.Sprintf("\n[%s]%s[-]\n", widget.settings.source, tview.Escape(item.Link))
+	}
+
+	return str
+}
+
+// plainText converts the HTML of a story to text
+func plainText(content string) string {
+	text, err := html2text.FromString(content, html2text.Options{PrettyTables: true, OmitLinks: false})
+	if err != nil {
+		text = content
+	}
+
+	return strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n"))
+}
@@ -8,6 +8,8 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mmcdole/gofeed"
 	"github.com/rivo/tview"
@@ -31,15 +33,34 @@ type FeedItem struct {
 	viewed      bool
 }
 
+// key identifies the item in the read state: its GUID, or its link if it doesn't
+// have one
+func (feedItem *FeedItem) key() string {
+	if feedItem.item.GUID != "" {
+		return feedItem.item.GUID
+	}
+
+	return feedItem.item.Link
+}
+
+// fetchTimeout is how long a feed has to respond
+const fetchTimeout = 30 * time.Second
+
 // Widget is the container for RSS and Atom data
 type Widget struct {
 	view.ScrollableWidget
 
-	stories  []*FeedItem
-	parser   *gofeed.Parser
-	settings *Settings
-	err      error
-	showType ShowType
+	cache      map[string]*cachedFeed
+	cacheMutex sync.Mutex
+	client     *http.Client
+	stories    []*FeedItem
+	pages      *tview.Pages
+	readState  *readState
+	settings   *Settings
+	feedErrs   []error
+	stateErr   error
+	showType   ShowType
+	tviewApp   *tview.Application
 }
 
 func rotateShowType(showtype ShowType) ShowType {
@@ -57,32 +78,37 @@ func rotateShowType(showtype ShowType) ShowType {
 
 // NewWidget creates a new instance of a widget
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
-	parser := gofeed.NewParser()
+	client := &http.Client{Timeout: fetchTimeout}
 	if settings.disableHTTP2 {
-		// If HTTP/2 is disabled, we override the parser client
-		// with a client using a simple HTTP transport which
+		// If HTTP/2 is disabled, we override the client's
+		// transport with a simple HTTP transport which
 		// removes the client's default behavior of first
 		// trying HTTP/2 before downgrading to older protocol
 		// versions.
-		parser.Client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS12,
-					MaxVersion: tls.VersionTLS13,
-				},
+		client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				MaxVersion: tls.VersionTLS13,
 			},
 		}
 	}
 
-	parser.UserAgent = settings.userAgent
-
 	widget := &Widget{
 		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
-		parser:   parser,
+		cache:    map[string]*cachedFeed{},
+		client:   client,
+		pages:    pages,
 		settings: settings,
 		showType: SHOW_TITLE,
+		tviewApp: tviewApp,
+	}
+
+	path, err := readStatePath(settings.Name)
+	if err == nil {
+		widget.readState, err = loadReadState(path)
 	}
+	widget.stateErr = err
 
 	widget.SetRenderFunction(widget.Render)
 	widget.initializeKeyboardControls()
@@ -92,37 +118,20 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 /* -------------------- Exported Functions -------------------- */
 
-// Fetch retrieves RSS and Atom feed data
-func (widget *Widget) Fetch(feedURLs []string) ([]*FeedItem, error) {
-	var data []*FeedItem
-
-	for _, feedURL := range feedURLs {
-		feedItems, err := widget.fetchForFeed(feedURL)
-		if err != nil {
-			return nil, err
-		}
-
-		data = append(data, feedItems...)
-	}
-
-	data = widget.sort(data)
-
-	return data, nil
-}
-
 // Refresh updates the data in the widget
 func (widget *Widget) Refresh() {
-	feedItems, err := widget.Fetch(widget.settings.feeds)
+	feedURLs, err := widget.feedURLs()
+
+	feedItems, errs := widget.Fetch(feedURLs)
 	if err != nil {
-		widget.err = err
-		widget.stories = nil
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
-		widget.stories = feedItems
-		widget.SetItemCount(len(feedItems))
+		errs = append([]error{err}, errs...)
 	}
 
+	widget.feedErrs = errs
+	widget.stories = feedItems
+	widget.applyReadState()
+	widget.SetItemCount(len(feedItems))
+
 	widget.Render()
 }
 
@@ -133,57 +142,18 @@ func (widget *Widget) Render() {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) fetchForFeed(feedURL string) ([]*FeedItem, error) {
-	var (
-		feed *gofeed.Feed
-		err  error
-	)
-	if auth, isPrivateRSS := widget.settings.credentials[feedURL]; isPrivateRSS {
-		widget.parser.AuthConfig = &gofeed.Auth{
-			Username: auth.username,
-			Password: auth.password,
-		}
-		feed, err = widget.parser.ParseURL(feedURL)
-		widget.parser.AuthConfig = nil
-	} else {
-		feed, err = widget.parser.ParseURL(feedURL)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	var feedItems []*FeedItem
-
-	for idx, gofeedItem := range feed.Items {
-		if widget.settings.feedLimit >= 1 && idx >= widget.settings.feedLimit {
-			// We only want to get the widget.settings.feedLimit latest articles,
-			// not all of them. To get all, set feedLimit to < 1
-			break
-		}
-
-		feedItem := &FeedItem{
-			item:        gofeedItem,
-			sourceTitle: feed.Title,
-			viewed:      false,
-		}
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.title()
 
-		feedItems = append(feedItems, feedItem)
+	var str string
+	for _, err := range widget.feedErrs {
+		str += fmt.Sprintf("[red]%s[white]\n", tview.Escape(err.Error()))
 	}
 
-	return feedItems, nil
-}
-
-func (widget *Widget) content() (string, string, bool) {
-	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
 	data := widget.stories
 	if len(data) == 0 {
-		return title, "No data", false
+		return title, str + "No data", false
 	}
-	var str string
 
 	for idx, feedItem := range data {
 		rowColor := widget.RowColor(idx)
@@ -208,6 +178,10 @@ func (widget *Widget) content() (string, string, bool) {
 		str += utils.HighlightableHelper(widget.View, row, idx, len(feedItem.item.Title))
 	}
 
+	if widget.stateErr != nil {
+		str += fmt.Sprintf("\n[red]%s[white]\n", tview.Escape(widget.stateErr.Error()))
+	}
+
 	return title, str, false
 }
 
@@ -258,7 +232,7 @@ func (widget *Widget) openStory() {
 
 	if sel >= 0 && widget.stories != nil && sel < len(widget.stories) {
 		story := widget.stories[sel]
-		story.viewed = true
+		widget.setRead(true, story)
 
 		utils.OpenFile(story.item.Link)
 	}
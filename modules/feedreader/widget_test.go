@@ -1,12 +1,28 @@
 package feedreader
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/mmcdole/gofeed"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
 	"gotest.tools/assert"
 )
 
+func Test_NewWidget(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	ymlConfig, _ := config.ParseYaml("feeds: [https://example.com/feed.xml]")
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+	settings := NewSettingsFromYAML("news", ymlConfig, globalConfig)
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+
+	assert.NilError(t, widget.stateErr)
+	assert.Equal(t, "news.data", filepath.Base(widget.readState.path))
+}
+
 func Test_getShowText(t *testing.T) {
 	tests := []struct {
 		name     string
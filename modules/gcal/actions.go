@@ -0,0 +1,98 @@
+package gcal
+
+import (
+	"fmt"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+const (
+	responseAccepted  = "accepted"
+	responseDeclined  = "declined"
+	responseTentative = "tentative"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) accept() {
+	widget.respond(responseAccepted)
+}
+
+func (widget *Widget) decline() {
+	widget.respond(responseDeclined)
+}
+
+func (widget *Widget) tentative() {
+	widget.respond(responseTentative)
+}
+
+// respond changes the response to the selected event's invitation. A declined event
+// is no longer displayed, unless showDeclined is set. The response is saved in the
+// background, and if it can't be saved, the previous one is restored
+func (widget *Widget) respond(status string) {
+	calEvent := widget.selectedEvent()
+	if calEvent == nil {
+		return
+	}
+
+	attendee := calEvent.attendeeFor(widget.settings.email)
+	if attendee == nil {
+		widget.actionErr = fmt.Errorf("you aren't invited to %s", calEvent.event.Summary)
+		widget.display()
+		return
+	}
+
+	previous := attendee.ResponseStatus
+	attendee.ResponseStatus = status
+
+	widget.actionErr = nil
+	widget.display()
+
+	go func() {
+		err := widget.saveAttendees(calEvent)
+		if err != nil {
+			attendee.ResponseStatus = previous
+		}
+
+		widget.actionErr = err
+		widget.display()
+	}()
+}
+
+// saveAttendees saves the responses of an event's attendees
+func (widget *Widget) saveAttendees(calEvent *CalEvent) error {
+	srv, err := widget.service()
+	if err != nil {
+		return err
+	}
+
+	// The whole list is sent, as the API replaces it
+	patch := &calendar.Event{Attendees: calEvent.event.Attendees}
+
+	_, err = srv.Events.Patch(calEvent.calendarID, calEvent.event.Id, patch).Do()
+	return err
+}
+
+// quickAdd prompts for an event, e.g. "Lunch with Sam tomorrow at noon", and adds it
+// to the primary calendar in the background. Google works out when it is from the text
+func (widget *Widget) quickAdd() {
+	widget.ShowInputForm("Event:", func(text string) {
+		if text == "" {
+			return
+		}
+
+		go func() {
+			srv, err := widget.service()
+			if err == nil {
+				_, err = srv.Events.QuickAdd("primary", text).Do()
+			}
+
+			widget.Refresh()
+
+			if err != nil {
+				widget.actionErr = err
+				widget.display()
+			}
+		}()
+	})
+}
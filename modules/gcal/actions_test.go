@@ -0,0 +1,75 @@
+package gcal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/calendar/v3"
+)
+
+func testSettings(yml string) *Settings {
+	ymlConfig, _ := config.ParseYaml(yml)
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+
+	return NewSettingsFromYAML("gcal", ymlConfig, globalConfig)
+}
+
+func Test_ResponseFor(t *testing.T) {
+	event := NewCalEvent(&calendar.Event{Attendees: []*calendar.EventAttendee{
+		{Email: "bob@example.com", ResponseStatus: "accepted"},
+		{Email: "alice@work.example.com", ResponseStatus: "tentative", Self: true},
+	}})
+
+	assert.Equal(t, "accepted", event.ResponseFor("bob@example.com"))
+	assert.Equal(t, "tentative", event.ResponseFor("alice@example.com"))
+	assert.Equal(t, "", NewCalEvent(&calendar.Event{}).ResponseFor("alice@example.com"))
+}
+
+func Test_respond_notInvited(t *testing.T) {
+	settings := testSettings("email: alice@example.com\nwriteAccess: true")
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+	widget.calEvents = []*CalEvent{NewCalEvent(&calendar.Event{
+		Summary: "Lunch",
+		Start:   &calendar.EventDateTime{DateTime: "2024-03-12T12:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2024-03-12T13:00:00Z"},
+	})}
+	widget.display()
+	widget.Next()
+
+	widget.respond(responseDeclined)
+	assert.EqualError(t, widget.actionErr, "you aren't invited to Lunch")
+}
+
+func Test_writeAccess(t *testing.T) {
+	settings := testSettings("email: alice@example.com\nwriteAccess: true")
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+	assert.Equal(t, []string{calendar.CalendarReadonlyScope, calendar.CalendarEventsScope}, widget.scopes())
+	assert.Equal(t, "alice@example.com-write", widget.tokenName())
+	assert.Subset(t, widget.AssignedChars(), []string{"a", "d", "t", "n"})
+
+	readOnly := testSettings("email: alice@example.com")
+
+	widget = NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), readOnly)
+	assert.Equal(t, []string{calendar.CalendarReadonlyScope}, widget.scopes())
+	assert.Equal(t, "alice@example.com", widget.tokenName())
+	assert.NotContains(t, widget.AssignedChars(), "a")
+
+	// Events from elsewhere can't be changed
+	events := NewEventWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings, func() ([]*CalEvent, error) { return nil, nil })
+	assert.NotContains(t, events.AssignedChars(), "a")
+}
+
+func Test_Refresh_clearsActionErr(t *testing.T) {
+	settings := testSettings("email: alice@example.com")
+
+	widget := NewEventWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings, func() ([]*CalEvent, error) { return nil, nil })
+	widget.actionErr = errors.New("could not respond")
+
+	widget.Refresh()
+	assert.NoError(t, widget.actionErr)
+}
@@ -2,14 +2,21 @@ package gcal
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/wtfutil/wtf/utils"
 	"google.golang.org/api/calendar/v3"
 )
 
+// joinURLRegex matches the URL of a video call, e.g. a Google Meet, Zoom or Microsoft
+// Teams meeting
+var joinURLRegex = regexp.MustCompile(`https://(?:[\w-]+\.)*(?:meet\.google\.com|zoom\.us|teams\.microsoft\.com|teams\.live\.com|webex\.com|whereby\.com|meet\.jit\.si)/[^\s"'<>]+`)
+
 type CalEvent struct {
-	event *calendar.Event
+	calendarID string
+	event      *calendar.Event
 }
 
 func NewCalEvent(event *calendar.Event) *CalEvent {
@@ -43,6 +50,28 @@ func (calEvent *CalEvent) ConflictsWith(otherEvents []*CalEvent) bool {
 	return hasConflict
 }
 
+// JoinURL returns the URL to join the event's video call. It's taken from the event's
+// conference data if it has any, and otherwise from its location or description
+func (calEvent *CalEvent) JoinURL() string {
+	if calEvent.event.ConferenceData != nil {
+		for _, entryPoint := range calEvent.event.ConferenceData.EntryPoints {
+			if entryPoint.EntryPointType == "video" && entryPoint.Uri != "" {
+				return entryPoint.Uri
+			}
+		}
+	}
+
+	if calEvent.event.HangoutLink != "" {
+		return calEvent.event.HangoutLink
+	}
+
+	if url := findJoinURL(calEvent.event.Location); url != "" {
+		return url
+	}
+
+	return findJoinURL(calEvent.event.Description)
+}
+
 func (calEvent *CalEvent) Now() bool {
 	return time.Now().After(calEvent.Start()) && time.Now().Before(calEvent.End())
 }
@@ -56,11 +85,11 @@ func (calEvent *CalEvent) Past() bool {
 	return !calEvent.Now() && calEvent.Start().Before(time.Now())
 }
 
+// ResponseFor returns how the attendee with the email address, or the owner of the
+// calendar the event is from, responded to the event
 func (calEvent *CalEvent) ResponseFor(email string) string {
-	for _, attendee := range calEvent.event.Attendees {
-		if attendee.Email == email {
-			return attendee.ResponseStatus
-		}
+	if attendee := calEvent.attendeeFor(email); attendee != nil {
+		return attendee.ResponseStatus
 	}
 
 	return ""
@@ -118,3 +147,23 @@ func (calEvent *CalEvent) Timestamp(hourFormat string, showEndTime bool) string
 
 	return startTime.Format(timeFormat)
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// attendeeFor returns the attendee with the email address, or the owner of the
+// calendar the event is from
+func (calEvent *CalEvent) attendeeFor(email string) *calendar.EventAttendee {
+	for _, attendee := range calEvent.event.Attendees {
+		if attendee.Email == email || attendee.Self {
+			return attendee
+		}
+	}
+
+	return nil
+}
+
+// findJoinURL returns the first video call URL in text, without any punctuation that
+// follows it
+func findJoinURL(text string) string {
+	return strings.TrimRight(joinURLRegex.FindString(text), ".,;:)]")
+}
@@ -26,44 +26,45 @@ import (
 	"google.golang.org/api/option"
 )
 
+// periodEventLimit is the most events of a calendar that the week and month views display
+const periodEventLimit = 250
+
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Fetch() ([]*CalEvent, error) {
-	ctx := context.Background()
-
-	secretPath, _ := utils.ExpandHomeDir(widget.settings.secretFile)
-
-	b, err := os.ReadFile(filepath.Clean(secretPath))
-	if err != nil {
-		return nil, err
-	}
-
-	config, err := google.ConfigFromJSON(b, calendar.CalendarReadonlyScope)
-	if err != nil {
-		return nil, err
-	}
-	client := getClient(ctx, config, widget.settings.email)
-
-	srv, err := calendar.NewService(context.Background(), option.WithHTTPClient(client))
+	srv, err := widget.service()
 	if err != nil {
 		return nil, err
 	}
 
 	// Get calendar events
 	var events calendar.Events
+	calendarIDsByEvent := map[*calendar.Event]string{}
 
-	startTime := fromMidnight().Format(time.RFC3339)
+	// The list view displays the next events, and the week and month views all of theirs
+	start, end := widget.Period()
 	eventLimit := int64(widget.settings.eventCount)
+	if !end.IsZero() {
+		eventLimit = periodEventLimit
+	}
 
 	timezone := widget.settings.timezone
 
 	calendarIDs, err := widget.getCalendarIdList(srv)
 	for _, calendarID := range calendarIDs {
-		calendarEvents, listErr := srv.Events.List(calendarID).TimeZone(timezone).ShowDeleted(false).TimeMin(startTime).MaxResults(eventLimit).SingleEvents(true).OrderBy("startTime").Do()
+		call := srv.Events.List(calendarID).TimeZone(timezone).ShowDeleted(false).TimeMin(start.Format(time.RFC3339)).MaxResults(eventLimit).SingleEvents(true).OrderBy("startTime")
+		if !end.IsZero() {
+			call = call.TimeMax(end.Format(time.RFC3339))
+		}
+
+		calendarEvents, listErr := call.Do()
 		if listErr != nil {
 			break
 		}
 		events.Items = append(events.Items, calendarEvents.Items...)
+		for _, event := range calendarEvents.Items {
+			calendarIDsByEvent[event] = calendarID
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -87,7 +88,9 @@ func (widget *Widget) Fetch() ([]*CalEvent, error) {
 	// Wrap the calendar events in our custom CalEvent
 	calEvents := []*CalEvent{}
 	for _, event := range events.Items {
-		calEvents = append(calEvents, NewCalEvent(event))
+		calEvent := NewCalEvent(event)
+		calEvent.calendarID = calendarIDsByEvent[event]
+		calEvents = append(calEvents, calEvent)
 	}
 
 	return calEvents, err
@@ -95,9 +98,45 @@ func (widget *Widget) Fetch() ([]*CalEvent, error) {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func fromMidnight() time.Time {
-	now := time.Now()
-	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+// service returns a client of the Calendar API, authorized with the scopes that the
+// widget needs
+func (widget *Widget) service() (*calendar.Service, error) {
+	ctx := context.Background()
+
+	secretPath, _ := utils.ExpandHomeDir(widget.settings.secretFile)
+
+	b, err := os.ReadFile(filepath.Clean(secretPath))
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := google.ConfigFromJSON(b, widget.scopes()...)
+	if err != nil {
+		return nil, err
+	}
+	client := getClient(ctx, config, widget.tokenName())
+
+	return calendar.NewService(ctx, option.WithHTTPClient(client))
+}
+
+// scopes returns the scopes that the widget asks for: read-only access to calendars,
+// and access to change events if writing is enabled
+func (widget *Widget) scopes() []string {
+	if widget.settings.writeAccess {
+		return []string{calendar.CalendarReadonlyScope, calendar.CalendarEventsScope}
+	}
+
+	return []string{calendar.CalendarReadonlyScope}
+}
+
+// tokenName returns the name that the OAuth token is cached under. Tokens with write
+// access are cached separately, so that enabling it asks for access again
+func (widget *Widget) tokenName() string {
+	if widget.settings.writeAccess {
+		return widget.settings.email + "-write"
+	}
+
+	return widget.settings.email
 }
 
 // getClient uses a Context and Config to retrieve a Token
@@ -132,9 +171,9 @@ func (widget *Widget) authenticate() {
 		log.Fatalf("Unable to read secret file. %v", widget.settings.secretFile)
 	}
 
-	config, _ := google.ConfigFromJSON(b, calendar.CalendarReadonlyScope)
+	config, _ := google.ConfigFromJSON(b, widget.scopes()...)
 	tok := getTokenFromWeb(config)
-	cacheFile, _ := tokenCacheFile(widget.settings.email)
+	cacheFile, _ := tokenCacheFile(widget.tokenName())
 	saveToken(cacheFile, tok)
 }
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -17,6 +18,10 @@ func (widget *Widget) content() (string, string, bool) {
 	title := widget.settings.Title
 	calEvents := widget.calEvents
 
+	// The events that can be selected, in the order they're displayed in
+	widget.displayed = []*CalEvent{}
+	defer func() { widget.SetItemCount(len(widget.displayed)) }()
+
 	if widget.err != nil {
 		return title, widget.err.Error(), true
 	}
@@ -25,35 +30,36 @@ func (widget *Widget) content() (string, string, bool) {
 		return title, "No calendar events", false
 	}
 
-	var str string
-	var prevEvent *CalEvent
+	str := ""
+	if widget.actionErr != nil {
+		str += fmt.Sprintf("[red]%s[white]\n", tview.Escape(widget.actionErr.Error()))
+	}
+
+	now := time.Now()
+	str += widget.countdown(calEvents, now)
 
 	if !widget.settings.showDeclined {
 		calEvents = widget.removeDeclined(calEvents)
 	}
 
+	switch widget.viewMode {
+	case viewWeek:
+		return title, str + widget.weekContent(widget.removeAllDay(calEvents), now), false
+	case viewMonth:
+		return title, str + widget.monthContent(widget.removeAllDay(calEvents), now), false
+	}
+
+	var prevEvent *CalEvent
+
 	for _, calEvent := range calEvents {
 		if calEvent.AllDay() && !widget.settings.showAllDay {
 			continue
 		}
 
-		ts := calEvent.Timestamp(widget.settings.hourFormat, widget.settings.showEndTime)
-		timestamp := fmt.Sprintf("[%s]%s", widget.eventTimeColor(), ts)
-		if calEvent.AllDay() {
-			timestamp = ""
-		}
-
-		eventTitle := fmt.Sprintf("[%s]%s",
-			widget.titleColor(calEvent),
-			widget.eventSummary(calEvent, calEvent.ConflictsWith(calEvents)),
-		)
-
 		lineOne := fmt.Sprintf(
-			"%s %s %s %s[white]\n",
+			"%s %s\n",
 			widget.dayDivider(calEvent, prevEvent),
-			widget.responseIcon(calEvent),
-			timestamp,
-			eventTitle,
+			widget.selectable(calEvent, widget.eventLine(calEvent, calEvents)),
 		)
 
 		str += fmt.Sprintf("%s   %s%s\n",
@@ -72,6 +78,91 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
+// selectable marks the line of an event as one that can be selected
+func (widget *Widget) selectable(calEvent *CalEvent, line string) string {
+	idx := len(widget.displayed)
+	widget.displayed = append(widget.displayed, calEvent)
+
+	return fmt.Sprintf(`["%d"]%s[white][""]`, idx, line)
+}
+
+// eventLine returns the response icon, time and title of an event
+func (widget *Widget) eventLine(calEvent *CalEvent, calEvents []*CalEvent) string {
+	ts := calEvent.Timestamp(widget.settings.hourFormat, widget.settings.showEndTime)
+	timestamp := fmt.Sprintf("[%s]%s", widget.eventTimeColor(), ts)
+	if calEvent.AllDay() {
+		timestamp = ""
+	}
+
+	eventTitle := fmt.Sprintf("[%s]%s",
+		widget.titleColor(calEvent),
+		widget.eventSummary(calEvent, calEvent.ConflictsWith(calEvents)),
+	)
+
+	return fmt.Sprintf("%s %s %s", widget.responseIcon(calEvent), timestamp, eventTitle)
+}
+
+// countdown returns a line that says how long it is until the next meeting, in red
+// once it's about to start, or nothing if there isn't one
+func (widget *Widget) countdown(calEvents []*CalEvent, now time.Time) string {
+	if !widget.settings.showCountdown {
+		return ""
+	}
+
+	next := widget.nextMeeting(calEvents, now)
+	if next == nil {
+		return ""
+	}
+
+	until := next.Start().Sub(now)
+
+	color := widget.eventTimeColor()
+	if until <= time.Duration(widget.settings.countdownWarning)*time.Minute {
+		color = "red"
+	}
+
+	return fmt.Sprintf("[%s]Next meeting in %s: %s[white]\n\n", color, countdownDuration(until), next.event.Summary)
+}
+
+// nextMeeting returns the next event, that isn't all-day or declined, to start after now
+func (widget *Widget) nextMeeting(calEvents []*CalEvent, now time.Time) *CalEvent {
+	var next *CalEvent
+
+	for _, calEvent := range calEvents {
+		if calEvent.AllDay() || !calEvent.Start().After(now) || calEvent.ResponseFor(widget.settings.email) == "declined" {
+			continue
+		}
+
+		if next == nil || calEvent.Start().Before(next.Start()) {
+			next = calEvent
+		}
+	}
+
+	return next
+}
+
+// countdownDuration returns a duration rounded up to the minute, e.g. 7m, 1h 20m or
+// 2d 3h
+func countdownDuration(duration time.Duration) string {
+	mins := int((duration + time.Minute - 1) / time.Minute)
+
+	days, hours := mins/(24*60), mins/60%24
+	mins %= 60
+
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0 && mins > 0:
+		return fmt.Sprintf("%dh %dm", hours, mins)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", mins)
+	}
+}
+
 func (widget *Widget) dayDivider(event, prevEvent *CalEvent) string {
 	var prevStartTime time.Time
 
@@ -222,6 +313,21 @@ func (widget *Widget) responseIcon(calEvent *CalEvent) string {
 	}
 }
 
+// removeAllDay removes the all-day events, unless they're displayed
+func (widget *Widget) removeAllDay(events []*CalEvent) []*CalEvent {
+	if widget.settings.showAllDay {
+		return events
+	}
+
+	ret := []*CalEvent{}
+	for _, e := range events {
+		if !e.AllDay() {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
 func (widget *Widget) removeDeclined(events []*CalEvent) []*CalEvent {
 	var ret []*CalEvent
 	for _, e := range events {
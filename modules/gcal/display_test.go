@@ -29,19 +29,19 @@ func Test_display_content(t *testing.T) {
 			name:              "Event content with a single event, without end times displayed",
 			settings:          &Settings{Common: &cfg.Common{}, showEndTime: false},
 			events:            []*CalEvent{NewCalEvent(event)},
-			descriptionWanted: "[]Saturday, Apr 19\n  []01:00 []Foo[white]\n   \n",
+			descriptionWanted: "[]Saturday, Apr 19\n [\"0\"] []01:00 []Foo[white][\"\"]\n   \n",
 		},
 		{
 			name:              "Event content with a single event without showEndTime explicitly set in settings",
 			settings:          &Settings{Common: &cfg.Common{}},
 			events:            []*CalEvent{NewCalEvent(event)},
-			descriptionWanted: "[]Saturday, Apr 19\n  []01:00 []Foo[white]\n   \n",
+			descriptionWanted: "[]Saturday, Apr 19\n [\"0\"] []01:00 []Foo[white][\"\"]\n   \n",
 		},
 		{
 			name:              "Event content with a single event with end times displayed",
 			settings:          &Settings{Common: &cfg.Common{}, showEndTime: true},
 			events:            []*CalEvent{NewCalEvent(event)},
-			descriptionWanted: "[]Saturday, Apr 19\n  []01:00-02:00 []Foo[white]\n   \n",
+			descriptionWanted: "[]Saturday, Apr 19\n [\"0\"] []01:00-02:00 []Foo[white][\"\"]\n   \n",
 		},
 	}
 
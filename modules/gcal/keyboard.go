@@ -0,0 +1,25 @@
+package gcal
+
+import "github.com/gdamore/tcell/v2"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next event")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous event")
+	widget.SetKeyboardChar("o", widget.join, "Join the selected event's video call, or the current or next meeting's")
+	widget.SetKeyboardChar("v", widget.nextView, "Switch between the list, week and month views")
+
+	// Only Google calendars can be changed, and only once write access is enabled
+	if widget.fetch == nil && widget.settings.writeAccess {
+		widget.SetKeyboardChar("a", widget.accept, "Accept the selected event")
+		widget.SetKeyboardChar("d", widget.decline, "Decline the selected event")
+		widget.SetKeyboardChar("t", widget.tentative, "Tentatively accept the selected event")
+		widget.SetKeyboardChar("n", widget.quickAdd, "Add an event, e.g. Lunch with Sam tomorrow at noon")
+	}
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next event")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous event")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
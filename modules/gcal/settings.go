@@ -26,18 +26,23 @@ type Settings struct {
 	*cfg.Common
 
 	conflictIcon          string `help:"The icon displayed beside calendar events that have conflicting times (they intersect or overlap in some way)." values:"Any displayable unicode character." optional:"true"`
+	countdownWarning      int    `help:"The number of minutes before the next meeting starts that its countdown turns red." values:"A positive integer, 0..n." optional:"true"`
 	currentIcon           string `help:"The icon displayed beside the current calendar event." values:"Any displayable unicode character." optional:"true"`
 	displayResponseStatus bool   `help:"Whether or not to display your response status to the calendar event." values:"true or false" optional:"true"`
 	email                 string `help:"The email address associated with your Google account. Necessary for determining 'responseStatus'." values:"A valid email address string."`
 	eventCount            int    `help:"The number of calendar events to display." values:"A positive integer, 0..n." optional:"true"`
+	firstDayOfWeek        string `help:"The day that weeks start on in the week and month views." values:"monday or sunday" optional:"true"`
 	hourFormat            string `help:"The format of the clock." values:"12 or 24"`
 	multiCalendar         bool   `help:"Whether or not to display your primary calendar or all calendars you have access to." values:"true or false" optional:"true"`
 	secretFile            string `help:"Your Google client secret JSON file." values:"A string representing a file path to the JSON secret file."`
 	showAllDay            bool   `help:"Whether or not to display all-day events" values:"true or false" optional:"true" default:"true"`
+	showCountdown         bool   `help:"Whether or not to display how long it is until the next meeting." values:"true or false" optional:"true" default:"true"`
 	showDeclined          bool   `help:"Whether or not to display events you’ve declined to attend." values:"true or false" optional:"true"`
 	showEndTime           bool   `help:"Display the end time of events, in addition to start time." values:"true or false" optional:"true" default:"false"`
 	withLocation          bool   `help:"Whether or not to show the location of the appointment." values:"true or false"`
+	writeAccess           bool   `help:"Whether or not to ask for access to change events, to respond to invitations and add events. Enabling it asks you to authenticate again." values:"true or false" optional:"true" default:"false"`
 	timezone              string `help:"The time zone used to display calendar event times." values:"A valid TZ database time zone string" optional:"true"`
+	view                  string `help:"The view displayed at first. Press 'v' to switch views." values:"list, week or month" optional:"true" default:"list"`
 	calendarReadLevel     string `help:"The calender read level specifies level you want to read events. Default: writer " values:"reader, writer" optional:"true"`
 }
 
@@ -47,18 +52,23 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
 		conflictIcon:          ymlConfig.UString("conflictIcon", "🚨"),
+		countdownWarning:      ymlConfig.UInt("countdownWarning", 5),
 		currentIcon:           ymlConfig.UString("currentIcon", "🔸"),
 		displayResponseStatus: ymlConfig.UBool("displayResponseStatus", true),
 		email:                 ymlConfig.UString("email", ""),
 		eventCount:            ymlConfig.UInt("eventCount", 10),
+		firstDayOfWeek:        ymlConfig.UString("firstDayOfWeek", "monday"),
 		hourFormat:            ymlConfig.UString("hourFormat", "24"),
 		multiCalendar:         ymlConfig.UBool("multiCalendar", false),
 		secretFile:            ymlConfig.UString("secretFile", ""),
 		showAllDay:            ymlConfig.UBool("showAllDay", true),
+		showCountdown:         ymlConfig.UBool("showCountdown", true),
 		showEndTime:           ymlConfig.UBool("showEndTime", false),
 		showDeclined:          ymlConfig.UBool("showDeclined", false),
 		withLocation:          ymlConfig.UBool("withLocation", true),
+		writeAccess:           ymlConfig.UBool("writeAccess", false),
 		timezone:              ymlConfig.UString("timezone", ""),
+		view:                  ymlConfig.UString("view", viewList),
 		calendarReadLevel:     ymlConfig.UString("calendarReadLevel", "writer"),
 	}
 
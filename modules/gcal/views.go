@@ -0,0 +1,192 @@
+package gcal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	viewList  = "list"
+	viewWeek  = "week"
+	viewMonth = "month"
+)
+
+// views are the ways that events can be displayed, in the order that they're
+// switched between
+var views = []string{viewList, viewWeek, viewMonth}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Period returns when the events displayed by the current view start and end: the
+// current week or month. The list view displays the next events from midnight today,
+// so its end is zero
+func (widget *Widget) Period() (time.Time, time.Time) {
+	return widget.period(time.Now())
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) period(now time.Time) (time.Time, time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch widget.viewMode {
+	case viewWeek:
+		start := widget.weekStart(today)
+		return start, start.AddDate(0, 0, 7)
+	case viewMonth:
+		start := today.AddDate(0, 0, 1-today.Day())
+		return start, start.AddDate(0, 1, 0)
+	default:
+		return today, time.Time{}
+	}
+}
+
+// nextView switches to the next view and loads its events
+func (widget *Widget) nextView() {
+	for i, mode := range views {
+		if mode == widget.viewMode {
+			widget.viewMode = views[(i+1)%len(views)]
+			break
+		}
+	}
+
+	widget.Refresh()
+}
+
+// validView returns the view if it's known, and otherwise the list view
+func validView(mode string) string {
+	for _, known := range views {
+		if mode == known {
+			return mode
+		}
+	}
+
+	return viewList
+}
+
+// weekStart returns the first day of the week that day is in
+func (widget *Widget) weekStart(day time.Time) time.Time {
+	first := time.Monday
+	if strings.EqualFold(widget.settings.firstDayOfWeek, "sunday") {
+		first = time.Sunday
+	}
+
+	offset := (int(day.Weekday()) - int(first) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// weekContent displays the events of the current week day by day
+func (widget *Widget) weekContent(calEvents []*CalEvent, now time.Time) string {
+	start, end := widget.period(now)
+
+	str := ""
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		str += widget.agendaDay(day, calEvents, true)
+	}
+
+	return str
+}
+
+// monthContent displays a grid of the days of the current month, in which the days
+// with events are highlighted, followed by the events of the rest of the month
+func (widget *Widget) monthContent(calEvents []*CalEvent, now time.Time) string {
+	start, end := widget.period(now)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	str := fmt.Sprintf("[%s]%s\n", widget.settings.day, start.Format("January 2006"))
+
+	weekStart := widget.weekStart(start)
+	for day := weekStart; day.Before(weekStart.AddDate(0, 0, 7)); day = day.AddDate(0, 0, 1) {
+		str += fmt.Sprintf("[%s]%s ", widget.settings.description, day.Format("Mon")[:2])
+	}
+	str = strings.TrimSuffix(str, " ") + "\n"
+
+	for day := weekStart; day.Before(end); day = day.AddDate(0, 0, 1) {
+		switch {
+		case day.Before(start):
+			str += "   "
+			continue
+		case day.Equal(today):
+			str += fmt.Sprintf("[%s::r]%2d[-::-] ", widget.dayColor(day, today, calEvents), day.Day())
+		default:
+			str += fmt.Sprintf("[%s]%2d ", widget.dayColor(day, today, calEvents), day.Day())
+		}
+
+		if day.AddDate(0, 0, 1).Weekday() == weekStart.Weekday() {
+			str = strings.TrimSuffix(str, " ") + "\n"
+		}
+	}
+
+	if !strings.HasSuffix(str, "\n") {
+		str = strings.TrimSuffix(str, " ") + "\n"
+	}
+
+	if today.Before(start) || !today.Before(end) {
+		today = start
+	}
+
+	agenda := ""
+	for day := today; day.Before(end); day = day.AddDate(0, 0, 1) {
+		agenda += widget.agendaDay(day, calEvents, false)
+	}
+
+	if agenda != "" {
+		str += "\n" + agenda
+	}
+
+	return str
+}
+
+// dayColor returns the color of a day in the month grid: the past color if it has
+// passed, and otherwise the title color if it has events
+func (widget *Widget) dayColor(day, today time.Time, calEvents []*CalEvent) string {
+	switch {
+	case day.Before(today):
+		return widget.settings.past
+	case len(eventsOn(day, calEvents)) > 0:
+		return widget.settings.title
+	default:
+		return widget.settings.description
+	}
+}
+
+// agendaDay displays the events of a day below its date. Days without events are
+// left out unless showEmpty is set
+func (widget *Widget) agendaDay(day time.Time, calEvents []*CalEvent, showEmpty bool) string {
+	dayEvents := eventsOn(day, calEvents)
+	if len(dayEvents) == 0 && !showEmpty {
+		return ""
+	}
+
+	str := fmt.Sprintf("[%s]%s\n", widget.settings.day, day.Format(utils.FullDateFormat))
+
+	if len(dayEvents) == 0 {
+		return str + fmt.Sprintf("  [%s]No events[white]\n", widget.settings.past)
+	}
+
+	for _, calEvent := range dayEvents {
+		str += fmt.Sprintf(" %s\n", widget.selectable(calEvent, widget.eventLine(calEvent, calEvents)))
+	}
+
+	return str
+}
+
+// eventsOn returns the events that take place on a day, including those that started
+// on an earlier day and are still taking place
+func eventsOn(day time.Time, calEvents []*CalEvent) []*CalEvent {
+	dayEnd := day.AddDate(0, 0, 1)
+
+	dayEvents := []*CalEvent{}
+	for _, calEvent := range calEvents {
+		start, end := calEvent.Start(), calEvent.End()
+
+		if start.Before(dayEnd) && (end.After(day) || (start.Equal(end) && !start.Before(day))) {
+			dayEvents = append(dayEvents, calEvent)
+		}
+	}
+
+	return dayEvents
+}
@@ -0,0 +1,161 @@
+package gcal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+	"google.golang.org/api/calendar/v3"
+)
+
+func timedEvent(summary string, start, end time.Time) *CalEvent {
+	return NewCalEvent(&calendar.Event{
+		Summary: summary,
+		Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+		End:     &calendar.EventDateTime{DateTime: end.Format(time.RFC3339)},
+	})
+}
+
+func Test_period(t *testing.T) {
+	now := time.Date(2024, 3, 13, 15, 30, 0, 0, time.Local)
+
+	widget := &Widget{settings: &Settings{firstDayOfWeek: "monday"}}
+
+	start, end := widget.period(now)
+	assert.Equal(t, time.Date(2024, 3, 13, 0, 0, 0, 0, time.Local), start)
+	assert.True(t, end.IsZero())
+
+	widget.viewMode = viewWeek
+	start, end = widget.period(now)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.Local), start)
+	assert.Equal(t, time.Date(2024, 3, 18, 0, 0, 0, 0, time.Local), end)
+
+	widget.settings.firstDayOfWeek = "sunday"
+	start, _ = widget.period(now)
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local), start)
+
+	widget.viewMode = viewMonth
+	start, end = widget.period(now)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local), start)
+	assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.Local), end)
+}
+
+func Test_validView(t *testing.T) {
+	assert.Equal(t, viewList, validView("agenda"))
+	assert.Equal(t, viewMonth, validView("month"))
+}
+
+func Test_weekContent(t *testing.T) {
+	now := time.Date(2024, 3, 13, 15, 30, 0, 0, time.Local)
+
+	widget := &Widget{
+		settings: &Settings{Common: &cfg.Common{}, firstDayOfWeek: "monday"},
+		viewMode: viewWeek,
+	}
+
+	events := []*CalEvent{
+		timedEvent("Standup", time.Date(2024, 3, 11, 9, 0, 0, 0, time.Local), time.Date(2024, 3, 11, 9, 15, 0, 0, time.Local)),
+		timedEvent("Late night", time.Date(2024, 3, 15, 23, 0, 0, 0, time.Local), time.Date(2024, 3, 16, 1, 0, 0, 0, time.Local)),
+	}
+
+	assert.Equal(t,
+		"[]Monday, Mar 11\n [\"0\"] []09:00 []Standup[white][\"\"]\n"+
+			"[]Tuesday, Mar 12\n  []No events[white]\n"+
+			"[]Wednesday, Mar 13\n  []No events[white]\n"+
+			"[]Thursday, Mar 14\n  []No events[white]\n"+
+			"[]Friday, Mar 15\n [\"1\"] []23:00 []Late night[white][\"\"]\n"+
+			"[]Saturday, Mar 16\n [\"2\"] []23:00 []Late night[white][\"\"]\n"+
+			"[]Sunday, Mar 17\n  []No events[white]\n",
+		widget.weekContent(events, now),
+	)
+}
+
+func Test_monthContent(t *testing.T) {
+	now := time.Date(2024, 2, 13, 15, 30, 0, 0, time.Local)
+
+	widget := &Widget{
+		settings: &Settings{
+			Common:         &cfg.Common{},
+			colors:         colors{day: "day", description: "desc", past: "past", title: "title"},
+			firstDayOfWeek: "monday",
+		},
+		viewMode: viewMonth,
+	}
+
+	events := []*CalEvent{
+		timedEvent("Planning", time.Date(2024, 2, 5, 10, 0, 0, 0, time.Local), time.Date(2024, 2, 5, 11, 0, 0, 0, time.Local)),
+		timedEvent("Review", time.Date(2024, 2, 20, 10, 0, 0, 0, time.Local), time.Date(2024, 2, 20, 11, 0, 0, 0, time.Local)),
+	}
+
+	assert.Equal(t,
+		"[day]February 2024\n"+
+			"[desc]Mo [desc]Tu [desc]We [desc]Th [desc]Fr [desc]Sa [desc]Su\n"+
+			"         [past] 1 [past] 2 [past] 3 [past] 4\n"+
+			"[past] 5 [past] 6 [past] 7 [past] 8 [past] 9 [past]10 [past]11\n"+
+			"[past]12 [desc::r]13[-::-] [desc]14 [desc]15 [desc]16 [desc]17 [desc]18\n"+
+			"[desc]19 [title]20 [desc]21 [desc]22 [desc]23 [desc]24 [desc]25\n"+
+			"[desc]26 [desc]27 [desc]28 [desc]29\n"+
+			"\n"+
+			"[day]Tuesday, Feb 20\n [\"0\"] []10:00 [past]Review[white][\"\"]\n",
+		widget.monthContent(events, now),
+	)
+}
+
+func Test_countdown(t *testing.T) {
+	now := time.Now()
+
+	widget := &Widget{
+		settings: &Settings{
+			Common:           &cfg.Common{},
+			colors:           colors{eventTime: "white"},
+			countdownWarning: 5,
+			email:            "alice@example.com",
+			showCountdown:    true,
+		},
+	}
+
+	declined := timedEvent("Declined", now.Add(2*time.Minute), now.Add(time.Hour))
+	declined.event.Attendees = []*calendar.EventAttendee{{Email: "alice@example.com", ResponseStatus: "declined"}}
+
+	events := []*CalEvent{
+		timedEvent("In progress", now.Add(-time.Minute), now.Add(time.Hour)),
+		declined,
+		timedEvent("Standup", now.Add(20*time.Minute), now.Add(30*time.Minute)),
+	}
+
+	assert.Equal(t, "[white]Next meeting in 20m: Standup[white]\n\n", widget.countdown(events, now))
+	assert.Equal(t, "[red]Next meeting in 5m: Standup[white]\n\n", widget.countdown(events, now.Add(15*time.Minute)))
+	assert.Equal(t, "", widget.countdown(events, now.Add(time.Hour)))
+
+	widget.settings.showCountdown = false
+	assert.Equal(t, "", widget.countdown(events, now))
+}
+
+func Test_countdownDuration(t *testing.T) {
+	assert.Equal(t, "1m", countdownDuration(10*time.Second))
+	assert.Equal(t, "7m", countdownDuration(7*time.Minute))
+	assert.Equal(t, "1h", countdownDuration(time.Hour))
+	assert.Equal(t, "1h 20m", countdownDuration(80*time.Minute))
+	assert.Equal(t, "2d 3h", countdownDuration(51*time.Hour))
+	assert.Equal(t, "1d", countdownDuration(24*time.Hour))
+}
+
+func Test_JoinURL(t *testing.T) {
+	event := &calendar.Event{
+		Description: "Join: https://us02web.zoom.us/j/123456?pwd=abc. Or dial in",
+		Location:    "Room 1",
+	}
+	assert.Equal(t, "https://us02web.zoom.us/j/123456?pwd=abc", NewCalEvent(event).JoinURL())
+
+	event.HangoutLink = "https://meet.google.com/abc-defg-hij"
+	assert.Equal(t, "https://meet.google.com/abc-defg-hij", NewCalEvent(event).JoinURL())
+
+	event.ConferenceData = &calendar.ConferenceData{EntryPoints: []*calendar.EntryPoint{
+		{EntryPointType: "phone", Uri: "tel:+1-555-0100"},
+		{EntryPointType: "video", Uri: "https://teams.microsoft.com/l/meetup-join/xyz"},
+	}}
+	assert.Equal(t, "https://teams.microsoft.com/l/meetup-join/xyz", NewCalEvent(event).JoinURL())
+
+	assert.Equal(t, "", NewCalEvent(&calendar.Event{Description: "https://example.com/agenda"}).JoinURL())
+}
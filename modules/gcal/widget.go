@@ -1,38 +1,69 @@
 package gcal
 
 import (
+	"time"
+
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
 
 type Widget struct {
-	view.TextWidget
+	view.ScrollableWidget
 
+	actionErr error
 	calEvents []*CalEvent
+	displayed []*CalEvent
 	err       error
+	fetch     func() ([]*CalEvent, error)
+	pages     *tview.Pages
 	settings  *Settings
 	tviewApp  *tview.Application
+	viewMode  string
+}
+
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	return newWidget(tviewApp, redrawChan, pages, settings, nil)
+}
+
+// NewEventWidget creates a widget that displays the events returned by fetch rather
+// than those of a Google calendar, for modules that read calendars from elsewhere.
+// Such events can't be responded to or added
+func NewEventWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings, fetch func() ([]*CalEvent, error)) *Widget {
+	return newWidget(tviewApp, redrawChan, pages, settings, fetch)
 }
 
-func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Settings) *Widget {
+func newWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings, fetch func() ([]*CalEvent, error)) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(tviewApp, redrawChan, nil, settings.Common),
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		fetch:    fetch,
+		pages:    pages,
 		tviewApp: tviewApp,
 		settings: settings,
+		viewMode: validView(settings.view),
 	}
 
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
+
 	return &widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Disable() {
-	widget.TextWidget.Disable()
+	widget.ScrollableWidget.Disable()
 }
 
 func (widget *Widget) Refresh() {
-	if isAuthenticated(widget.settings.email) {
+	if widget.fetch != nil {
+		widget.fetchAndDisplayEvents()
+		return
+	}
+
+	if isAuthenticated(widget.tokenName()) {
 		widget.fetchAndDisplayEvents()
 		return
 	}
@@ -44,7 +75,14 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) fetchAndDisplayEvents() {
-	calEvents, err := widget.Fetch()
+	fetch := widget.Fetch
+	if widget.fetch != nil {
+		fetch = widget.fetch
+	}
+
+	widget.actionErr = nil
+
+	calEvents, err := fetch()
 	if err != nil {
 		widget.err = err
 		widget.calEvents = []*CalEvent{}
@@ -55,3 +93,43 @@ func (widget *Widget) fetchAndDisplayEvents() {
 
 	widget.display()
 }
+
+// join opens the video call of the selected event if it has one, and otherwise that
+// of the meeting that's taking place, or of the next one that has a video call
+func (widget *Widget) join() {
+	if selected := widget.selectedEvent(); selected != nil && selected.JoinURL() != "" {
+		utils.OpenFile(selected.JoinURL())
+		return
+	}
+
+	now := time.Now()
+
+	var next *CalEvent
+	for _, calEvent := range widget.calEvents {
+		if calEvent.AllDay() || calEvent.JoinURL() == "" || !calEvent.End().After(now) {
+			continue
+		}
+
+		if calEvent.Now() {
+			next = calEvent
+			break
+		}
+
+		if next == nil || calEvent.Start().Before(next.Start()) {
+			next = calEvent
+		}
+	}
+
+	if next != nil {
+		utils.OpenFile(next.JoinURL())
+	}
+}
+
+// selectedEvent returns the event that's selected, if any
+func (widget *Widget) selectedEvent() *CalEvent {
+	if widget.Selected < 0 || widget.Selected >= len(widget.displayed) {
+		return nil
+	}
+
+	return widget.displayed[widget.Selected]
+}
@@ -0,0 +1,29 @@
+package git
+
+import "fmt"
+
+const (
+	backendCLI   = "cli"
+	backendGoGit = "go-git"
+)
+
+// backend reads the state of a repository. The CLI backend shells out to the git
+// binary, while the go-git backend reads the repository directly
+type backend interface {
+	status() repoStatus
+	stashCount() int
+	commits(commitCount int, commitFormat, dateFormat string) []string
+	repository() string
+}
+
+// newBackend returns the named backend for the repository at repoPath
+func newBackend(name string, repoPath string) (backend, error) {
+	switch name {
+	case "", backendCLI:
+		return &cliBackend{path: repoPath}, nil
+	case backendGoGit:
+		return newGoGitBackend(repoPath)
+	default:
+		return nil, fmt.Errorf("%s is not a supported git backend", name)
+	}
+}
@@ -0,0 +1,67 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+// cliBackend reads the state of a repository by running the git binary
+type cliBackend struct {
+	path string
+}
+
+func (cli *cliBackend) status() repoStatus {
+	arg := []string{gitDirArg(cli.path), workTreeArg(cli.path), "status", "--porcelain=v2", "--branch", "-z"}
+
+	cmd := exec.Command(__go_cmd, arg...)
+	str := utils.ExecuteCommand(cmd)
+
+	return parseStatus(str)
+}
+
+func (cli *cliBackend) stashCount() int {
+	arg := []string{gitDirArg(cli.path), workTreeArg(cli.path), "stash", "list"}
+
+	cmd := exec.Command(__go_cmd, arg...)
+	str := strings.TrimSpace(utils.ExecuteCommand(cmd))
+
+	if str == "" {
+		return 0
+	}
+
+	return len(strings.Split(str, "\n"))
+}
+
+func (cli *cliBackend) commits(commitCount int, commitFormat, dateFormat string) []string {
+	dateStr := fmt.Sprintf("--date=format:%s", dateFormat)
+	numStr := fmt.Sprintf("-n %d", commitCount)
+	commitStr := fmt.Sprintf("--pretty=format:%s", commitFormat)
+
+	arg := []string{gitDirArg(cli.path), workTreeArg(cli.path), "log", dateStr, numStr, commitStr}
+
+	cmd := exec.Command(__go_cmd, arg...)
+	str := utils.ExecuteCommand(cmd)
+
+	data := strings.Split(str, "\n")
+
+	return data
+}
+
+func (cli *cliBackend) repository() string {
+	arg := []string{gitDirArg(cli.path), workTreeArg(cli.path), "rev-parse", "--show-toplevel"}
+	cmd := exec.Command(__go_cmd, arg...)
+	str := utils.ExecuteCommand(cmd)
+
+	return str
+}
+
+func gitDirArg(path string) string {
+	return fmt.Sprintf("--git-dir=%s/.git", path)
+}
+
+func workTreeArg(path string) string {
+	return fmt.Sprintf("--work-tree=%s", path)
+}
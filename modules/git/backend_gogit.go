@@ -0,0 +1,247 @@
+package git
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// goGitBackend reads the state of a repository with go-git, without needing a git binary
+type goGitBackend struct {
+	path string
+	repo *gogit.Repository
+}
+
+func newGoGitBackend(repoPath string) (*goGitBackend, error) {
+	repo, err := gogit.PlainOpen(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &goGitBackend{path: repoPath, repo: repo}, nil
+}
+
+func (gg *goGitBackend) status() repoStatus {
+	status := repoStatus{Files: []ChangedFile{}}
+
+	status.Branch = gg.branch()
+	status.Upstream, status.Ahead, status.Behind = gg.upstream(status.Branch)
+
+	worktree, err := gg.repo.Worktree()
+	if err != nil {
+		return status
+	}
+
+	fileStatuses, err := worktree.Status()
+	if err != nil {
+		return status
+	}
+
+	paths := make([]string, 0, len(fileStatuses))
+	for path := range fileStatuses {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		fileStatus := fileStatuses[path]
+
+		file := ChangedFile{
+			Path:     path,
+			Index:    statusCodeChar(fileStatus.Staging),
+			WorkTree: statusCodeChar(fileStatus.Worktree),
+		}
+
+		if fileStatus.Staging == gogit.Renamed || fileStatus.Staging == gogit.Copied {
+			file.OrigPath = fileStatus.Extra
+		}
+
+		if fileStatus.Staging == gogit.UpdatedButUnmerged || fileStatus.Worktree == gogit.UpdatedButUnmerged {
+			file.Conflicted = true
+		}
+
+		if file.Index == '.' && file.WorkTree == '.' {
+			continue
+		}
+
+		status.Files = append(status.Files, file)
+	}
+
+	return status
+}
+
+// stashCount counts the entries in the stash reflog, as go-git has no stash support
+func (gg *goGitBackend) stashCount() int {
+	file, err := os.Open(filepath.Join(gg.path, ".git", "logs", "refs", "stash"))
+	if err != nil {
+		return 0
+	}
+	defer func() { _ = file.Close() }()
+
+	count := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if scanner.Text() != "" {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (gg *goGitBackend) commits(commitCount int, commitFormat, dateFormat string) []string {
+	lines := []string{}
+
+	head, err := gg.repo.Head()
+	if err != nil {
+		return lines
+	}
+
+	iter, err := gg.repo.Log(&gogit.LogOptions{From: head.Hash()})
+	if err != nil {
+		return lines
+	}
+	defer iter.Close()
+
+	errDone := errors.New("done")
+	_ = iter.ForEach(func(commit *object.Commit) error {
+		if len(lines) >= commitCount {
+			return errDone
+		}
+
+		lines = append(lines, formatCommitPretty(commit, commitFormat, dateFormat))
+		return nil
+	})
+
+	return lines
+}
+
+func (gg *goGitBackend) repository() string {
+	worktree, err := gg.repo.Worktree()
+	if err != nil {
+		return gg.path
+	}
+
+	return worktree.Filesystem.Root()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// branch returns the short name of the checked out branch, or "(detached)" if HEAD
+// doesn't point at a branch. This matches `git status --porcelain=v2 --branch`
+func (gg *goGitBackend) branch() string {
+	head, err := gg.repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return ""
+	}
+
+	if head.Type() == plumbing.SymbolicReference && head.Target().IsBranch() {
+		return head.Target().Short()
+	}
+
+	return "(detached)"
+}
+
+// upstream returns the name of the upstream of a branch, and how many commits the
+// branch is ahead and behind it
+func (gg *goGitBackend) upstream(branch string) (string, int, int) {
+	config, err := gg.repo.Config()
+	if err != nil {
+		return "", 0, 0
+	}
+
+	branchConfig, ok := config.Branches[branch]
+	if !ok || branchConfig.Remote == "" || branchConfig.Merge == "" {
+		return "", 0, 0
+	}
+
+	upstreamName := branchConfig.Remote + "/" + branchConfig.Merge.Short()
+	upstreamRef := plumbing.NewRemoteReferenceName(branchConfig.Remote, branchConfig.Merge.Short())
+	if branchConfig.Remote == "." {
+		upstreamName = branchConfig.Merge.Short()
+		upstreamRef = branchConfig.Merge
+	}
+
+	local, err := gg.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		return upstreamName, 0, 0
+	}
+
+	remote, err := gg.repo.Reference(upstreamRef, true)
+	if err != nil {
+		return upstreamName, 0, 0
+	}
+
+	ahead, behind, err := gg.aheadBehind(local.Hash(), remote.Hash())
+	if err != nil {
+		return upstreamName, 0, 0
+	}
+
+	return upstreamName, ahead, behind
+}
+
+// aheadBehind counts the commits reachable from one commit but not from the other, by
+// walking back from each of them until reaching their merge base
+func (gg *goGitBackend) aheadBehind(local, upstream plumbing.Hash) (int, int, error) {
+	if local == upstream {
+		return 0, 0, nil
+	}
+
+	localCommit, err := gg.repo.CommitObject(local)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	upstreamCommit, err := gg.repo.CommitObject(upstream)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bases, err := localCommit.MergeBase(upstreamCommit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ahead, err := countCommitsUntil(localCommit, bases)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	behind, err := countCommitsUntil(upstreamCommit, bases)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return ahead, behind, nil
+}
+
+func countCommitsUntil(from *object.Commit, stops []*object.Commit) (int, error) {
+	stopHashes := map[plumbing.Hash]bool{}
+	for _, stop := range stops {
+		stopHashes[stop.Hash] = true
+	}
+
+	count := 0
+	err := object.NewCommitPreorderIter(from, stopHashes, nil).ForEach(func(*object.Commit) error {
+		count++
+		return nil
+	})
+
+	return count, err
+}
+
+// statusCodeChar converts a go-git status code into the character git uses for it
+// in porcelain v2 output
+func statusCodeChar(code gogit.StatusCode) byte {
+	if code == gogit.Unmodified {
+		return '.'
+	}
+
+	return byte(code)
+}
@@ -0,0 +1,105 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepo creates a repository with two commits, a staged file, a modified file and
+// an untracked file
+func newTestRepo(t *testing.T) string {
+	dir := t.TempDir()
+
+	repo, err := gogit.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	signature := &object.Signature{
+		Name:  "Test User",
+		Email: "test@example.com",
+		When:  time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC),
+	}
+
+	for _, name := range []string{"a.txt", "b.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0o600))
+		_, err = worktree.Add(name)
+		require.NoError(t, err)
+		_, err = worktree.Commit("Add "+name, &gogit.CommitOptions{Author: signature, Committer: signature})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.txt"), []byte("c\n"), 0o600))
+	_, err = worktree.Add("c.txt")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "d.txt"), []byte("d\n"), 0o600))
+
+	return dir
+}
+
+func Test_goGitBackend(t *testing.T) {
+	dir := newTestRepo(t)
+
+	reader, err := newBackend(backendGoGit, dir)
+	require.NoError(t, err)
+
+	status := reader.status()
+	assert.Equal(t, "master", status.Branch)
+	assert.Equal(t, []ChangedFile{
+		{Path: "a.txt", Index: '.', WorkTree: 'M'},
+		{Path: "c.txt", Index: 'A', WorkTree: '.'},
+		{Path: "d.txt", Index: '?', WorkTree: '?'},
+	}, status.Files)
+
+	assert.Equal(t, 0, reader.stashCount())
+	assert.Equal(t, dir, reader.repository())
+	assert.Equal(
+		t,
+		[]string{"Add b.txt Test User on Mar 05, 2024", "Add a.txt Test User on Mar 05, 2024"},
+		reader.commits(5, "%s %an on %cd", "%b %d, %Y"),
+	)
+}
+
+func Test_backendsMatch(t *testing.T) {
+	if _, err := exec.LookPath(__go_cmd); err != nil {
+		t.Skip("git binary not available")
+	}
+
+	dir := newTestRepo(t)
+
+	cli, err := newBackend(backendCLI, dir)
+	require.NoError(t, err)
+
+	goGit, err := newBackend(backendGoGit, dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, cli.status(), goGit.status())
+	assert.Equal(t, cli.stashCount(), goGit.stashCount())
+
+	commitFormat := "[forestgreen]%h [white]%s [grey]%an on %cd[white]"
+	dateFormat := "%b %d, %Y"
+	assert.Equal(t, cli.commits(5, commitFormat, dateFormat), goGit.commits(5, commitFormat, dateFormat))
+}
+
+func Test_newBackend_unknown(t *testing.T) {
+	_, err := newBackend("svn", t.TempDir())
+	assert.Error(t, err)
+}
+
+func Test_strftime(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 14, 30, 9, 0, time.UTC)
+
+	assert.Equal(t, "Mar 05, 2024", strftime(date, "%b %d, %Y"))
+	assert.Equal(t, "2024-03-05 14:30:09", strftime(date, "%Y-%m-%d %H:%M:%S"))
+	assert.Equal(t, "Tuesday 02PM 100% %Q", strftime(date, "%A %I%p 100%% %Q"))
+}
@@ -3,9 +3,22 @@ package git
 import (
 	"fmt"
 	"strings"
-	"unicode/utf8"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
 )
 
+var changeColors = map[byte]string{
+	'A': "green",
+	'C': "purple",
+	'D': "red",
+	'M': "yellow",
+	'R': "purple",
+	'T': "yellow",
+	'U': "red",
+	'?': "grey",
+}
+
 func (widget *Widget) display() {
 	widget.Redraw(widget.content)
 }
@@ -16,6 +29,8 @@ func (widget *Widget) content() (string, string, bool) {
 		return widget.CommonSettings().Title, " Git repo data is unavailable ", false
 	}
 
+	widget.SetItemCount(len(repoData.ChangedFiles))
+
 	widgetTitle := ""
 	if widget.settings.lastFolderTitle {
 		pathParts := strings.Split(repoData.Repository, "/")
@@ -43,11 +58,20 @@ func (widget *Widget) content() (string, string, bool) {
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.GitRepos), widget.Idx, width) + "\n"
+
+	if widget.err != nil {
+		str += fmt.Sprintf(" [red]%s[white]\n\n", tview.Escape(widget.err.Error()))
+	}
+
+	if repoData.Err != nil {
+		str += fmt.Sprintf(" [red]%s[white]\n", tview.Escape(repoData.Err.Error()))
+		return title, str, false
+	}
+
 	for _, v := range widget.settings.sections {
 		if v == "branch" {
-			str += fmt.Sprintf(" [%s]Branch[white]\n", widget.settings.Colors.Subheading)
-			str += fmt.Sprintf(" %s", repoData.Branch)
-		} else if v == "files" && (widget.settings.showFilesIfEmpty || len(repoData.ChangedFiles) > 1) {
+			str += widget.formatBranch(repoData)
+		} else if v == "files" && (widget.settings.showFilesIfEmpty || len(repoData.ChangedFiles) > 0) {
 			str += widget.formatChanges(repoData.ChangedFiles)
 		} else if v == "commits" {
 			str += widget.formatCommits(repoData.Commits)
@@ -58,41 +82,74 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
-func (widget *Widget) formatChanges(data []string) string {
+func (widget *Widget) formatBranch(repo *GitRepo) string {
+	str := fmt.Sprintf(" [%s]Branch[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" %s", repo.Branch)
+
+	if repo.Upstream != "" {
+		str += fmt.Sprintf(" [grey]%s[white]", repo.Upstream)
+
+		if repo.Ahead > 0 {
+			str += fmt.Sprintf(" [green]↑%d[white]", repo.Ahead)
+		}
+		if repo.Behind > 0 {
+			str += fmt.Sprintf(" [red]↓%d[white]", repo.Behind)
+		}
+	}
+
+	if repo.StashCount > 0 {
+		str += fmt.Sprintf(" [yellow]stash: %d[white]", repo.StashCount)
+	}
+
+	if repo.State != "" {
+		str += fmt.Sprintf(" [red::b]%s[::-][white]", strings.ToUpper(repo.State))
+	}
+
+	return str + "\n"
+}
+
+func (widget *Widget) formatChanges(data []ChangedFile) string {
 	str := fmt.Sprintf(" [%s]Changed Files[white]\n", widget.settings.Colors.Subheading)
 
-	if len(data) == 1 {
+	if len(data) == 0 {
 		str += " [grey]none[white]\n"
 	} else {
-		for _, line := range data {
-			str += widget.formatChange(line)
+		for idx, file := range data {
+			str += widget.formatChange(idx, file)
 		}
 	}
 
 	return str
 }
 
-func (widget *Widget) formatChange(line string) string {
-	if line == "" {
-		return ""
+func (widget *Widget) formatChange(idx int, file ChangedFile) string {
+	path := file.Path
+	if file.OrigPath != "" {
+		path = fmt.Sprintf("%s -> %s", file.OrigPath, file.Path)
 	}
 
-	line = strings.TrimSpace(line)
-	firstChar, _ := utf8.DecodeRuneInString(line)
+	row := fmt.Sprintf(
+		" %s%s [%s]%s",
+		formatStatusChar(file.Index, file.Conflicted),
+		formatStatusChar(file.WorkTree, file.Conflicted),
+		widget.RowColor(idx),
+		tview.Escape(path),
+	)
+
+	return utils.HighlightableHelper(widget.View, row, idx, len(path)+4)
+}
 
-	// Revisit this and kill the ugly duplication
-	switch firstChar {
-	case 'A':
-		line = strings.Replace(line, "A", "[green]A[white]", 1)
-	case 'D':
-		line = strings.Replace(line, "D", "[red]D[white]", 1)
-	case 'M':
-		line = strings.Replace(line, "M", "[yellow]M[white]", 1)
-	case 'R':
-		line = strings.Replace(line, "R", "[purple]R[white]", 1)
+func formatStatusChar(char byte, conflicted bool) string {
+	if char == '.' {
+		return " "
 	}
 
-	return fmt.Sprintf(" %s\n", strings.ReplaceAll(line, "\"", ""))
+	color, ok := changeColors[char]
+	if conflicted || !ok {
+		color = "red"
+	}
+
+	return fmt.Sprintf("[%s]%c[white]", color, char)
 }
 
 func (widget *Widget) formatCommits(data []string) string {
@@ -108,3 +165,27 @@ func (widget *Widget) formatCommits(data []string) string {
 func (widget *Widget) formatCommit(line string) string {
 	return fmt.Sprintf(" %s\n", strings.ReplaceAll(line, "\"", ""))
 }
+
+// formatDiff colors a unified diff for display
+func formatDiff(diff string) string {
+	str := ""
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		escaped := tview.Escape(line)
+
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			str += fmt.Sprintf("[::b]%s[::-]\n", escaped)
+		case strings.HasPrefix(line, "+"):
+			str += fmt.Sprintf("[green]%s[white]\n", escaped)
+		case strings.HasPrefix(line, "-"):
+			str += fmt.Sprintf("[red]%s[white]\n", escaped)
+		case strings.HasPrefix(line, "@@"):
+			str += fmt.Sprintf("[aqua]%s[white]\n", escaped)
+		default:
+			str += escaped + "\n"
+		}
+	}
+
+	return str
+}
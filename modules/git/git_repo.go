@@ -1,94 +1,195 @@
 package git
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/wtfutil/wtf/utils"
 )
 
+// maxUntrackedPreview is the largest untracked file that will be shown in the diff preview
+const maxUntrackedPreview = 64 * 1024
+
 type GitRepo struct {
 	Branch       string
-	ChangedFiles []string
+	Upstream     string
+	Ahead        int
+	Behind       int
+	StashCount   int
+	State        string
+	ChangedFiles []ChangedFile
 	Commits      []string
 	Repository   string
 	Path         string
+	Err          error
 }
 
-func NewGitRepo(repoPath string, commitCount int, commitFormat, dateFormat string) *GitRepo {
-	repo := GitRepo{Path: repoPath}
-
-	repo.Branch = repo.branch()
-	repo.ChangedFiles = repo.changedFiles()
-	repo.Commits = repo.commits(commitCount, commitFormat, dateFormat)
-	repo.Repository = strings.TrimSpace(repo.repository())
+// NewGitRepo reads the state of the repository at repoPath using the named backend.
+// Actions such as pull and commit always use the git binary, whichever backend is used
+func NewGitRepo(repoPath string, backendName string, commitCount int, commitFormat, dateFormat string) *GitRepo {
+	repo := GitRepo{Path: repoPath, Repository: repoPath}
+
+	reader, err := newBackend(backendName, repoPath)
+	if err != nil {
+		repo.Err = err
+		return &repo
+	}
+
+	status := reader.status()
+	repo.Branch = status.Branch
+	repo.Upstream = status.Upstream
+	repo.Ahead = status.Ahead
+	repo.Behind = status.Behind
+	repo.ChangedFiles = status.Files
+
+	repo.StashCount = reader.stashCount()
+	repo.State = repo.state()
+	repo.Commits = reader.commits(commitCount, commitFormat, dateFormat)
+	repo.Repository = strings.TrimSpace(reader.repository())
 
 	return &repo
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (repo *GitRepo) branch() string {
-	arg := []string{repo.gitDir(), repo.workTree(), "rev-parse", "--abbrev-ref", "HEAD"}
+// state returns the name of the operation currently in progress in the repository,
+// such as a merge or a rebase, or an empty string if there isn't one
+func (repo *GitRepo) state() string {
+	gitDir := filepath.Join(repo.Path, ".git")
+
+	markers := []struct {
+		path  string
+		state string
+	}{
+		{"rebase-merge", "rebasing"},
+		{"rebase-apply", "rebasing"},
+		{"MERGE_HEAD", "merging"},
+		{"CHERRY_PICK_HEAD", "cherry-picking"},
+		{"REVERT_HEAD", "reverting"},
+		{"BISECT_LOG", "bisecting"},
+	}
+
+	for _, marker := range markers {
+		if _, err := os.Stat(filepath.Join(gitDir, marker.path)); err == nil {
+			return marker.state
+		}
+	}
+
+	return ""
+}
 
+func (repo *GitRepo) pull() string {
+	arg := []string{gitDirArg(repo.Path), workTreeArg(repo.Path), "pull"}
 	cmd := exec.Command(__go_cmd, arg...)
 	str := utils.ExecuteCommand(cmd)
-
 	return str
 }
 
-func (repo *GitRepo) changedFiles() []string {
-	arg := []string{repo.gitDir(), repo.workTree(), "status", "--porcelain"}
-
+func (repo *GitRepo) checkout(branch string) string {
+	arg := []string{gitDirArg(repo.Path), workTreeArg(repo.Path), "checkout", branch}
 	cmd := exec.Command(__go_cmd, arg...)
 	str := utils.ExecuteCommand(cmd)
-
-	data := strings.Split(str, "\n")
-
-	return data
+	return str
 }
 
-func (repo *GitRepo) commits(commitCount int, commitFormat, dateFormat string) []string {
-	dateStr := fmt.Sprintf("--date=format:\"%s\"", dateFormat)
-	numStr := fmt.Sprintf("-n %d", commitCount)
-	commitStr := fmt.Sprintf("--pretty=format:\"%s\"", commitFormat)
-
-	arg := []string{repo.gitDir(), repo.workTree(), "log", dateStr, numStr, commitStr}
-
-	cmd := exec.Command(__go_cmd, arg...)
-	str := utils.ExecuteCommand(cmd)
+func (repo *GitRepo) stage(file ChangedFile) error {
+	_, err := repo.run("add", "--", file.Path)
+	return err
+}
 
-	data := strings.Split(str, "\n")
+func (repo *GitRepo) unstage(file ChangedFile) error {
+	_, err := repo.run("restore", "--staged", "--", file.Path)
+	return err
+}
 
-	return data
+func (repo *GitRepo) fetchAll() error {
+	_, err := repo.run("fetch", "--all", "--prune")
+	return err
 }
 
-func (repo *GitRepo) repository() string {
-	arg := []string{repo.gitDir(), repo.workTree(), "rev-parse", "--show-toplevel"}
-	cmd := exec.Command(__go_cmd, arg...)
-	str := utils.ExecuteCommand(cmd)
+func (repo *GitRepo) commit(message string) error {
+	_, err := repo.run("commit", "-m", message)
+	return err
+}
 
-	return str
+// diff returns the staged and unstaged changes to a file. For untracked files, the
+// whole file is returned as an addition
+func (repo *GitRepo) diff(file ChangedFile) (string, error) {
+	if file.IsUntracked() {
+		return repo.untrackedDiff(file)
+	}
+
+	str := ""
+
+	if file.IsStaged() {
+		staged, err := repo.run("diff", "--cached", "--", file.Path)
+		if err != nil {
+			return "", err
+		}
+		str += staged
+	}
+
+	if file.WorkTree != '.' {
+		unstaged, err := repo.run("diff", "--", file.Path)
+		if err != nil {
+			return "", err
+		}
+		str += unstaged
+	}
+
+	return str, nil
 }
-func (repo *GitRepo) pull() string {
-	arg := []string{repo.gitDir(), repo.workTree(), "pull"}
-	cmd := exec.Command(__go_cmd, arg...)
-	str := utils.ExecuteCommand(cmd)
-	return str
+
+func (repo *GitRepo) untrackedDiff(file ChangedFile) (string, error) {
+	path := filepath.Join(repo.Path, file.Path)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return fmt.Sprintf("%s is an untracked directory\n", file.Path), nil
+	}
+	if info.Size() > maxUntrackedPreview {
+		return fmt.Sprintf("%s is too large to preview\n", file.Path), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	str := fmt.Sprintf("new file %s\n", file.Path)
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		str += "+" + line + "\n"
+	}
+
+	return str, nil
 }
 
-func (repo *GitRepo) checkout(branch string) string {
-	arg := []string{repo.gitDir(), repo.workTree(), "checkout", branch}
+// run executes a git command in the repository and returns its output. If the command
+// fails, the returned error contains whatever git wrote to stderr
+func (repo *GitRepo) run(args ...string) (string, error) {
+	arg := append([]string{gitDirArg(repo.Path), workTreeArg(repo.Path)}, args...)
 	cmd := exec.Command(__go_cmd, arg...)
-	str := utils.ExecuteCommand(cmd)
-	return str
-}
+	cmd.Dir = repo.Path
 
-func (repo *GitRepo) gitDir() string {
-	return fmt.Sprintf("--git-dir=%s/.git", repo.Path)
-}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", errors.New(msg)
+		}
+		return "", err
+	}
 
-func (repo *GitRepo) workTree() string {
-	return fmt.Sprintf("--work-tree=%s", repo.Path)
+	return stdout.String(), nil
 }
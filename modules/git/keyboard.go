@@ -6,11 +6,21 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
 	widget.InitializeRefreshKeyboardControl(widget.Refresh)
 
+	widget.SetKeyboardChar("j", widget.Next, "Select next file")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous file")
 	widget.SetKeyboardChar("l", widget.NextSource, "Select next source")
 	widget.SetKeyboardChar("h", widget.PrevSource, "Select previous source")
 	widget.SetKeyboardChar("p", widget.Pull, "Pull repo")
 	widget.SetKeyboardChar("c", widget.Checkout, "Checkout branch")
+	widget.SetKeyboardChar("s", widget.Stage, "Stage file")
+	widget.SetKeyboardChar("u", widget.Unstage, "Unstage file")
+	widget.SetKeyboardChar("f", widget.FetchAll, "Fetch all remotes")
+	widget.SetKeyboardChar("C", widget.Commit, "Commit staged changes")
 
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next file")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous file")
 	widget.SetKeyboardKey(tcell.KeyLeft, widget.PrevSource, "Select previous source")
 	widget.SetKeyboardKey(tcell.KeyRight, widget.NextSource, "Select next source")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.ShowDiff, "Show diff of file")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
 }
@@ -0,0 +1,128 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// formatCommitPretty renders a commit using a subset of the placeholders understood by
+// `git log --pretty=format:`. Dates are rendered with dateFormat, which uses the same
+// strftime directives as `git log --date=format:`
+func formatCommitPretty(commit *object.Commit, format, dateFormat string) string {
+	subject, body, _ := strings.Cut(commit.Message, "\n")
+
+	placeholders := map[string]string{
+		"H":  commit.Hash.String(),
+		"h":  commit.Hash.String()[:7],
+		"s":  subject,
+		"b":  strings.TrimSpace(body),
+		"an": commit.Author.Name,
+		"ae": commit.Author.Email,
+		"ad": strftime(commit.Author.When, dateFormat),
+		"ar": humanize.Time(commit.Author.When),
+		"cn": commit.Committer.Name,
+		"ce": commit.Committer.Email,
+		"cd": strftime(commit.Committer.When, dateFormat),
+		"cr": humanize.Time(commit.Committer.When),
+		"n":  "\n",
+		"%":  "%",
+	}
+
+	return expandDirectives(format, func(directive string) (string, int, bool) {
+		// Two-character placeholders take precedence over one-character ones
+		for _, length := range []int{2, 1} {
+			if len(directive) < length {
+				continue
+			}
+
+			if value, ok := placeholders[directive[:length]]; ok {
+				return value, length, true
+			}
+		}
+
+		return "", 0, false
+	})
+}
+
+// strftime formats a time using the common C strftime directives
+func strftime(t time.Time, format string) string {
+	return expandDirectives(format, func(directive string) (string, int, bool) {
+		var value string
+
+		switch directive[0] {
+		case 'a':
+			value = t.Format("Mon")
+		case 'A':
+			value = t.Format("Monday")
+		case 'b', 'h':
+			value = t.Format("Jan")
+		case 'B':
+			value = t.Format("January")
+		case 'd':
+			value = t.Format("02")
+		case 'e':
+			value = fmt.Sprintf("%2d", t.Day())
+		case 'F':
+			value = t.Format("2006-01-02")
+		case 'H':
+			value = t.Format("15")
+		case 'I':
+			value = t.Format("03")
+		case 'j':
+			value = fmt.Sprintf("%03d", t.YearDay())
+		case 'm':
+			value = t.Format("01")
+		case 'M':
+			value = t.Format("04")
+		case 'p':
+			value = t.Format("PM")
+		case 'S':
+			value = t.Format("05")
+		case 'T':
+			value = t.Format("15:04:05")
+		case 'y':
+			value = t.Format("06")
+		case 'Y':
+			value = t.Format("2006")
+		case 'z':
+			value = t.Format("-0700")
+		case 'Z':
+			value = t.Format("MST")
+		case '%':
+			value = "%"
+		default:
+			return "", 0, false
+		}
+
+		return value, 1, true
+	})
+}
+
+// expandDirectives replaces each %-directive in format with the value returned by
+// lookup, which is given the text following the % and returns the replacement and
+// the length of the directive it consumed. Unknown directives are left untouched
+func expandDirectives(format string, lookup func(directive string) (string, int, bool)) string {
+	var builder strings.Builder
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			builder.WriteByte(format[i])
+			continue
+		}
+
+		value, length, ok := lookup(format[i+1:])
+		if !ok {
+			builder.WriteByte(format[i])
+			continue
+		}
+
+		builder.WriteString(value)
+		i += length
+	}
+
+	return builder.String()
+}
@@ -23,6 +23,7 @@ type Settings struct {
 	commitFormat     string        `help:"The string format for the commit message." optional:"true"`
 	dateFormat       string        `help:"The string format for the date/time in the commit message." optional:"true"`
 	repositories     []interface{} `help:"Defines which git repositories to watch." values:"A list of zero or more local file paths pointing to valid git repositories."`
+	backend          string        `help:"How repository data is read. 'go-git' reads repositories directly and does not need a git binary, though actions such as pull and commit still do." values:"cli or go-git" optional:"true" default:"cli"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -38,6 +39,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		commitFormat:     ymlConfig.UString("commitFormat", "[forestgreen]%h [white]%s [grey]%an on %cd[white]"),
 		dateFormat:       ymlConfig.UString("dateFormat", "%b %d, %Y"),
 		repositories:     ymlConfig.UList("repositories"),
+		backend:          ymlConfig.UString("backend", backendCLI),
 	}
 	if len(settings.sections) == 0 {
 		for _, v := range []string{"branch", "files", "commits"} {
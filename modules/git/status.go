@@ -0,0 +1,118 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ChangedFile is a single entry from the output of `git status`
+type ChangedFile struct {
+	Path       string
+	OrigPath   string // The path the file was renamed or copied from, if it was
+	Index      byte   // The status of the file in the index, '.' if unchanged
+	WorkTree   byte   // The status of the file in the work tree, '.' if unchanged
+	Conflicted bool
+}
+
+// IsStaged returns true if the file has changes in the index
+func (file ChangedFile) IsStaged() bool {
+	return !file.Conflicted && file.Index != '.' && file.Index != '?'
+}
+
+// IsUntracked returns true if the file is not tracked by git
+func (file ChangedFile) IsUntracked() bool {
+	return file.Index == '?'
+}
+
+// repoStatus is the parsed output of `git status --porcelain=v2 --branch -z`
+type repoStatus struct {
+	Branch   string
+	Upstream string
+	Ahead    int
+	Behind   int
+	Files    []ChangedFile
+}
+
+// parseStatus parses the output of `git status --porcelain=v2 --branch -z`.
+// See https://git-scm.com/docs/git-status#_porcelain_format_version_2
+func parseStatus(output string) repoStatus {
+	status := repoStatus{Files: []ChangedFile{}}
+
+	entries := strings.Split(output, "\x00")
+
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if entry == "" {
+			continue
+		}
+
+		switch entry[0] {
+		case '#':
+			status.parseHeader(entry)
+		case '1':
+			// 1 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <path>
+			fields := strings.SplitN(entry, " ", 9)
+			if len(fields) == 9 {
+				status.Files = append(status.Files, newChangedFile(fields[1], fields[8]))
+			}
+		case '2':
+			// 2 <XY> <sub> <mH> <mI> <mW> <hH> <hI> <X><score> <path>, followed by
+			// the original path as a separate entry
+			fields := strings.SplitN(entry, " ", 10)
+			if len(fields) == 10 {
+				file := newChangedFile(fields[1], fields[9])
+				if i+1 < len(entries) {
+					i++
+					file.OrigPath = entries[i]
+				}
+				status.Files = append(status.Files, file)
+			}
+		case 'u':
+			// u <XY> <sub> <m1> <m2> <m3> <mW> <h1> <h2> <h3> <path>
+			fields := strings.SplitN(entry, " ", 11)
+			if len(fields) == 11 {
+				file := newChangedFile(fields[1], fields[10])
+				file.Conflicted = true
+				status.Files = append(status.Files, file)
+			}
+		case '?':
+			status.Files = append(status.Files, ChangedFile{
+				Path:     strings.TrimPrefix(entry, "? "),
+				Index:    '?',
+				WorkTree: '?',
+			})
+		}
+	}
+
+	return status
+}
+
+func (status *repoStatus) parseHeader(entry string) {
+	fields := strings.Fields(entry)
+	if len(fields) < 3 {
+		return
+	}
+
+	switch fields[1] {
+	case "branch.head":
+		status.Branch = fields[2]
+	case "branch.upstream":
+		status.Upstream = fields[2]
+	case "branch.ab":
+		if len(fields) == 4 {
+			status.Ahead, _ = strconv.Atoi(strings.TrimPrefix(fields[2], "+"))
+			status.Behind, _ = strconv.Atoi(strings.TrimPrefix(fields[3], "-"))
+		}
+	}
+}
+
+func newChangedFile(xy, path string) ChangedFile {
+	file := ChangedFile{Path: path, Index: '.', WorkTree: '.'}
+
+	if len(xy) == 2 {
+		file.Index = xy[0]
+		file.WorkTree = xy[1]
+	}
+
+	return file
+}
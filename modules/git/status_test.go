@@ -0,0 +1,54 @@
+package git
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseStatus(t *testing.T) {
+	output := strings.Join([]string{
+		"# branch.oid 1234567890abcdef",
+		"# branch.head main",
+		"# branch.upstream origin/main",
+		"# branch.ab +2 -3",
+		"1 M. N... 100644 100644 100644 abc def README.md",
+		"1 .M N... 100644 100644 100644 abc def path with spaces.go",
+		"2 R. N... 100644 100644 100644 abc def R100 new.go",
+		"old.go",
+		"u UU N... 100644 100644 100644 100644 abc def ghi conflict.go",
+		"? untracked.txt",
+		"",
+	}, "\x00")
+
+	status := parseStatus(output)
+
+	assert.Equal(t, "main", status.Branch)
+	assert.Equal(t, "origin/main", status.Upstream)
+	assert.Equal(t, 2, status.Ahead)
+	assert.Equal(t, 3, status.Behind)
+
+	assert.Equal(t, []ChangedFile{
+		{Path: "README.md", Index: 'M', WorkTree: '.'},
+		{Path: "path with spaces.go", Index: '.', WorkTree: 'M'},
+		{Path: "new.go", OrigPath: "old.go", Index: 'R', WorkTree: '.'},
+		{Path: "conflict.go", Index: 'U', WorkTree: 'U', Conflicted: true},
+		{Path: "untracked.txt", Index: '?', WorkTree: '?'},
+	}, status.Files)
+
+	assert.True(t, status.Files[0].IsStaged())
+	assert.False(t, status.Files[1].IsStaged())
+	assert.False(t, status.Files[3].IsStaged())
+	assert.False(t, status.Files[4].IsStaged())
+	assert.True(t, status.Files[4].IsUntracked())
+}
+
+func Test_parseStatus_noUpstream(t *testing.T) {
+	status := parseStatus("# branch.oid (initial)\x00# branch.head (detached)\x00")
+
+	assert.Equal(t, "(detached)", status.Branch)
+	assert.Equal(t, "", status.Upstream)
+	assert.Equal(t, 0, status.Ahead)
+	assert.Empty(t, status.Files)
+}
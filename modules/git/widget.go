@@ -1,14 +1,17 @@
 package git
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"runtime"
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -19,10 +22,11 @@ const (
 
 type Widget struct {
 	view.MultiSourceWidget
-	view.TextWidget
+	view.ScrollableWidget
 
 	GitRepos []*GitRepo
 
+	err      error
 	pages    *tview.Pages
 	settings *Settings
 	tviewApp *tview.Application
@@ -31,7 +35,7 @@ type Widget struct {
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.Common, "repository", "repositories"),
-		TextWidget:        view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+		ScrollableWidget:  view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
 		tviewApp: tviewApp,
 		pages:    pages,
@@ -41,6 +45,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	widget.initializeKeyboardControls()
 
 	widget.SetDisplayFunction(widget.display)
+	widget.SetRenderFunction(widget.display)
 
 	return &widget
 }
@@ -60,10 +65,60 @@ func (widget *Widget) Checkout() {
 		widget.Refresh()
 	}
 
-	widget.addButtons(form, checkoutFctn)
+	widget.addButtons(form, "Checkout", checkoutFctn)
 	widget.modalFocus(form)
 }
 
+// Commit prompts for a commit message and commits the staged changes with it
+func (widget *Widget) Commit() {
+	repo := widget.currentData()
+	if repo == nil {
+		return
+	}
+
+	form := widget.modalForm("Commit message:", "")
+
+	commitFctn := func() {
+		text := form.GetFormItem(0).(*tview.InputField).GetText()
+		widget.pages.RemovePage("modal")
+		widget.tviewApp.SetFocus(widget.View)
+
+		if strings.TrimSpace(text) == "" {
+			widget.display()
+			return
+		}
+
+		widget.performAction(func() error {
+			return repo.commit(text)
+		})
+	}
+
+	widget.addButtons(form, "Commit", commitFctn)
+	widget.modalFocus(form)
+}
+
+// FetchAll fetches all remotes of the current repository
+func (widget *Widget) FetchAll() {
+	repo := widget.currentData()
+	if repo == nil {
+		return
+	}
+
+	widget.performAction(repo.fetchAll)
+}
+
+// NextSource displays the next repository and clears the file selection
+func (widget *Widget) NextSource() {
+	widget.MultiSourceWidget.NextSource()
+	widget.Unselect()
+}
+
+// PrevSource displays the previous repository and clears the file selection
+func (widget *Widget) PrevSource() {
+	widget.MultiSourceWidget.PrevSource()
+	widget.Unselect()
+}
+
 func (widget *Widget) Pull() {
 	repoToPull := widget.GitRepos[widget.Idx]
 	repoToPull.pull()
@@ -72,6 +127,8 @@ func (widget *Widget) Pull() {
 }
 
 func (widget *Widget) Refresh() {
+	widget.err = nil
+
 	repoPaths := utils.ToStrs(widget.settings.repositories)
 
 	widget.GitRepos = widget.gitRepos(repoPaths)
@@ -79,14 +136,63 @@ func (widget *Widget) Refresh() {
 	widget.display()
 }
 
-/* -------------------- Unexported Functions -------------------- */
+// ShowDiff displays the changes to the selected file in a modal
+func (widget *Widget) ShowDiff() {
+	repo := widget.currentData()
+	file := widget.selectedFile()
+	if repo == nil || file == nil {
+		return
+	}
+
+	diff, err := repo.diff(*file)
+	if err != nil {
+		diff = err.Error()
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("diff")
+		widget.tviewApp.SetFocus(widget.View)
+	}
+
+	modal := view.NewBillboardModal(formatDiff(diff), closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s  ", file.Path))
+
+	widget.pages.AddPage("diff", modal, false, true)
+	widget.tviewApp.SetFocus(modal)
 
-func (widget *Widget) addCheckoutButton(form *tview.Form, fctn func()) {
-	form.AddButton("Checkout", fctn)
+	widget.RedrawChan <- true
 }
 
-func (widget *Widget) addButtons(form *tview.Form, checkoutFctn func()) {
-	widget.addCheckoutButton(form, checkoutFctn)
+// Stage adds the selected file to the index
+func (widget *Widget) Stage() {
+	repo := widget.currentData()
+	file := widget.selectedFile()
+	if repo == nil || file == nil {
+		return
+	}
+
+	widget.performAction(func() error {
+		return repo.stage(*file)
+	})
+}
+
+// Unstage removes the selected file from the index
+func (widget *Widget) Unstage() {
+	repo := widget.currentData()
+	file := widget.selectedFile()
+	if repo == nil || file == nil || !file.IsStaged() {
+		return
+	}
+
+	widget.performAction(func() error {
+		return repo.unstage(*file)
+	})
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) addButtons(form *tview.Form, label string, fctn func()) {
+	form.AddButton(label, fctn)
 	widget.addCancelButton(form)
 }
 
@@ -135,6 +241,28 @@ func (widget *Widget) modalFrame(form *tview.Form) *tview.Frame {
 	return frame
 }
 
+// performAction runs a git command against the current repository and refreshes the
+// widget. If the command fails, its error is displayed above the repository data
+func (widget *Widget) performAction(action func() error) {
+	err := action()
+	widget.Refresh()
+
+	if err != nil {
+		widget.err = err
+		widget.display()
+	}
+}
+
+// selectedFile returns the currently-selected changed file, if there is one
+func (widget *Widget) selectedFile() *ChangedFile {
+	repo := widget.currentData()
+	if repo == nil || widget.Selected < 0 || widget.Selected >= len(repo.ChangedFiles) {
+		return nil
+	}
+
+	return &repo.ChangedFiles[widget.Selected]
+}
+
 func (widget *Widget) currentData() *GitRepo {
 	if len(widget.GitRepos) == 0 {
 		return nil
@@ -147,29 +275,44 @@ func (widget *Widget) currentData() *GitRepo {
 	return widget.GitRepos[widget.Idx]
 }
 
+// gitRepos reads the state of every configured repository. Paths ending in a path
+// separator are searched for repositories. The repositories are read in parallel
 func (widget *Widget) gitRepos(repoPaths []string) []*GitRepo {
-	repos := []*GitRepo{}
+	paths := []string{}
 
 	for _, repoPath := range repoPaths {
 		if strings.HasSuffix(repoPath, string(os.PathSeparator)) {
-			repos = append(repos, widget.findGitRepositories(make([]*GitRepo, 0), repoPath)...)
-
+			paths = append(paths, widget.findGitRepositories(make([]string, 0), repoPath)...)
 		} else {
-			repo := NewGitRepo(
-				repoPath,
+			paths = append(paths, repoPath)
+		}
+	}
+
+	repos := make([]*GitRepo, len(paths))
+
+	group := errgroup.Group{}
+	group.SetLimit(runtime.NumCPU())
+
+	for i, path := range paths {
+		group.Go(func() error {
+			repos[i] = NewGitRepo(
+				path,
+				widget.settings.backend,
 				widget.settings.commitCount,
 				widget.settings.commitFormat,
 				widget.settings.dateFormat,
 			)
-
-			repos = append(repos, repo)
-		}
+			return nil
+		})
 	}
 
+	_ = group.Wait()
+
 	return repos
 }
 
-func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory string) []*GitRepo {
+// findGitRepositories returns the paths of all the git repositories below directory
+func (widget *Widget) findGitRepositories(repositories []string, directory string) []string {
 	directory = strings.TrimSuffix(directory, string(os.PathSeparator))
 
 	files, err := os.ReadDir(directory)
@@ -185,15 +328,7 @@ func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory str
 
 			if file.Name() == ".git" {
 				path = strings.TrimSuffix(path, string(os.PathSeparator)+".git")
-
-				repo := NewGitRepo(
-					path,
-					widget.settings.commitCount,
-					widget.settings.commitFormat,
-					widget.settings.dateFormat,
-				)
-
-				repositories = append(repositories, repo)
+				repositories = append(repositories, path)
 				continue
 			}
 			if file.Name() == "vendor" || file.Name() == "node_modules" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	ghb "github.com/google/go-github/v32/github"
+	"github.com/rivo/tview"
 )
 
 func (widget *Widget) display() {
@@ -21,19 +22,23 @@ func (widget *Widget) content() (string, string, bool) {
 		widget.View.ScrollToBeginning()
 	}
 
-	// initial maxItems count
 	widget.Items = make([]int, 0)
-	widget.SetItemCount(len(repo.myReviewRequests((username))))
+	widget.SetItemCount(0)
 
-	title := fmt.Sprintf("%s - %s", widget.CommonSettings().Title, widget.title(repo))
 	if repo == nil {
-		return title, " GitHub repo data is unavailable ", false
-	} else if repo.Err != nil {
+		return widget.CommonSettings().Title, " GitHub repo data is unavailable ", false
+	}
+
+	title := fmt.Sprintf("%s - %s", widget.CommonSettings().Title, widget.title(repo))
+	if repo.Err != nil {
 		return title, repo.Err.Error(), true
 	}
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.GithubRepos), widget.Idx, width)
+	if widget.err != nil {
+		str += fmt.Sprintf("\n [red]%s[white]\n", tview.Escape(widget.err.Error()))
+	}
 	if widget.settings.showStats {
 		str += fmt.Sprintf("\n [%s]Stats[white]\n", widget.settings.Colors.Subheading)
 		str += widget.displayStats(repo)
@@ -55,26 +60,7 @@ func (widget *Widget) content() (string, string, bool) {
 }
 
 func (widget *Widget) displayMyPullRequests(repo *Repo, username string) string {
-	prs := repo.myPullRequests(username, widget.settings.enableStatus)
-
-	prLength := len(prs)
-
-	if prLength == 0 {
-		return " [grey]none[white]\n"
-	}
-
-	maxItems := widget.GetItemCount()
-
-	str := ""
-	for idx, pr := range prs {
-		str += fmt.Sprintf(` %s[green]["%d"]%4d[""][white] %s`, widget.mergeString(pr), maxItems+idx, *pr.Number, *pr.Title)
-		str += "\n"
-		widget.Items = append(widget.Items, *pr.Number)
-	}
-
-	widget.SetItemCount(maxItems + prLength)
-
-	return str
+	return widget.displayPullRequests(repo, repo.myPullRequests(username))
 }
 
 func (widget *Widget) displayCustomQuery(repo *Repo, filter string, perPage int) string {
@@ -90,37 +76,76 @@ func (widget *Widget) displayCustomQuery(repo *Repo, filter string, perPage int)
 		return " [grey]none[white]\n"
 	}
 
-	maxItems := widget.GetItemCount()
-
 	str := ""
-	for idx, issue := range res.Issues {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, *issue.Number, *issue.Title)
+	for _, issue := range res.Issues {
+		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, len(widget.Items), *issue.Number, *issue.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *issue.Number)
 	}
 
-	widget.SetItemCount(maxItems + issuesLength)
+	widget.SetItemCount(len(widget.Items))
 
 	return str
 }
 
 func (widget *Widget) displayMyReviewRequests(repo *Repo, username string) string {
-	prs := repo.myReviewRequests(username)
+	return widget.displayPullRequests(repo, repo.myReviewRequests(username))
+}
 
+func (widget *Widget) displayPullRequests(repo *Repo, prs []*ghb.PullRequest) string {
 	if len(prs) == 0 {
 		return " [grey]none[white]\n"
 	}
 
 	str := ""
-	for idx, pr := range prs {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, idx, *pr.Number, *pr.Title)
-		str += "\n"
+	for _, pr := range prs {
+		str += widget.pullRequestRow(repo, pr)
 		widget.Items = append(widget.Items, *pr.Number)
 	}
 
+	widget.SetItemCount(len(widget.Items))
+
 	return str
 }
 
+// pullRequestRow formats a pull request with its mergeability, check results, draft
+// and conflict state, reviews and age
+func (widget *Widget) pullRequestRow(repo *Repo, pr *ghb.PullRequest) string {
+	details := repo.Details[pr.GetNumber()]
+
+	str := fmt.Sprintf(
+		` %s%s[green]["%d"]%4d[""][white] %s`,
+		widget.mergeString(pr),
+		widget.checksString(details),
+		len(widget.Items),
+		*pr.Number,
+		tview.Escape(pr.GetTitle()),
+	)
+
+	if pr.GetDraft() {
+		str += " [grey]draft[white]"
+	}
+
+	if pr.GetMergeableState() == "dirty" {
+		str += " [red]conflict[white]"
+	}
+
+	if details != nil {
+		if details.Approvals > 0 {
+			str += fmt.Sprintf(" [green]+%d[white]", details.Approvals)
+		}
+		if details.ChangesRequested > 0 {
+			str += fmt.Sprintf(" [red]-%d[white]", details.ChangesRequested)
+		}
+	}
+
+	if prAge := age(pr.GetCreatedAt()); prAge != "" {
+		str += fmt.Sprintf(" [grey]%s[white]", prAge)
+	}
+
+	return str + "\n"
+}
+
 func (widget *Widget) displayStats(repo *Repo) string {
 	locPrinter, err := widget.settings.LocalizedPrinter()
 	if err != nil {
@@ -162,3 +187,19 @@ func (widget *Widget) mergeString(pr *ghb.PullRequest) string {
 	}
 	return "? "
 }
+
+// checksString summarizes the check runs of a pull request as a single icon
+func (widget *Widget) checksString(details *PullRequestDetails) string {
+	if !widget.settings.enableChecks || details == nil || details.Checks.Total() == 0 {
+		return ""
+	}
+
+	switch {
+	case details.Checks.Failed > 0:
+		return "[red]\u2717[white] "
+	case details.Checks.Pending > 0:
+		return "[yellow]\u25cf[white] "
+	default:
+		return "[green]\u2713[white] "
+	}
+}
@@ -24,6 +24,7 @@ type Repo struct {
 	Name         string
 	Owner        string
 	PullRequests []*ghb.PullRequest
+	Details      map[int]*PullRequestDetails
 	RemoteRepo   *ghb.Repository
 	Err          error
 }
@@ -31,8 +32,9 @@ type Repo struct {
 // NewGithubRepo returns a new Github Repo with a name, owner, apiKey, baseURL and uploadURL
 func NewGithubRepo(name, owner, apiKey, baseURL, uploadURL string) *Repo {
 	repo := Repo{
-		Name:  name,
-		Owner: owner,
+		Name:    name,
+		Owner:   owner,
+		Details: map[int]*PullRequestDetails{},
 
 		apiKey:    apiKey,
 		baseURL:   baseURL,
@@ -62,6 +64,7 @@ func (repo *Repo) Refresh() {
 	prs, err := repo.loadPullRequests()
 	repo.Err = err
 	repo.PullRequests = prs
+	repo.Details = map[int]*PullRequestDetails{}
 	if err != nil {
 		return
 	}
@@ -70,6 +73,12 @@ func (repo *Repo) Refresh() {
 	repo.RemoteRepo = remote
 }
 
+// RefreshDetails reloads the mergeability of the given pull requests and, if withChecks
+// is set, their check runs and reviews
+func (repo *Repo) RefreshDetails(prs []*ghb.PullRequest, withChecks bool) {
+	repo.Details = repo.loadDetails(prs, withChecks)
+}
+
 /* -------------------- Counts -------------------- */
 
 // IssueCount return the total amount of issues as an int
@@ -128,46 +137,20 @@ func (repo *Repo) githubClient() (*ghb.Client, error) {
 }
 
 // myPullRequests returns a list of pull requests created by username on this repo
-func (repo *Repo) myPullRequests(username string, showStatus bool) []*ghb.PullRequest {
+func (repo *Repo) myPullRequests(username string) []*ghb.PullRequest {
 	prs := []*ghb.PullRequest{}
 
 	for _, pr := range repo.PullRequests {
 		user := *pr.User
 
 		if *user.Login == username {
-			prs = append(prs, pr)
+			prs = append(prs, repo.pullRequest(pr.GetNumber()))
 		}
 	}
 
-	if showStatus {
-		prs = repo.individualPRs(prs)
-	}
-
 	return prs
 }
 
-// individualPRs takes a list of pull requests (presumably returned from
-// github.PullRequests.List) and fetches them individually to get more detailed
-// status info on each. see: https://developer.github.com/v3/git/#checking-mergeability-of-pull-requests
-func (repo *Repo) individualPRs(prs []*ghb.PullRequest) []*ghb.PullRequest {
-	github, err := repo.githubClient()
-	if err != nil {
-		return prs
-	}
-
-	var ret []*ghb.PullRequest
-	for i := range prs {
-		pr, _, err := github.PullRequests.Get(context.Background(), repo.Owner, repo.Name, prs[i].GetNumber())
-		if err != nil {
-			// worst case, just keep the original one
-			ret = append(ret, prs[i])
-		} else {
-			ret = append(ret, pr)
-		}
-	}
-	return ret
-}
-
 // myReviewRequests returns a list of pull requests for which username has been
 // requested to do a code review
 func (repo *Repo) myReviewRequests(username string) []*ghb.PullRequest {
@@ -176,7 +159,7 @@ func (repo *Repo) myReviewRequests(username string) []*ghb.PullRequest {
 	for _, pr := range repo.PullRequests {
 		for _, reviewer := range pr.RequestedReviewers {
 			if *reviewer.Login == username {
-				prs = append(prs, pr)
+				prs = append(prs, repo.pullRequest(pr.GetNumber()))
 			}
 		}
 	}
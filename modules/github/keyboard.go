@@ -15,6 +15,10 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("o", widget.openRepo, "Open item in browser")
 	widget.SetKeyboardChar("p", widget.openPulls, "Open pull requests in browser")
 	widget.SetKeyboardChar("i", widget.openIssues, "Open issues in browser")
+	widget.SetKeyboardChar("a", widget.Approve, "Approve pull request")
+	widget.SetKeyboardChar("m", widget.Merge, "Merge pull request")
+	widget.SetKeyboardChar("R", widget.RerunFailedChecks, "Re-run failed checks")
+	widget.SetKeyboardChar("c", widget.Comment, "Comment on pull request or issue")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
@@ -0,0 +1,33 @@
+package github
+
+import (
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+// mergeMethods are the ways GitHub can merge a pull request
+var mergeMethods = []string{"merge", "squash", "rebase"}
+
+func (widget *Widget) addButtons(form *tview.Form, label string, fctn func()) {
+	form.AddButton(label, fctn)
+
+	cancelFn := func() {
+		widget.closeModal()
+		widget.display()
+	}
+
+	form.AddButton("Cancel", cancelFn)
+	form.SetCancelFunc(cancelFn)
+}
+
+func (widget *Widget) closeModal() {
+	widget.pages.RemovePage("modal")
+	widget.tviewApp.SetFocus(widget.View)
+}
+
+func (widget *Widget) modalFocus(form *tview.Form, title string) {
+	frame := view.NewModalFrame(form, view.ModalWidth, view.FormModalHeight+form.GetFormItemCount()*2)
+	frame.SetTitle(title)
+	widget.pages.AddPage("modal", frame, false, true)
+	widget.tviewApp.SetFocus(frame)
+}
@@ -0,0 +1,317 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	ghb "github.com/google/go-github/v32/github"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	reviewApproved         = "APPROVED"
+	reviewChangesRequested = "CHANGES_REQUESTED"
+	reviewDismissed        = "DISMISSED"
+
+	// detailFetchLimit is how many pull requests have their details loaded at once
+	detailFetchLimit = 4
+)
+
+// actionsRunRegex extracts the workflow run ID from the details URL of a check run
+// created by GitHub Actions
+var actionsRunRegex = regexp.MustCompile(`/actions/runs/(\d+)`)
+
+// CheckSummary counts the check runs of a pull request's head commit by outcome
+type CheckSummary struct {
+	Passed  int
+	Failed  int
+	Pending int
+}
+
+// Total returns the number of check runs
+func (summary CheckSummary) Total() int {
+	return summary.Passed + summary.Failed + summary.Pending
+}
+
+// PullRequestDetails holds the state of a pull request that GitHub doesn't include
+// when listing pull requests
+type PullRequestDetails struct {
+	PullRequest      *ghb.PullRequest
+	Checks           CheckSummary
+	FailedChecks     []*ghb.CheckRun
+	Approvals        int
+	ChangesRequested int
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// loadDetails fetches the details of each pull request. When withChecks is false only
+// the pull request itself is fetched, which is enough to know its mergeability
+func (repo *Repo) loadDetails(prs []*ghb.PullRequest, withChecks bool) map[int]*PullRequestDetails {
+	github, err := repo.githubClient()
+	if err != nil {
+		return map[int]*PullRequestDetails{}
+	}
+
+	results := make([]*PullRequestDetails, len(prs))
+
+	group := errgroup.Group{}
+	group.SetLimit(detailFetchLimit)
+
+	for i, pr := range prs {
+		group.Go(func() error {
+			results[i] = repo.loadPullRequestDetails(github, pr, withChecks)
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	details := make(map[int]*PullRequestDetails, len(results))
+	for _, result := range results {
+		details[result.PullRequest.GetNumber()] = result
+	}
+
+	return details
+}
+
+func (repo *Repo) loadPullRequestDetails(github *ghb.Client, pr *ghb.PullRequest, withChecks bool) *PullRequestDetails {
+	ctx := context.Background()
+
+	// If anything fails, worst case the listed pull request is used on its own
+	details := &PullRequestDetails{PullRequest: pr}
+
+	// Mergeability is only computed when a pull request is fetched individually.
+	// see: https://developer.github.com/v3/git/#checking-mergeability-of-pull-requests
+	if full, _, err := github.PullRequests.Get(ctx, repo.Owner, repo.Name, pr.GetNumber()); err == nil {
+		details.PullRequest = full
+	}
+
+	if !withChecks {
+		return details
+	}
+
+	opts := &ghb.ListCheckRunsOptions{ListOptions: ghb.ListOptions{PerPage: 100}}
+	if checkRuns, _, err := github.Checks.ListCheckRunsForRef(ctx, repo.Owner, repo.Name, details.PullRequest.GetHead().GetSHA(), opts); err == nil {
+		details.Checks, details.FailedChecks = summarizeCheckRuns(checkRuns.CheckRuns)
+	}
+
+	if reviews, _, err := github.PullRequests.ListReviews(ctx, repo.Owner, repo.Name, pr.GetNumber(), &ghb.ListOptions{PerPage: 100}); err == nil {
+		details.Approvals, details.ChangesRequested = summarizeReviews(reviews)
+	}
+
+	return details
+}
+
+// pullRequest returns the pull request with the given number, preferring the detailed
+// version if it has been loaded
+func (repo *Repo) pullRequest(number int) *ghb.PullRequest {
+	if details, ok := repo.Details[number]; ok {
+		return details.PullRequest
+	}
+
+	for _, pr := range repo.PullRequests {
+		if pr.GetNumber() == number {
+			return pr
+		}
+	}
+
+	return nil
+}
+
+// approve submits an approving review, with an optional comment
+func (repo *Repo) approve(number int, body string) error {
+	github, err := repo.githubClient()
+	if err != nil {
+		return err
+	}
+
+	review := &ghb.PullRequestReviewRequest{Event: ghb.String("APPROVE")}
+	if body != "" {
+		review.Body = ghb.String(body)
+	}
+
+	_, _, err = github.PullRequests.CreateReview(context.Background(), repo.Owner, repo.Name, number, review)
+	return err
+}
+
+// merge merges a pull request with the given method (merge, squash or rebase). The
+// merge is refused by GitHub if the head has moved on since it was displayed
+func (repo *Repo) merge(number int, method, commitTitle string) error {
+	github, err := repo.githubClient()
+	if err != nil {
+		return err
+	}
+
+	opts := &ghb.PullRequestOptions{
+		CommitTitle: commitTitle,
+		MergeMethod: method,
+	}
+	if pr := repo.pullRequest(number); pr != nil {
+		opts.SHA = pr.GetHead().GetSHA()
+	}
+
+	result, _, err := github.PullRequests.Merge(context.Background(), repo.Owner, repo.Name, number, "", opts)
+	if err != nil {
+		return err
+	}
+
+	if !result.GetMerged() {
+		return fmt.Errorf("#%d was not merged: %s", number, result.GetMessage())
+	}
+
+	return nil
+}
+
+// comment adds a comment to a pull request or an issue
+func (repo *Repo) comment(number int, body string) error {
+	github, err := repo.githubClient()
+	if err != nil {
+		return err
+	}
+
+	comment := &ghb.IssueComment{Body: ghb.String(body)}
+	_, _, err = github.Issues.CreateComment(context.Background(), repo.Owner, repo.Name, number, comment)
+	return err
+}
+
+// rerunFailedChecks re-runs the failed checks of a pull request. Only the failed jobs
+// of GitHub Actions workflows are re-run; other apps are asked to re-run the whole
+// check suite
+func (repo *Repo) rerunFailedChecks(number int) error {
+	details, ok := repo.Details[number]
+	if !ok || len(details.FailedChecks) == 0 {
+		return fmt.Errorf("#%d has no failed checks", number)
+	}
+
+	github, err := repo.githubClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	runIDs := map[int64]bool{}
+	suiteIDs := map[int64]bool{}
+
+	for _, check := range details.FailedChecks {
+		if runID := actionsRunID(check); runID != 0 {
+			runIDs[runID] = true
+		} else if check.GetCheckSuite().GetID() != 0 {
+			suiteIDs[check.GetCheckSuite().GetID()] = true
+		}
+	}
+
+	for runID := range runIDs {
+		url := fmt.Sprintf("repos/%s/%s/actions/runs/%d/rerun-failed-jobs", repo.Owner, repo.Name, runID)
+
+		req, err := github.NewRequest("POST", url, nil)
+		if err != nil {
+			return err
+		}
+
+		if _, err := github.Do(ctx, req, nil); err != nil {
+			return err
+		}
+	}
+
+	for suiteID := range suiteIDs {
+		if _, err := github.Checks.ReRequestCheckSuite(ctx, repo.Owner, repo.Name, suiteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// actionsRunID returns the ID of the workflow run a check run belongs to, or 0 if it
+// wasn't created by GitHub Actions
+func actionsRunID(check *ghb.CheckRun) int64 {
+	if check.GetApp().GetSlug() != "github-actions" {
+		return 0
+	}
+
+	match := actionsRunRegex.FindStringSubmatch(check.GetDetailsURL())
+	if match == nil {
+		return 0
+	}
+
+	runID, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return runID
+}
+
+// summarizeCheckRuns counts check runs by outcome and returns the ones that failed
+func summarizeCheckRuns(checkRuns []*ghb.CheckRun) (CheckSummary, []*ghb.CheckRun) {
+	summary := CheckSummary{}
+	failed := []*ghb.CheckRun{}
+
+	for _, check := range checkRuns {
+		if check.GetStatus() != "completed" {
+			summary.Pending++
+			continue
+		}
+
+		switch check.GetConclusion() {
+		case "success", "neutral", "skipped":
+			summary.Passed++
+		default:
+			summary.Failed++
+			failed = append(failed, check)
+		}
+	}
+
+	return summary, failed
+}
+
+// summarizeReviews counts the reviewers whose latest review approves the pull request
+// and those whose latest review requests changes. Comments don't change a reviewer's
+// state, and dismissed reviews no longer count
+func summarizeReviews(reviews []*ghb.PullRequestReview) (int, int) {
+	states := map[string]string{}
+
+	for _, review := range reviews {
+		login := review.GetUser().GetLogin()
+
+		switch review.GetState() {
+		case reviewApproved, reviewChangesRequested:
+			states[login] = review.GetState()
+		case reviewDismissed:
+			delete(states, login)
+		}
+	}
+
+	approvals, changesRequested := 0, 0
+	for _, state := range states {
+		if state == reviewApproved {
+			approvals++
+		} else {
+			changesRequested++
+		}
+	}
+
+	return approvals, changesRequested
+}
+
+// age formats the time since a pull request was opened as a short duration
+func age(since time.Time) string {
+	if since.IsZero() {
+		return ""
+	}
+
+	duration := time.Since(since)
+
+	switch {
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm", int(duration.Minutes()))
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(duration.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(duration.Hours()/24))
+	}
+}
@@ -0,0 +1,86 @@
+package github
+
+import (
+	"testing"
+
+	ghb "github.com/google/go-github/v32/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func checkRun(status, conclusion, app, detailsURL string) *ghb.CheckRun {
+	return &ghb.CheckRun{
+		Status:     ghb.String(status),
+		Conclusion: ghb.String(conclusion),
+		App:        &ghb.App{Slug: ghb.String(app)},
+		DetailsURL: ghb.String(detailsURL),
+	}
+}
+
+func review(login, state string) *ghb.PullRequestReview {
+	return &ghb.PullRequestReview{
+		User:  &ghb.User{Login: ghb.String(login)},
+		State: ghb.String(state),
+	}
+}
+
+func Test_summarizeCheckRuns(t *testing.T) {
+	failure := checkRun("completed", "failure", "github-actions", "")
+	timedOut := checkRun("completed", "timed_out", "circleci", "")
+
+	summary, failed := summarizeCheckRuns([]*ghb.CheckRun{
+		checkRun("completed", "success", "github-actions", ""),
+		checkRun("completed", "skipped", "github-actions", ""),
+		failure,
+		timedOut,
+		checkRun("in_progress", "", "github-actions", ""),
+	})
+
+	assert.Equal(t, CheckSummary{Passed: 2, Failed: 2, Pending: 1}, summary)
+	assert.Equal(t, 5, summary.Total())
+	assert.Equal(t, []*ghb.CheckRun{failure, timedOut}, failed)
+}
+
+func Test_summarizeReviews(t *testing.T) {
+	approvals, changesRequested := summarizeReviews([]*ghb.PullRequestReview{
+		review("alice", reviewChangesRequested),
+		review("alice", "COMMENTED"),
+		review("bob", reviewApproved),
+		review("carol", reviewApproved),
+		review("carol", reviewDismissed),
+		review("dave", reviewChangesRequested),
+		review("alice", reviewApproved),
+	})
+
+	assert.Equal(t, 2, approvals)
+	assert.Equal(t, 1, changesRequested)
+}
+
+func Test_actionsRunID(t *testing.T) {
+	tests := []struct {
+		name     string
+		check    *ghb.CheckRun
+		expected int64
+	}{
+		{
+			name:     "with actions run",
+			check:    checkRun("completed", "failure", "github-actions", "https://github.com/wtfutil/wtf/actions/runs/12345/job/678"),
+			expected: 12345,
+		},
+		{
+			name:     "with other app",
+			check:    checkRun("completed", "failure", "circleci", "https://github.com/wtfutil/wtf/actions/runs/12345/job/678"),
+			expected: 0,
+		},
+		{
+			name:     "without run in url",
+			check:    checkRun("completed", "failure", "github-actions", "https://github.com/wtfutil/wtf/runs/678"),
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, actionsRunID(tt.check))
+		})
+	}
+}
@@ -19,7 +19,9 @@ type Settings struct {
 	apiKey                 string        `help:"Your GitHub API token."`
 	baseURL                string        `help:"Your GitHub Enterprise API URL." optional:"true"`
 	customQueries          []customQuery `help:"Custom queries allow you to filter pull requests and issues however you like. Give the query a title and a filter. Filters can be copied directly from GitHub’s UI." optional:"true"`
+	enableChecks           bool          `help:"Display check run results, review state, draft and conflict state for pull requests." optional:"true"`
 	enableStatus           bool          `help:"Display pull request mergeability status (‘dirty’, ‘clean’, ‘unstable’, ‘blocked’)." optional:"true"`
+	mergeMethod            string        `help:"The merge method selected by default when merging a pull request." values:"merge, squash or rebase" optional:"true"`
 	repositories           []string      `help:"A list of github repositories." values:"Example: wtfutil/wtf"`
 	showMyPullRequests     bool          `help:"Show my pull requests section" optional:"true"`
 	showOpenReviewRequests bool          `help:"Show open review requests section" optional:"true"`
@@ -41,7 +43,9 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 		apiKey:                 ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_GITHUB_TOKEN"))),
 		baseURL:                ymlConfig.UString("baseURL", os.Getenv("WTF_GITHUB_BASE_URL")),
+		enableChecks:           ymlConfig.UBool("enableChecks", false),
 		enableStatus:           ymlConfig.UBool("enableStatus", false),
+		mergeMethod:            ymlConfig.UString("mergeMethod", "merge"),
 		showMyPullRequests:     ymlConfig.UBool("showMyPullRequests", true),
 		showOpenReviewRequests: ymlConfig.UBool("showOpenReviewRequests", true),
 		showStats:              ymlConfig.UBool("showStats", true),
@@ -1,9 +1,11 @@
 package github
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
+	ghb "github.com/google/go-github/v32/github"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
@@ -16,7 +18,10 @@ type Widget struct {
 
 	GithubRepos []*Repo
 
+	err      error
+	pages    *tview.Pages
 	settings *Settings
+	tviewApp *tview.Application
 	Selected int
 	maxItems int
 	Items    []int
@@ -28,7 +33,9 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.Common, "repository", "repositories"),
 		TextWidget:        view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		pages:    pages,
 		settings: settings,
+		tviewApp: tviewApp,
 	}
 
 	widget.GithubRepos = widget.buildRepoCollection(widget.settings.repositories)
@@ -94,13 +101,117 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the github data via the Github API and reruns the display
 func (widget *Widget) Refresh() {
+	widget.err = nil
+
 	for _, repo := range widget.GithubRepos {
 		repo.Refresh()
+
+		if widget.settings.enableStatus || widget.settings.enableChecks {
+			repo.RefreshDetails(widget.detailedPullRequests(repo), widget.settings.enableChecks)
+		}
 	}
 
 	widget.display()
 }
 
+// Approve prompts for an optional comment and approves the selected pull request
+func (widget *Widget) Approve() {
+	repo, number := widget.selectedPullRequest()
+	if repo == nil {
+		return
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddInputField("Comment:", "", 60, nil, nil)
+
+	approveFctn := func() {
+		body := form.GetFormItem(0).(*tview.InputField).GetText()
+		widget.closeModal()
+
+		widget.performAction(func() error {
+			return repo.approve(number, strings.TrimSpace(body))
+		})
+	}
+
+	widget.addButtons(form, "Approve", approveFctn)
+	widget.modalFocus(form, "Approve #"+strconv.Itoa(number))
+}
+
+// Merge prompts for a merge method and commit title and merges the selected pull request
+func (widget *Widget) Merge() {
+	repo, number := widget.selectedPullRequest()
+	if repo == nil {
+		return
+	}
+
+	pr := repo.pullRequest(number)
+
+	methodIdx := 0
+	for idx, method := range mergeMethods {
+		if method == widget.settings.mergeMethod {
+			methodIdx = idx
+		}
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddDropDown("Method:", mergeMethods, methodIdx, nil)
+	form.AddInputField("Commit title:", pr.GetTitle(), 60, nil, nil)
+
+	mergeFctn := func() {
+		_, method := form.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+		title := form.GetFormItem(1).(*tview.InputField).GetText()
+		widget.closeModal()
+
+		widget.performAction(func() error {
+			return repo.merge(number, method, strings.TrimSpace(title))
+		})
+	}
+
+	widget.addButtons(form, "Merge", mergeFctn)
+	widget.modalFocus(form, "Merge #"+strconv.Itoa(number))
+}
+
+// Comment prompts for a comment and adds it to the selected pull request or issue
+func (widget *Widget) Comment() {
+	repo := widget.currentGithubRepo()
+	number, ok := widget.selectedNumber()
+	if repo == nil || !ok {
+		return
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddInputField("Comment:", "", 60, nil, nil)
+
+	commentFctn := func() {
+		body := form.GetFormItem(0).(*tview.InputField).GetText()
+		widget.closeModal()
+
+		if strings.TrimSpace(body) == "" {
+			widget.display()
+			return
+		}
+
+		widget.performAction(func() error {
+			return repo.comment(number, body)
+		})
+	}
+
+	widget.addButtons(form, "Comment", commentFctn)
+	widget.modalFocus(form, "Comment on #"+strconv.Itoa(number))
+}
+
+// RerunFailedChecks re-runs the failed checks of the selected pull request
+func (widget *Widget) RerunFailedChecks() {
+	repo, number := widget.selectedPullRequest()
+	if repo == nil {
+		return
+	}
+
+	widget.performAction(func() error {
+		return repo.rerunFailedChecks(number)
+	})
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) buildRepoCollection(repoData []string) []*Repo {
@@ -135,6 +246,61 @@ func (widget *Widget) currentGithubRepo() *Repo {
 	return widget.GithubRepos[widget.Idx]
 }
 
+// detailedPullRequests returns the pull requests whose details are displayed, which are
+// the user's own pull requests and the ones they've been asked to review
+func (widget *Widget) detailedPullRequests(repo *Repo) []*ghb.PullRequest {
+	prs := []*ghb.PullRequest{}
+	seen := map[int]bool{}
+
+	for _, pr := range append(repo.myPullRequests(widget.settings.username), repo.myReviewRequests(widget.settings.username)...) {
+		if !seen[pr.GetNumber()] {
+			seen[pr.GetNumber()] = true
+			prs = append(prs, pr)
+		}
+	}
+
+	return prs
+}
+
+// performAction runs an action against GitHub and refreshes the widget. If the action
+// fails, its error is displayed above the repository data
+func (widget *Widget) performAction(action func() error) {
+	err := action()
+	widget.Refresh()
+
+	if err != nil {
+		widget.err = err
+		widget.display()
+	}
+}
+
+// selectedNumber returns the number of the currently-selected pull request or issue
+func (widget *Widget) selectedNumber() (int, bool) {
+	if widget.Selected < 0 || widget.Selected >= len(widget.Items) {
+		return 0, false
+	}
+
+	return widget.Items[widget.Selected], true
+}
+
+// selectedPullRequest returns the repository and number of the currently-selected item
+// if it is a pull request. If an issue is selected, an error is displayed instead
+func (widget *Widget) selectedPullRequest() (*Repo, int) {
+	repo := widget.currentGithubRepo()
+	number, ok := widget.selectedNumber()
+	if repo == nil || !ok {
+		return nil, 0
+	}
+
+	if repo.pullRequest(number) == nil {
+		widget.err = errors.New("#" + strconv.Itoa(number) + " is not an open pull request")
+		widget.display()
+		return nil, 0
+	}
+
+	return repo, number
+}
+
 func (widget *Widget) openPr() {
 	currentSelection := widget.View.GetHighlights()
 	if widget.Selected >= 0 && len(widget.Items) > 0 && currentSelection[0] != "" {
@@ -0,0 +1,109 @@
+package githubactions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghb "github.com/google/go-github/v32/github"
+	"golang.org/x/oauth2"
+)
+
+// Client talks to the GitHub Actions API of github.com or a GitHub Enterprise server
+type Client struct {
+	github *ghb.Client
+}
+
+// NewClient creates a client authenticated with apiKey. If baseURL is set, the client
+// talks to that GitHub Enterprise server instead of github.com
+func NewClient(apiKey, baseURL, uploadURL string) (*Client, error) {
+	httpClient := http.DefaultClient
+	if apiKey != "" {
+		tokenService := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiKey})
+		httpClient = oauth2.NewClient(context.Background(), tokenService)
+	}
+
+	if baseURL == "" {
+		return &Client{github: ghb.NewClient(httpClient)}, nil
+	}
+
+	if uploadURL == "" {
+		uploadURL = baseURL
+	}
+
+	github, err := ghb.NewEnterpriseClient(baseURL, uploadURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{github: github}, nil
+}
+
+// WorkflowRuns returns the most recent workflow runs of a repository, optionally only
+// those for the given branch
+func (client *Client) WorkflowRuns(repository, branch string, count int) ([]*WorkflowRun, error) {
+	query := url.Values{}
+	query.Set("per_page", fmt.Sprint(count))
+	if branch != "" {
+		query.Set("branch", branch)
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs?%s", repository, query.Encode())
+
+	req, err := client.github.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := &WorkflowRuns{}
+	if _, err := client.github.Do(context.Background(), req, runs); err != nil {
+		return nil, err
+	}
+
+	for _, run := range runs.WorkflowRuns {
+		run.Repository = repository
+	}
+
+	return runs.WorkflowRuns, nil
+}
+
+// Rerun re-runs every job of a workflow run
+func (client *Client) Rerun(run *WorkflowRun) error {
+	owner, name, err := splitRepository(run.Repository)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.github.Actions.RerunWorkflowByID(context.Background(), owner, name, run.ID)
+	return err
+}
+
+// Cancel cancels a queued or in-progress workflow run
+func (client *Client) Cancel(run *WorkflowRun) error {
+	owner, name, err := splitRepository(run.Repository)
+	if err != nil {
+		return err
+	}
+
+	// GitHub replies 202 Accepted as the cancellation happens asynchronously, which
+	// go-github reports as an error
+	_, err = client.github.Actions.CancelWorkflowRunByID(context.Background(), owner, name, run.ID)
+	if _, ok := err.(*ghb.AcceptedError); ok {
+		return nil
+	}
+
+	return err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func splitRepository(repository string) (string, string, error) {
+	owner, name, ok := strings.Cut(repository, "/")
+	if ok && owner != "" && name != "" {
+		return owner, name, nil
+	}
+
+	return "", "", fmt.Errorf("invalid repository %q, expected owner/name", repository)
+}
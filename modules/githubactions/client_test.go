@@ -0,0 +1,128 @@
+package githubactions
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const runsResponse = `{
+  "total_count": 2,
+  "workflow_runs": [
+    {
+      "id": 30433642,
+      "name": "Build",
+      "run_number": 562,
+      "event": "push",
+      "head_branch": "main",
+      "status": "completed",
+      "conclusion": "failure",
+      "html_url": "https://github.example.com/wtfutil/wtf/actions/runs/30433642",
+      "actor": {"login": "octocat"},
+      "created_at": "2024-03-05T14:30:00Z",
+      "updated_at": "2024-03-05T14:34:10Z",
+      "run_started_at": "2024-03-05T14:30:05Z"
+    },
+    {
+      "id": 30433643,
+      "name": "Lint",
+      "run_number": 87,
+      "event": "pull_request",
+      "head_branch": "main",
+      "status": "in_progress",
+      "conclusion": null,
+      "actor": {"login": "hubot"},
+      "created_at": "2024-03-05T14:31:00Z",
+      "updated_at": "2024-03-05T14:31:00Z",
+      "run_started_at": "2024-03-05T14:31:00Z"
+    }
+  ]
+}`
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("token", server.URL+"/", "")
+	require.NoError(t, err)
+
+	return client
+}
+
+func Test_WorkflowRuns(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/wtfutil/wtf/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "main", r.URL.Query().Get("branch"))
+		assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+
+		_, _ = w.Write([]byte(runsResponse))
+	})
+
+	runs, err := newTestClient(t, mux).WorkflowRuns("wtfutil/wtf", "main", 5)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, int64(30433642), runs[0].ID)
+	assert.Equal(t, "Build", runs[0].Name)
+	assert.Equal(t, "octocat", runs[0].Actor.Login)
+	assert.Equal(t, "failure", runs[0].State())
+	assert.Equal(t, "wtfutil/wtf", runs[0].Repository)
+	assert.Equal(t, 4*time.Minute+5*time.Second, runs[0].Duration(time.Now()))
+
+	assert.Equal(t, "in_progress", runs[1].State())
+	assert.False(t, runs[1].IsCompleted())
+}
+
+func Test_WorkflowRuns_error(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/wtfutil/wtf/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	_, err := newTestClient(t, mux).WorkflowRuns("wtfutil/wtf", "", 5)
+	assert.Error(t, err)
+}
+
+func Test_RerunAndCancel(t *testing.T) {
+	calls := []string{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/wtfutil/wtf/actions/runs/42/rerun", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" rerun")
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/api/v3/repos/wtfutil/wtf/actions/runs/42/cancel", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" cancel")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	client := newTestClient(t, mux)
+	run := &WorkflowRun{ID: 42, Repository: "wtfutil/wtf"}
+
+	require.NoError(t, client.Rerun(run))
+	require.NoError(t, client.Cancel(run))
+	assert.Equal(t, []string{"POST rerun", "POST cancel"}, calls)
+
+	assert.Error(t, client.Rerun(&WorkflowRun{ID: 42, Repository: "wtf"}))
+}
+
+func Test_Duration(t *testing.T) {
+	started := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+	now := started.Add(90 * time.Second)
+
+	running := &WorkflowRun{Status: "in_progress", RunStartedAt: started}
+	assert.Equal(t, 90*time.Second, running.Duration(now))
+
+	queued := &WorkflowRun{Status: "queued"}
+	assert.Equal(t, time.Duration(0), queued.Duration(now))
+
+	assert.Equal(t, "1m30s", formatDuration(90*time.Second))
+	assert.Equal(t, "2h05m", formatDuration(2*time.Hour+5*time.Minute))
+}
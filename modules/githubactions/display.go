@@ -0,0 +1,96 @@
+package githubactions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+)
+
+var stateIcons = map[string]string{
+	"success":         "[green]✓",
+	"failure":         "[red]✗",
+	"timed_out":       "[red]✗",
+	"startup_failure": "[red]✗",
+	"cancelled":       "[grey]⊘",
+	"skipped":         "[grey]-",
+	"neutral":         "[grey]-",
+	"action_required": "[yellow]!",
+	"in_progress":     "[yellow]●",
+	"queued":          "[grey]○",
+	"waiting":         "[grey]○",
+	"requested":       "[grey]○",
+	"pending":         "[grey]○",
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		return title, widget.err.Error(), true
+	}
+
+	if len(widget.runs) == 0 {
+		return title, " [grey]no workflow runs[white]", false
+	}
+
+	now := time.Now()
+	showRepository := len(widget.settings.repositories) > 1
+
+	str := ""
+	for idx, run := range widget.runs {
+		str += widget.runRow(idx, run, showRepository, now)
+	}
+
+	return title, str, false
+}
+
+// runRow formats a workflow run with its state, workflow, branch, triggering actor,
+// duration and age
+func (widget *Widget) runRow(idx int, run *WorkflowRun, showRepository bool, now time.Time) string {
+	icon, ok := stateIcons[run.State()]
+	if !ok {
+		icon = "[grey]?"
+	}
+
+	name := run.Name
+	if showRepository {
+		name = run.Repository + " " + name
+	}
+
+	row := fmt.Sprintf(
+		" %s [%s]%s #%d [blue]%s [grey]%s %s %s ago",
+		icon,
+		widget.RowColor(idx),
+		tview.Escape(name),
+		run.RunNumber,
+		tview.Escape(run.HeadBranch),
+		tview.Escape(run.Actor.Login),
+		formatDuration(run.Duration(now)),
+		formatDuration(now.Sub(run.CreatedAt)),
+	)
+
+	return utils.HighlightableHelper(widget.View, row, idx, tview.TaggedStringWidth(row))
+}
+
+// formatDuration formats a duration with its two most significant units, e.g. 3m12s
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration < time.Minute:
+		return fmt.Sprintf("%ds", int(duration.Seconds()))
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(duration.Minutes()), int(duration.Seconds())%60)
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(duration.Hours()), int(duration.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%02dh", int(duration.Hours()/24), int(duration.Hours())%24)
+	}
+}
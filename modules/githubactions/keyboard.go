@@ -0,0 +1,21 @@
+package githubactions
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next workflow run")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous workflow run")
+	widget.SetKeyboardChar("o", widget.OpenLogs, "Open workflow run logs in browser")
+	widget.SetKeyboardChar("R", widget.Rerun, "Re-run workflow run")
+	widget.SetKeyboardChar("x", widget.Cancel, "Cancel workflow run")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next workflow run")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous workflow run")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.OpenLogs, "Open workflow run logs in browser")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
@@ -0,0 +1,45 @@
+package githubactions
+
+import (
+	"os"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "GitHub Actions"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	*cfg.Common
+
+	apiKey       string   `help:"Your GitHub API token."`
+	baseURL      string   `help:"Your GitHub Enterprise API URL." optional:"true"`
+	branches     []string `help:"Only show workflow runs for these branches." optional:"true"`
+	repositories []string `help:"A list of github repositories." values:"Example: wtfutil/wtf"`
+	runCount     int      `help:"The number of workflow runs to show per repository and branch." optional:"true"`
+	uploadURL    string   `help:"Your GitHub Enterprise upload URL (often the same as API URL)." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		apiKey:    ymlConfig.UString("apiKey", os.Getenv("WTF_GITHUB_TOKEN")),
+		baseURL:   ymlConfig.UString("baseURL", os.Getenv("WTF_GITHUB_BASE_URL")),
+		branches:  utils.ToStrs(ymlConfig.UList("branches")),
+		runCount:  ymlConfig.UInt("runCount", 10),
+		uploadURL: ymlConfig.UString("uploadURL", os.Getenv("WTF_GITHUB_UPLOAD_URL")),
+	}
+	settings.repositories = cfg.ParseAsMapOrList(ymlConfig, "repositories")
+
+	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).
+		Service(settings.baseURL).Load()
+
+	return &settings
+}
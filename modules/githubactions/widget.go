@@ -0,0 +1,177 @@
+package githubactions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/sync/errgroup"
+)
+
+// fetchLimit is how many repositories and branches are fetched at once
+const fetchLimit = 4
+
+// Widget lists the recent workflow runs of a set of repositories
+type Widget struct {
+	view.ScrollableWidget
+
+	client   *Client
+	err      error
+	mutex    sync.Mutex
+	runs     []*WorkflowRun
+	settings *Settings
+}
+
+// NewWidget creates a new instance of the widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		settings: settings,
+	}
+
+	client, err := NewClient(settings.apiKey, settings.baseURL, settings.uploadURL)
+	if err != nil {
+		widget.err = err
+	} else {
+		widget.client = client
+	}
+
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh reloads the workflow runs of every repository and branch
+func (widget *Widget) Refresh() {
+	if widget.client == nil {
+		widget.display()
+		return
+	}
+
+	runs, err := widget.fetchRuns()
+
+	widget.mutex.Lock()
+	widget.runs = runs
+	widget.err = err
+	widget.SetItemCount(len(runs))
+	widget.mutex.Unlock()
+
+	widget.display()
+}
+
+// Rerun re-runs the selected workflow run
+func (widget *Widget) Rerun() {
+	run := widget.selectedRun()
+	if run == nil {
+		return
+	}
+
+	widget.performAction(func() error {
+		return widget.client.Rerun(run)
+	})
+}
+
+// Cancel cancels the selected workflow run if it hasn't finished yet
+func (widget *Widget) Cancel() {
+	run := widget.selectedRun()
+	if run == nil || run.IsCompleted() {
+		return
+	}
+
+	widget.performAction(func() error {
+		return widget.client.Cancel(run)
+	})
+}
+
+// OpenLogs opens the selected workflow run, with its jobs and logs, in the browser
+func (widget *Widget) OpenLogs() {
+	run := widget.selectedRun()
+	if run == nil || run.HTMLURL == "" {
+		return
+	}
+
+	utils.OpenFile(run.HTMLURL)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetchRuns loads the runs for every configured repository and branch, newest first
+func (widget *Widget) fetchRuns() ([]*WorkflowRun, error) {
+	branches := widget.settings.branches
+	if len(branches) == 0 {
+		branches = []string{""}
+	}
+
+	type query struct {
+		repository string
+		branch     string
+	}
+
+	queries := []query{}
+	for _, repository := range widget.settings.repositories {
+		for _, branch := range branches {
+			queries = append(queries, query{repository, branch})
+		}
+	}
+
+	results := make([][]*WorkflowRun, len(queries))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, q := range queries {
+		group.Go(func() error {
+			runs, err := widget.client.WorkflowRuns(q.repository, q.branch, widget.settings.runCount)
+			results[i] = runs
+			return err
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	runs := []*WorkflowRun{}
+	for _, result := range results {
+		runs = append(runs, result...)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+
+	return runs, nil
+}
+
+// performAction runs an action against the GitHub API and refreshes the widget. If the
+// action fails, its error is displayed instead of the workflow runs
+func (widget *Widget) performAction(action func() error) {
+	if err := action(); err != nil {
+		widget.mutex.Lock()
+		widget.err = err
+		widget.mutex.Unlock()
+
+		widget.display()
+		return
+	}
+
+	widget.Refresh()
+}
+
+func (widget *Widget) selectedRun() *WorkflowRun {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if widget.client == nil || widget.Selected < 0 || widget.Selected >= len(widget.runs) {
+		return nil
+	}
+
+	return widget.runs[widget.Selected]
+}
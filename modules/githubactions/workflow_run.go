@@ -0,0 +1,79 @@
+package githubactions
+
+import (
+	"time"
+)
+
+const (
+	statusCompleted = "completed"
+)
+
+// Actor is the user who triggered a workflow run
+type Actor struct {
+	Login string `json:"login"`
+}
+
+// WorkflowRun is a single run of a GitHub Actions workflow. The fields are decoded
+// directly from the REST API, as the go-github release in use predates several of them
+type WorkflowRun struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	RunNumber    int       `json:"run_number"`
+	Event        string    `json:"event"`
+	HeadBranch   string    `json:"head_branch"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HTMLURL      string    `json:"html_url"`
+	Actor        Actor     `json:"actor"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	RunStartedAt time.Time `json:"run_started_at"`
+
+	// Repository is the owner/name of the repository the run belongs to
+	Repository string `json:"-"`
+}
+
+// WorkflowRuns is the response to listing the workflow runs of a repository
+type WorkflowRuns struct {
+	TotalCount   int            `json:"total_count"`
+	WorkflowRuns []*WorkflowRun `json:"workflow_runs"`
+}
+
+// IsCompleted returns true if the run has finished, whatever its outcome
+func (run *WorkflowRun) IsCompleted() bool {
+	return run.Status == statusCompleted
+}
+
+// State returns the conclusion of a completed run, or its status if it is still
+// queued or running
+func (run *WorkflowRun) State() string {
+	if run.IsCompleted() && run.Conclusion != "" {
+		return run.Conclusion
+	}
+
+	return run.Status
+}
+
+// Duration returns how long the run took, or how long it has been running so far
+func (run *WorkflowRun) Duration(now time.Time) time.Duration {
+	started := run.RunStartedAt
+	if started.IsZero() {
+		started = run.CreatedAt
+	}
+
+	if started.IsZero() {
+		return 0
+	}
+
+	finished := now
+	if run.IsCompleted() {
+		finished = run.UpdatedAt
+	}
+
+	if finished.Before(started) {
+		return 0
+	}
+
+	return finished.Sub(started).Truncate(time.Second)
+}
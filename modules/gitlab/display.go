@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 
+	"github.com/rivo/tview"
 	"github.com/xanzy/go-gitlab"
 )
 
@@ -40,15 +41,26 @@ func (widget *Widget) content() (string, string, bool) {
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.PaginationMarker(len(widget.GitlabProjects), widget.Idx, width) + "\n"
+	if widget.err != nil {
+		str += fmt.Sprintf(" [red]%s[white]\n\n", tview.Escape(widget.err.Error()))
+	}
 	str += fmt.Sprintf(" [%s]Stats[white]\n", widget.settings.Colors.Subheading)
 	str += widget.displayStats(project)
 	str += "\n"
+	str += fmt.Sprintf(" [%s]Pipelines[white]\n", widget.settings.Colors.Subheading)
+	str += widget.displayDefaultBranchPipeline(project)
+	str += "\n"
 	str += fmt.Sprintf(" [%s]Open Assigned Merge Requests[white]\n", widget.settings.Colors.Subheading)
 	str += widget.displayMyAssignedMergeRequests(project, widget.settings.username)
 	str += "\n"
 	str += fmt.Sprintf(" [%s]My Merge Requests[white]\n", widget.settings.Colors.Subheading)
 	str += widget.displayMyMergeRequests(project, widget.settings.username)
 	str += "\n"
+	if len(project.FailedJobs) > 0 {
+		str += fmt.Sprintf(" [%s]Failed Jobs[white]\n", widget.settings.Colors.Subheading)
+		str += widget.renderFailedJobs(project.FailedJobs)
+		str += "\n"
+	}
 	str += fmt.Sprintf(" [%s]Open Assigned Issues[white]\n", widget.settings.Colors.Subheading)
 	str += widget.displayMyAssignedIssues(project, widget.settings.username)
 	str += "\n"
@@ -60,12 +72,12 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) displayMyMergeRequests(project *GitlabProject, username string) string {
 	mrs := project.myMergeRequests()
-	return widget.renderMergeRequests(mrs)
+	return widget.renderMergeRequests(project, mrs)
 }
 
 func (widget *Widget) displayMyAssignedMergeRequests(project *GitlabProject, username string) string {
 	mrs := project.myAssignedMergeRequests()
-	return widget.renderMergeRequests(mrs)
+	return widget.renderMergeRequests(project, mrs)
 }
 
 func (widget *Widget) displayMyAssignedIssues(project *GitlabProject, username string) string {
@@ -78,7 +90,7 @@ func (widget *Widget) displayMyIssues(project *GitlabProject, username string) s
 	return widget.renderIssues(issues)
 }
 
-func (widget *Widget) renderMergeRequests(mrs []*gitlab.MergeRequest) string {
+func (widget *Widget) renderMergeRequests(project *GitlabProject, mrs []*gitlab.MergeRequest) string {
 
 	length := len(mrs)
 
@@ -89,7 +101,19 @@ func (widget *Widget) renderMergeRequests(mrs []*gitlab.MergeRequest) string {
 
 	str := ""
 	for idx, issue := range mrs {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, issue.IID, issue.Title)
+		icon := pipelineIcon(project.MergeRequestPipelines[issue.IID])
+		if icon != "" {
+			icon += " "
+		}
+
+		str += fmt.Sprintf(
+			` %s[green]["%d"]%4d[""][white] %s%s`,
+			icon,
+			maxItems+idx,
+			issue.IID,
+			issue.Title,
+			approvalString(project.Approvals[issue.IID]),
+		)
 		str += "\n"
 		widget.Items = append(widget.Items, ContentItem{Type: "MR", ID: issue.IID})
 	}
@@ -118,6 +142,60 @@ func (widget *Widget) renderIssues(issues []*gitlab.Issue) string {
 	return str
 }
 
+func (widget *Widget) renderFailedJobs(jobs []*gitlab.Job) string {
+	maxItems := widget.GetItemCount()
+
+	str := ""
+	for idx, job := range jobs {
+		str += fmt.Sprintf(
+			` [red]✗[white] [green]["%d"]%s[""][white] [grey]%s %s[white]`,
+			maxItems+idx,
+			tview.Escape(job.Name),
+			tview.Escape(job.Stage),
+			tview.Escape(job.Ref),
+		)
+		str += "\n"
+		widget.Items = append(widget.Items, ContentItem{Type: "JOB", ID: job.ID})
+	}
+	widget.SetItemCount(maxItems + len(jobs))
+
+	return str
+}
+
+func (widget *Widget) displayDefaultBranchPipeline(project *GitlabProject) string {
+	pipeline := project.DefaultBranchPipeline
+	if pipeline == nil {
+		return " [grey]none[white]\n"
+	}
+
+	return fmt.Sprintf(" %s %s [grey]#%d %s[white]\n", pipelineIcon(pipeline), pipeline.Ref, pipeline.ID, pipeline.Status)
+}
+
+// approvalString summarizes the approvals of a merge request
+func approvalString(approvals *gitlab.MergeRequestApprovals) string {
+	if approvals == nil {
+		return ""
+	}
+
+	if approvals.Approved && approvals.ApprovalsLeft == 0 {
+		return " [green]approved[white]"
+	}
+
+	if approvals.ApprovalsRequired > 0 {
+		return fmt.Sprintf(
+			" [yellow]%d/%d approvals[white]",
+			approvals.ApprovalsRequired-approvals.ApprovalsLeft,
+			approvals.ApprovalsRequired,
+		)
+	}
+
+	if len(approvals.ApprovedBy) > 0 {
+		return fmt.Sprintf(" [green]approved by %d[white]", len(approvals.ApprovedBy))
+	}
+
+	return ""
+}
+
 func (widget *Widget) displayStats(project *GitlabProject) string {
 	str := fmt.Sprintf(
 		" MRs: %d  Issues: %d  Stars: %d\n",
@@ -37,6 +37,11 @@ type GitlabProject struct {
 	AssignedIssues        []*glb.Issue
 	AuthoredIssues        []*glb.Issue
 	RemoteProject         *glb.Project
+
+	DefaultBranchPipeline *glb.PipelineInfo
+	MergeRequestPipelines map[int]*glb.PipelineInfo
+	Approvals             map[int]*glb.MergeRequestApprovals
+	FailedJobs            []*glb.Job
 }
 
 func NewGitlabProject(context *context, projectPath string) *GitlabProject {
@@ -56,6 +61,7 @@ func (project *GitlabProject) Refresh() {
 	project.AssignedIssues, _ = project.loadAssignedIssues()
 	project.AuthoredIssues, _ = project.loadAuthoredIssues()
 	project.RemoteProject, _ = project.loadRemoteProject()
+	project.loadPipelines()
 }
 
 /* -------------------- Counts -------------------- */
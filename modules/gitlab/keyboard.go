@@ -15,6 +15,9 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("o", widget.openRepo, "Open item in browser")
 	widget.SetKeyboardChar("p", widget.openPulls, "Open merge requests in browser")
 	widget.SetKeyboardChar("i", widget.openIssues, "Open issues in browser")
+	widget.SetKeyboardChar("L", widget.ShowJobLog, "Show failed job log")
+	widget.SetKeyboardChar("R", widget.RetryPipeline, "Retry pipeline")
+	widget.SetKeyboardChar("a", widget.ApproveMergeRequest, "Approve merge request")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
@@ -0,0 +1,36 @@
+package gitlab
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+const (
+	logModalHeight = 30
+	logModalWidth  = 120
+)
+
+// newLogModal creates a modal that displays the end of a job log. It is scrolled to
+// the bottom, as the end of the log is usually where the job failed
+func newLogModal(text string, closeFunc func()) *tview.Frame {
+	textView := tview.NewTextView()
+	textView.SetDynamicColors(true)
+	textView.SetWrap(true)
+	textView.SetText(text)
+	textView.ScrollToEnd()
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		case tcell.KeyTab:
+			return nil
+		default:
+			return event
+		}
+	})
+
+	return view.NewModalFrame(textView, logModalWidth, logModalHeight)
+}
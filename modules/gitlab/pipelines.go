@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"io"
+	"strings"
+
+	glb "github.com/xanzy/go-gitlab"
+)
+
+var pipelineIcons = map[string]string{
+	"success":              "[green]✓[white]",
+	"failed":               "[red]✗[white]",
+	"canceled":             "[grey]⊘[white]",
+	"skipped":              "[grey]-[white]",
+	"manual":               "[grey]▸[white]",
+	"running":              "[yellow]●[white]",
+	"pending":              "[grey]○[white]",
+	"created":              "[grey]○[white]",
+	"preparing":            "[grey]○[white]",
+	"scheduled":            "[grey]○[white]",
+	"waiting_for_resource": "[grey]○[white]",
+}
+
+// recentPipelineCount is how many of a project's latest pipelines are searched for the
+// pipelines of its merge requests
+const recentPipelineCount = 100
+
+/* -------------------- Unexported Functions -------------------- */
+
+// pipelineIcon returns a colored icon for the status of a pipeline, or an empty string
+// if there is no pipeline
+func pipelineIcon(pipeline *glb.PipelineInfo) string {
+	if pipeline == nil {
+		return ""
+	}
+
+	if icon, ok := pipelineIcons[pipeline.Status]; ok {
+		return icon
+	}
+
+	return "[grey]?[white]"
+}
+
+// loadPipelines fetches the latest pipeline of the default branch and of each merge
+// request, along with the approvals of each merge request, and the failed jobs of every
+// pipeline that failed
+func (project *GitlabProject) loadPipelines() {
+	project.DefaultBranchPipeline = nil
+	project.MergeRequestPipelines = map[int]*glb.PipelineInfo{}
+	project.Approvals = map[int]*glb.MergeRequestApprovals{}
+	project.FailedJobs = []*glb.Job{}
+
+	failedPipelines := []*glb.PipelineInfo{}
+
+	if project.RemoteProject != nil && project.RemoteProject.DefaultBranch != "" {
+		pipeline, err := project.loadLatestPipeline(project.RemoteProject.DefaultBranch)
+		if err == nil && pipeline != nil {
+			project.DefaultBranchPipeline = pipeline
+
+			if pipeline.Status == "failed" {
+				failedPipelines = append(failedPipelines, pipeline)
+			}
+		}
+	}
+
+	mrs := project.displayedMergeRequests()
+
+	if len(mrs) > 0 {
+		pipelines, err := project.loadRecentPipelines()
+		if err == nil {
+			project.MergeRequestPipelines = mergeRequestPipelines(mrs, pipelines)
+		}
+	}
+
+	for _, mr := range mrs {
+		if _, ok := project.Approvals[mr.IID]; ok {
+			continue
+		}
+
+		pipeline, ok := project.MergeRequestPipelines[mr.IID]
+		if ok && pipeline.Status == "failed" && !containsPipeline(failedPipelines, pipeline.ID) {
+			failedPipelines = append(failedPipelines, pipeline)
+		}
+
+		approvals, _, err := project.context.client.MergeRequestApprovals.GetConfiguration(project.path, mr.IID)
+		if err == nil {
+			project.Approvals[mr.IID] = approvals
+		}
+	}
+
+	for _, pipeline := range failedPipelines {
+		jobs, err := project.loadFailedJobs(pipeline.ID)
+		if err == nil {
+			project.FailedJobs = append(project.FailedJobs, jobs...)
+		}
+	}
+}
+
+// mergeRequestPipelines matches each merge request to the newest of the given pipelines
+// that ran on its head commit. Merge requests whose pipelines are older than the given
+// ones are left out
+func mergeRequestPipelines(mrs []*glb.MergeRequest, pipelines []*glb.PipelineInfo) map[int]*glb.PipelineInfo {
+	latest := map[string]*glb.PipelineInfo{}
+	for _, pipeline := range pipelines {
+		if _, ok := latest[pipeline.SHA]; !ok {
+			latest[pipeline.SHA] = pipeline
+		}
+	}
+
+	result := map[int]*glb.PipelineInfo{}
+	for _, mr := range mrs {
+		if pipeline, ok := latest[mr.SHA]; ok {
+			result[mr.IID] = pipeline
+		}
+	}
+
+	return result
+}
+
+func containsPipeline(pipelines []*glb.PipelineInfo, pipelineID int) bool {
+	for _, pipeline := range pipelines {
+		if pipeline.ID == pipelineID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// displayedMergeRequests returns the merge requests that are assigned to or authored
+// by the user
+func (project *GitlabProject) displayedMergeRequests() []*glb.MergeRequest {
+	mrs := []*glb.MergeRequest{}
+	mrs = append(mrs, project.myAssignedMergeRequests()...)
+	return append(mrs, project.myMergeRequests()...)
+}
+
+func (project *GitlabProject) loadLatestPipeline(ref string) (*glb.PipelineInfo, error) {
+	opts := glb.ListProjectPipelinesOptions{
+		ListOptions: glb.ListOptions{PerPage: 1},
+		Ref:         &ref,
+	}
+
+	pipelines, _, err := project.context.client.Pipelines.ListProjectPipelines(project.path, &opts)
+	if err != nil || len(pipelines) == 0 {
+		return nil, err
+	}
+
+	return pipelines[0], nil
+}
+
+// loadRecentPipelines fetches the most recent pipelines of the project in a single
+// request, newest first
+func (project *GitlabProject) loadRecentPipelines() ([]*glb.PipelineInfo, error) {
+	opts := glb.ListProjectPipelinesOptions{
+		ListOptions: glb.ListOptions{PerPage: recentPipelineCount},
+	}
+
+	pipelines, _, err := project.context.client.Pipelines.ListProjectPipelines(project.path, &opts)
+	return pipelines, err
+}
+
+func (project *GitlabProject) loadFailedJobs(pipelineID int) ([]*glb.Job, error) {
+	opts := glb.ListJobsOptions{
+		Scope: &[]glb.BuildStateValue{glb.Failed},
+	}
+
+	jobs, _, err := project.context.client.Jobs.ListPipelineJobs(project.path, pipelineID, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// failedJob returns the failed job with the given ID
+func (project *GitlabProject) failedJob(jobID int) *glb.Job {
+	for _, job := range project.FailedJobs {
+		if job.ID == jobID {
+			return job
+		}
+	}
+
+	return nil
+}
+
+// jobLogTail returns the last lines of a job's log
+func (project *GitlabProject) jobLogTail(jobID int, lines int) (string, error) {
+	trace, _, err := project.context.client.Jobs.GetTraceFile(project.path, jobID)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(trace)
+	if err != nil {
+		return "", err
+	}
+
+	return tail(string(data), lines), nil
+}
+
+// retryPipeline retries the failed jobs of a pipeline
+func (project *GitlabProject) retryPipeline(pipelineID int) error {
+	_, _, err := project.context.client.Pipelines.RetryPipelineBuild(project.path, pipelineID)
+	return err
+}
+
+// approve approves a merge request as the current user
+func (project *GitlabProject) approve(mrIID int) error {
+	_, _, err := project.context.client.MergeRequestApprovals.ApproveMergeRequest(project.path, mrIID, &glb.ApproveMergeRequestOptions{})
+	return err
+}
+
+// tail returns the last n lines of text. GitLab job logs use \r to redraw progress
+// output, so only the final state of each line is kept
+func tail(text string, n int) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	for i, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		if idx := strings.LastIndex(line, "\r"); idx >= 0 {
+			line = line[idx+1:]
+		}
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	glb "github.com/xanzy/go-gitlab"
+)
+
+func Test_tail(t *testing.T) {
+	log := "one\ntwo\nprogress 10%\rprogress 50%\rprogress 100%\r\nfour\n"
+
+	assert.Equal(t, "progress 100%\nfour", tail(log, 2))
+	assert.Equal(t, "one\ntwo\nprogress 100%\nfour", tail(log, 10))
+}
+
+func Test_approvalString(t *testing.T) {
+	tests := []struct {
+		name      string
+		approvals *glb.MergeRequestApprovals
+		expected  string
+	}{
+		{
+			name:      "without approvals",
+			approvals: nil,
+			expected:  "",
+		},
+		{
+			name:      "when approved",
+			approvals: &glb.MergeRequestApprovals{Approved: true, ApprovalsRequired: 2},
+			expected:  " [green]approved[white]",
+		},
+		{
+			name:      "when partially approved",
+			approvals: &glb.MergeRequestApprovals{ApprovalsRequired: 2, ApprovalsLeft: 1},
+			expected:  " [yellow]1/2 approvals[white]",
+		},
+		{
+			name: "with optional approvals",
+			approvals: &glb.MergeRequestApprovals{
+				ApprovedBy: []*glb.MergeRequestApproverUser{{}},
+			},
+			expected: " [green]approved by 1[white]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, approvalString(tt.approvals))
+		})
+	}
+}
+
+func Test_mergeRequestPipelines(t *testing.T) {
+	mrs := []*glb.MergeRequest{
+		{IID: 1, SHA: "aaa"},
+		{IID: 2, SHA: "bbb"},
+		{IID: 3, SHA: "ccc"},
+	}
+
+	pipelines := []*glb.PipelineInfo{
+		{ID: 30, SHA: "aaa", Status: "running"},
+		{ID: 20, SHA: "bbb", Status: "failed"},
+		{ID: 10, SHA: "aaa", Status: "failed"},
+	}
+
+	result := mergeRequestPipelines(mrs, pipelines)
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, 30, result[1].ID)
+	assert.Equal(t, 20, result[2].ID)
+	assert.NotContains(t, result, 3)
+}
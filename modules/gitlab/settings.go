@@ -18,6 +18,7 @@ type Settings struct {
 
 	apiKey   string   `help:"A GitLab personal access token. Requires at least api access."`
 	domain   string   `help:"Your GitLab corporate domain."`
+	logLines int      `help:"The number of lines of a failed job's log to display." optional:"true"`
 	projects []string `help:"A list of key/value pairs each describing a GitLab project to fetch data for." values:"Key: The name of the project. Value: The namespace of the project."`
 	username string   `help:"Your GitLab username. Used to figure out which requests require your approval"`
 }
@@ -29,6 +30,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 		apiKey:   ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_GITLAB_TOKEN"))),
 		domain:   ymlConfig.UString("domain", "https://gitlab.com"),
+		logLines: ymlConfig.UInt("logLines", 100),
 		username: ymlConfig.UString("username"),
 	}
 
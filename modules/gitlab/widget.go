@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/rivo/tview"
@@ -20,7 +21,10 @@ type Widget struct {
 	GitlabProjects []*GitlabProject
 
 	context  *context
+	err      error
+	pages    *tview.Pages
 	settings *Settings
+	tviewApp *tview.Application
 	Selected int
 	maxItems int
 	Items    []ContentItem
@@ -37,7 +41,9 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		TextWidget:        view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
 
 		context:  context,
+		pages:    pages,
 		settings: settings,
+		tviewApp: tviewApp,
 
 		configError: err,
 	}
@@ -61,6 +67,8 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
+	widget.err = nil
+
 	for _, project := range widget.GitlabProjects {
 		project.Refresh()
 	}
@@ -68,6 +76,78 @@ func (widget *Widget) Refresh() {
 	widget.display()
 }
 
+// ShowJobLog displays the end of the selected failed job's log in a modal
+func (widget *Widget) ShowJobLog() {
+	project, item := widget.selectedItem()
+	if project == nil || item.Type != "JOB" {
+		return
+	}
+
+	job := project.failedJob(item.ID)
+	if job == nil {
+		return
+	}
+
+	log, err := project.jobLogTail(job.ID, widget.settings.logLines)
+	if err != nil {
+		log = err.Error()
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("log")
+		widget.tviewApp.SetFocus(widget.View)
+	}
+
+	modal := newLogModal(tview.TranslateANSI(tview.Escape(log)), closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s #%d  ", job.Name, job.ID))
+
+	widget.pages.AddPage("log", modal, false, true)
+	widget.tviewApp.SetFocus(modal)
+
+	widget.RedrawChan <- true
+}
+
+// RetryPipeline retries the pipeline of the selected merge request or failed job
+func (widget *Widget) RetryPipeline() {
+	project, item := widget.selectedItem()
+	if project == nil {
+		return
+	}
+
+	pipelineID := 0
+
+	switch item.Type {
+	case "MR":
+		if pipeline, ok := project.MergeRequestPipelines[item.ID]; ok {
+			pipelineID = pipeline.ID
+		}
+	case "JOB":
+		if job := project.failedJob(item.ID); job != nil {
+			pipelineID = job.Pipeline.ID
+		}
+	}
+
+	if pipelineID == 0 {
+		return
+	}
+
+	widget.performAction(func() error {
+		return project.retryPipeline(pipelineID)
+	})
+}
+
+// ApproveMergeRequest approves the selected merge request
+func (widget *Widget) ApproveMergeRequest() {
+	project, item := widget.selectedItem()
+	if project == nil || item.Type != "MR" {
+		return
+	}
+
+	widget.performAction(func() error {
+		return project.approve(item.ID)
+	})
+}
+
 // SetItemCount sets the amount of PRs RRs and other PRs throughout the widgets display creation
 func (widget *Widget) SetItemCount(items int) {
 	widget.maxItems = items
@@ -138,6 +218,28 @@ func (widget *Widget) currentGitlabProject() *GitlabProject {
 	return widget.GitlabProjects[widget.Idx]
 }
 
+// performAction runs an action against GitLab and refreshes the widget. If the action
+// fails, its error is displayed above the project data
+func (widget *Widget) performAction(action func() error) {
+	err := action()
+	widget.Refresh()
+
+	if err != nil {
+		widget.err = err
+		widget.display()
+	}
+}
+
+// selectedItem returns the current project and the item selected in it
+func (widget *Widget) selectedItem() (*GitlabProject, ContentItem) {
+	project := widget.currentGitlabProject()
+	if project == nil || widget.Selected < 0 || widget.Selected >= len(widget.Items) {
+		return nil, ContentItem{}
+	}
+
+	return project, widget.Items[widget.Selected]
+}
+
 func (widget *Widget) openItemInBrowser() {
 	currentSelection := widget.View.GetHighlights()
 	if widget.Selected >= 0 && currentSelection[0] != "" {
@@ -156,6 +258,8 @@ func (widget *Widget) openItemInBrowser() {
 			url = (project.RemoteProject.WebURL + "/merge_requests/" + strconv.Itoa(item.ID))
 		case "ISSUE":
 			url = (project.RemoteProject.WebURL + "/issues/" + strconv.Itoa(item.ID))
+		case "JOB":
+			url = (project.RemoteProject.WebURL + "/-/jobs/" + strconv.Itoa(item.ID))
 		}
 
 		utils.OpenFile(url)
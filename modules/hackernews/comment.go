@@ -0,0 +1,146 @@
+package hackernews
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// commentLimit is the most comments of a story that are loaded, as each one is a
+	// request of its own
+	commentLimit = 300
+
+	// fetchLimit is how many comments are fetched at once
+	fetchLimit = 8
+)
+
+// Comment represents a comment on a HackerNews story
+type Comment struct {
+	By      string `json:"by"`
+	Dead    bool   `json:"dead"`
+	Deleted bool   `json:"deleted"`
+	ID      int    `json:"id"`
+	Kids    []int  `json:"kids"`
+	Text    string `json:"text"`
+	Time    int64  `json:"time"`
+}
+
+// GetComment fetches a comment by its ID
+func GetComment(id int) (Comment, error) {
+	var comment Comment
+
+	resp, err := apiRequest("item/" + strconv.Itoa(id))
+	if err != nil {
+		return comment, err
+	}
+
+	err = utils.ParseJSON(&comment, bytes.NewReader(resp))
+	if err != nil {
+		return comment, err
+	}
+
+	return comment, nil
+}
+
+// GetComments fetches the comments of a story as a thread. Comments are fetched a
+// level of the thread at a time, so that when a story has more than commentLimit
+// comments the deepest replies are the ones left out
+func GetComments(story *Story) ([]*view.Comment, error) {
+	comments := map[int]*Comment{}
+
+	ids := story.Kids
+	for len(ids) > 0 && len(comments) < commentLimit {
+		ids = ids[:min(len(ids), commentLimit-len(comments))]
+
+		level, err := fetchComments(ids)
+		if err != nil && len(comments) == 0 {
+			return nil, err
+		}
+
+		ids = []int{}
+		for _, comment := range level {
+			comments[comment.ID] = comment
+			ids = append(ids, comment.Kids...)
+		}
+	}
+
+	return threadComments(story.Kids, comments), nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetchComments fetches comments concurrently, keeping them in the order of ids. A
+// comment that can't be fetched is left out, along with its replies. It's an error
+// when none of them can be
+func fetchComments(ids []int) ([]*Comment, error) {
+	fetched := make([]*Comment, len(ids))
+	errs := make([]error, len(ids))
+
+	group := errgroup.Group{}
+	group.SetLimit(fetchLimit)
+
+	for i, id := range ids {
+		group.Go(func() error {
+			comment, err := GetComment(id)
+			if err != nil {
+				errs[i] = err
+				return nil
+			}
+
+			fetched[i] = &comment
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	comments := []*Comment{}
+	for _, comment := range fetched {
+		if comment != nil {
+			comments = append(comments, comment)
+		}
+	}
+
+	if len(comments) == 0 && len(ids) > 0 {
+		return nil, errs[0]
+	}
+
+	return comments, nil
+}
+
+// threadComments builds the thread of the comments with the given IDs and their
+// replies. Deleted and dead comments are only kept, without their text, when they
+// have replies
+func threadComments(ids []int, comments map[int]*Comment) []*view.Comment {
+	thread := []*view.Comment{}
+
+	for _, id := range ids {
+		comment, ok := comments[id]
+		if !ok {
+			continue
+		}
+
+		replies := threadComments(comment.Kids, comments)
+
+		if comment.Deleted || comment.Dead {
+			if len(replies) > 0 {
+				thread = append(thread, &view.Comment{Replies: replies})
+			}
+			continue
+		}
+
+		thread = append(thread, &view.Comment{
+			Author:  comment.By,
+			Replies: replies,
+			Text:    view.CommentText(comment.Text),
+			Time:    time.Unix(comment.Time, 0),
+		})
+	}
+
+	return thread
+}
@@ -0,0 +1,64 @@
+package hackernews
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_GetComments(t *testing.T) {
+	items := map[string]string{
+		"/item/2.json": `{"id": 2, "by": "alice", "text": "Nice &amp; <i>short</i>", "time": 1700000000, "kids": [4, 5]}`,
+		"/item/3.json": `{"id": 3, "deleted": true, "kids": [6]}`,
+		"/item/4.json": `{"id": 4, "by": "bob", "text": "Agreed<p>Second paragraph", "time": 1700000100}`,
+		"/item/5.json": `{"id": 5, "dead": true}`,
+		"/item/6.json": `{"id": 6, "by": "carol", "text": "Orphan", "time": 1700000200}`,
+		"/item/8.json": `{"id": 8, "by": "dave", "text": "Replies gone", "time": 1700000300, "kids": [9]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		item, ok := items[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(item))
+	}))
+	defer server.Close()
+
+	endpoint := apiEndpoint
+	apiEndpoint = server.URL + "/"
+	defer func() { apiEndpoint = endpoint }()
+
+	comments, err := GetComments(&Story{ID: 1, Kids: []int{2, 3}})
+	assert.NilError(t, err)
+
+	assert.Equal(t, 2, len(comments))
+	assert.Equal(t, "alice", comments[0].Author)
+	assert.Equal(t, "Nice & short", comments[0].Text)
+	assert.Equal(t, int64(1700000000), comments[0].Time.Unix())
+
+	// The dead reply is left out
+	assert.Equal(t, 1, len(comments[0].Replies))
+	assert.Equal(t, "Agreed\n\nSecond paragraph", comments[0].Replies[0].Text)
+
+	// The deleted comment is kept for its reply
+	assert.Equal(t, "", comments[1].Author)
+	assert.Equal(t, "carol", comments[1].Replies[0].Author)
+
+	// A comment that can't be fetched doesn't prevent the others from being shown
+	comments, err = GetComments(&Story{ID: 1, Kids: []int{7, 6}})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(comments))
+	assert.Equal(t, "carol", comments[0].Author)
+
+	comments, err = GetComments(&Story{ID: 1, Kids: []int{8}})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(comments))
+	assert.Equal(t, 0, len(comments[0].Replies))
+
+	_, err = GetComments(&Story{ID: 1, Kids: []int{7}})
+	assert.ErrorContains(t, err, "404")
+}
@@ -101,6 +101,15 @@ func (widget *Widget) openComments() {
 	}
 }
 
+func (widget *Widget) showComments() {
+	story := widget.selectedStory()
+	if story != nil {
+		widget.ShowComments(story.Title, func() ([]*view.Comment, error) {
+			return GetComments(story)
+		})
+	}
+}
+
 func (widget *Widget) openStory() {
 	story := widget.selectedStory()
 	if story != nil {
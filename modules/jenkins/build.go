@@ -0,0 +1,170 @@
+package jenkins
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Build is a single build of a job
+type Build struct {
+	Number            int    `json:"number"`
+	Url               string `json:"url"`
+	Result            string `json:"result"`
+	Building          bool   `json:"building"`
+	Duration          int64  `json:"duration"`
+	EstimatedDuration int64  `json:"estimatedDuration"`
+	Timestamp         int64  `json:"timestamp"`
+}
+
+// StartedAt returns when the build started
+func (build *Build) StartedAt() time.Time {
+	return time.UnixMilli(build.Timestamp)
+}
+
+// Elapsed returns how long the build took, or how long it has been running so far
+func (build *Build) Elapsed(now time.Time) time.Duration {
+	if build.Building {
+		return now.Sub(build.StartedAt()).Truncate(time.Second)
+	}
+
+	return (time.Duration(build.Duration) * time.Millisecond).Truncate(time.Second)
+}
+
+// State returns the result of a finished build, or "BUILDING" for a running one
+func (build *Build) State() string {
+	if build.Building {
+		return "BUILDING"
+	}
+
+	if build.Result == "" {
+		return "PENDING"
+	}
+
+	return build.Result
+}
+
+type buildHistory struct {
+	Builds []Build `json:"builds"`
+}
+
+// ParameterDefinition describes a parameter of a parameterized job
+type ParameterDefinition struct {
+	Class                 string          `json:"_class"`
+	Name                  string          `json:"name"`
+	Type                  string          `json:"type"`
+	Description           string          `json:"description"`
+	Choices               []string        `json:"choices"`
+	DefaultParameterValue *ParameterValue `json:"defaultParameterValue"`
+}
+
+// ParameterValue is the default value of a parameter
+type ParameterValue struct {
+	Value interface{} `json:"value"`
+}
+
+// DefaultValue returns the default value of the parameter as it would be submitted
+func (param *ParameterDefinition) DefaultValue() string {
+	if param.DefaultParameterValue == nil || param.DefaultParameterValue.Value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(param.DefaultParameterValue.Value)
+}
+
+type jobProperties struct {
+	Property []struct {
+		ParameterDefinitions []ParameterDefinition `json:"parameterDefinitions"`
+	} `json:"property"`
+}
+
+// consoleChunk is a piece of a build's console output
+type consoleChunk struct {
+	Text     string
+	Next     int64
+	MoreData bool
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// recentBuilds returns the most recent builds of a job
+func (client *Client) recentBuilds(job *Job) ([]Build, error) {
+	query := url.Values{}
+	query.Set("tree", fmt.Sprintf("builds[%s]{0,%d}", buildFields, client.settings.buildCount))
+
+	history := &buildHistory{}
+	err := client.getJSON(apiURL(job.Url, query), history)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.Builds, nil
+}
+
+// Parameters returns the parameter definitions of a job, which are empty if the job
+// isn't parameterized
+func (client *Client) Parameters(job *Job) ([]ParameterDefinition, error) {
+	query := url.Values{}
+	query.Set("tree", "property[parameterDefinitions[_class,name,type,description,choices,defaultParameterValue[value]]]")
+
+	properties := &jobProperties{}
+	err := client.getJSON(apiURL(job.Url, query), properties)
+	if err != nil {
+		return nil, err
+	}
+
+	params := []ParameterDefinition{}
+	for _, property := range properties.Property {
+		params = append(params, property.ParameterDefinitions...)
+	}
+
+	return params, nil
+}
+
+// TriggerBuild queues a build of a job. Parameterized jobs are built with the given
+// parameter values
+func (client *Client) TriggerBuild(job *Job, params url.Values) error {
+	endpoint := "build"
+	if params != nil {
+		endpoint = "buildWithParameters"
+	} else {
+		params = url.Values{}
+	}
+
+	resp, err := client.jenkinsRequest("POST", ensureLastSlash(job.Url)+endpoint, params)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// consoleText returns the console output of a build from the given byte offset onwards
+func (client *Client) consoleText(build *Build, start int64) (*consoleChunk, error) {
+	requestURL := ensureLastSlash(build.Url) + "logText/progressiveText?start=" + strconv.FormatInt(start, 10)
+
+	resp, err := client.jenkinsRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	text, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &consoleChunk{
+		Text:     string(text),
+		Next:     start + int64(len(text)),
+		MoreData: resp.Header.Get("X-More-Data") == "true",
+	}
+
+	if size, err := strconv.ParseInt(resp.Header.Get("X-Text-Size"), 10, 64); err == nil {
+		chunk.Next = size
+	}
+
+	return chunk, nil
+}
@@ -2,6 +2,8 @@ package jenkins
 
 import (
 	"crypto/tls"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -10,50 +12,82 @@ import (
 	"github.com/wtfutil/wtf/utils"
 )
 
-func (widget *Widget) Create(jenkinsURL string, username string, apiKey string) (*View, error) {
-	const apiSuffix = "api/json?pretty=true"
-	view := &View{}
-	parsedSuffix, err := url.Parse(apiSuffix)
-	if err != nil {
-		return view, err
+// jobFields are the fields requested for each job, at every level of folder nesting
+const jobFields = "name,url,color,_class"
+
+// buildFields are the fields requested for each build
+const buildFields = "number,url,result,building,duration,estimatedDuration,timestamp"
+
+// Client talks to the Jenkins server in the settings
+type Client struct {
+	settings *Settings
+}
+
+// NewClient creates a client for the Jenkins server in the settings
+func NewClient(settings *Settings) *Client {
+	client := Client{
+		settings: settings,
 	}
 
-	parsedJenkinsURL, err := url.Parse(ensureLastSlash(jenkinsURL))
+	return &client
+}
+
+func (client *Client) Create(jenkinsURL string, username string, apiKey string) (*View, error) {
+	return client.view(jenkinsURL, jobFields)
+}
+
+// JobsWithBuilds returns the jobs of the configured view along with up to count of
+// each job's most recent builds, in a single request
+func (client *Client) JobsWithBuilds(count int) ([]Job, error) {
+	view, err := client.view(client.settings.url, fmt.Sprintf("%s,builds[%s]{0,%d}", jobFields, buildFields, count))
 	if err != nil {
-		return view, err
+		return nil, err
 	}
-	jenkinsAPIURL := parsedJenkinsURL.ResolveReference(parsedSuffix)
 
-	req, _ := http.NewRequest("GET", jenkinsAPIURL.String(), http.NoBody)
-	req.SetBasicAuth(username, apiKey)
+	return view.Jobs, nil
+}
 
-	httpClient := &http.Client{Transport: &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: !widget.settings.verifyServerCertificate,
-		},
-		Proxy: http.ProxyFromEnvironment,
-	},
-	}
-	resp, err := httpClient.Do(req)
+func ensureLastSlash(url string) string {
+	return strings.TrimRight(url, "/") + "/"
+}
 
-	if err != nil {
-		return view, err
+/* -------------------- Unexported Functions -------------------- */
+
+// apiURL returns the JSON API URL of a Jenkins object, such as a view, job or build
+func apiURL(objectURL string, query url.Values) string {
+	apiURL := ensureLastSlash(objectURL) + "api/json"
+	if len(query) > 0 {
+		apiURL += "?" + query.Encode()
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	err = utils.ParseJSON(view, resp.Body)
+	return apiURL
+}
+
+// view fetches a view's jobs, requesting the given fields for each of them. Folders
+// are flattened and the jobs are filtered by jobNameRegex
+func (client *Client) view(viewURL string, fields string) (*View, error) {
+	view := &View{}
+
+	query := url.Values{}
+	query.Set("tree", fmt.Sprintf(
+		"name,url,description,%s,activeConfigurations[%s]",
+		jobsTree(client.settings.folderDepth, fields),
+		fields,
+	))
+
+	err := client.getJSON(apiURL(viewURL, query), view)
 	if err != nil {
 		return view, err
 	}
 
 	respJobs := make([]Job, 0, len(view.Jobs)+len(view.ActiveConfigurations))
-	respJobs = append(append(respJobs, view.Jobs...), view.ActiveConfigurations...)
+	respJobs = append(append(respJobs, flattenJobs(view.Jobs, "")...), view.ActiveConfigurations...)
 
 	jobs := make([]Job, 0)
 
-	var validID = regexp.MustCompile(widget.settings.jobNameRegex)
+	var validID = regexp.MustCompile(client.settings.jobNameRegex)
 	for _, job := range respJobs {
-		if validID.MatchString(job.Name) {
+		if validID.MatchString(job.DisplayName()) {
 			jobs = append(jobs, job)
 		}
 	}
@@ -63,6 +97,83 @@ func (widget *Widget) Create(jenkinsURL string, username string, apiKey string)
 	return view, nil
 }
 
-func ensureLastSlash(url string) string {
-	return strings.TrimRight(url, "/") + "/"
+// jobsTree builds the tree parameter that requests jobs nested in folders and
+// multibranch pipelines up to the given depth
+func jobsTree(depth int, fields string) string {
+	tree := fmt.Sprintf("jobs[%s]", fields)
+
+	for i := 0; i < depth; i++ {
+		tree = fmt.Sprintf("jobs[%s,%s]", fields, tree)
+	}
+
+	return tree
+}
+
+// flattenJobs replaces folders, multibranch pipelines and organization folders with
+// the jobs they contain. Nested jobs are named after their path, e.g. "folder/job"
+func flattenJobs(jobs []Job, prefix string) []Job {
+	flattened := []Job{}
+
+	for _, job := range jobs {
+		job.Path = prefix + job.Name
+
+		if job.isFolder() {
+			flattened = append(flattened, flattenJobs(job.Jobs, job.Path+"/")...)
+			continue
+		}
+
+		flattened = append(flattened, job)
+	}
+
+	return flattened
+}
+
+func (client *Client) httpClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: !client.settings.verifyServerCertificate,
+		},
+		Proxy: http.ProxyFromEnvironment,
+	},
+	}
+}
+
+// jenkinsRequest sends an authenticated request to Jenkins. If form isn't nil it is
+// sent as a form-encoded body. Responses outside the 2xx range are returned as errors
+func (client *Client) jenkinsRequest(method, requestURL string, form url.Values) (*http.Response, error) {
+	var body io.Reader = http.NoBody
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequest(method, requestURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(client.settings.user, client.settings.apiKey)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := client.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s", resp.Status)
+	}
+
+	return resp, nil
+}
+
+func (client *Client) getJSON(requestURL string, result interface{}) error {
+	resp, err := client.jenkinsRequest("GET", requestURL, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return utils.ParseJSON(result, resp.Body)
 }
@@ -0,0 +1,150 @@
+package jenkins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) (*Client, string) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(&Settings{
+		apiKey:                  "token",
+		buildCount:              5,
+		folderDepth:             2,
+		jobNameRegex:            ".*",
+		user:                    "me",
+		verifyServerCertificate: true,
+	})
+
+	return client, server.URL
+}
+
+func Test_jobsTree(t *testing.T) {
+	assert.Equal(t, "jobs[name,url,color,_class]", jobsTree(0, jobFields))
+	assert.Equal(t, "jobs[name,url,color,_class,jobs[name,url,color,_class]]", jobsTree(1, jobFields))
+}
+
+func Test_Create_flattensFolders(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/view/all/api/json", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "me", user)
+		assert.Equal(t, "token", pass)
+		assert.Contains(t, r.URL.Query().Get("tree"), "jobs[name,url,color,_class,jobs[")
+
+		_, _ = w.Write([]byte(`{
+			"name": "all",
+			"jobs": [
+				{"_class": "hudson.model.FreeStyleProject", "name": "deploy", "color": "blue"},
+				{"_class": "com.cloudbees.hudson.plugins.folder.Folder", "name": "team", "jobs": [
+					{"_class": "org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject", "name": "app", "jobs": [
+						{"_class": "org.jenkinsci.plugins.workflow.job.WorkflowJob", "name": "feature%2Flogin", "color": "red"}
+					]},
+					{"_class": "com.cloudbees.hudson.plugins.folder.Folder", "name": "empty"}
+				]}
+			]
+		}`))
+	})
+
+	client, serverURL := newTestClient(t, mux)
+
+	view, err := client.Create(serverURL+"/view/all", "me", "token")
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, job := range view.Jobs {
+		names = append(names, job.DisplayName())
+	}
+	assert.Equal(t, []string{"deploy", "team/app/feature/login"}, names)
+
+	client.settings.jobNameRegex = "^team/"
+	view, err = client.Create(serverURL+"/view/all", "me", "token")
+	require.NoError(t, err)
+	assert.Len(t, view.Jobs, 1)
+}
+
+func Test_parametersAndTrigger(t *testing.T) {
+	var triggered url.Values
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/job/deploy/api/json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"property": [
+			{},
+			{"parameterDefinitions": [
+				{"name": "ENV", "type": "ChoiceParameterDefinition", "choices": ["staging", "production"], "defaultParameterValue": {"value": "staging"}},
+				{"name": "DRY_RUN", "type": "BooleanParameterDefinition", "defaultParameterValue": {"value": true}}
+			]}
+		]}`))
+	})
+	mux.HandleFunc("/job/deploy/buildWithParameters", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		require.NoError(t, r.ParseForm())
+		triggered = r.PostForm
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client, serverURL := newTestClient(t, mux)
+	job := &Job{Name: "deploy", Url: serverURL + "/job/deploy/"}
+
+	params, err := client.Parameters(job)
+	require.NoError(t, err)
+	require.Len(t, params, 2)
+	assert.Equal(t, "staging", params[0].DefaultValue())
+	assert.Equal(t, "true", params[1].DefaultValue())
+
+	require.NoError(t, client.TriggerBuild(job, url.Values{"ENV": {"production"}}))
+	assert.Equal(t, "production", triggered.Get("ENV"))
+}
+
+func Test_consoleText(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/job/deploy/7/logText/progressiveText", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "12", r.URL.Query().Get("start"))
+
+		w.Header().Set("X-Text-Size", "30")
+		w.Header().Set("X-More-Data", "true")
+		_, _ = w.Write([]byte("Building...\n"))
+	})
+
+	client, serverURL := newTestClient(t, mux)
+	build := &Build{Number: 7, Url: serverURL + "/job/deploy/7/"}
+
+	chunk, err := client.consoleText(build, 12)
+	require.NoError(t, err)
+	assert.Equal(t, "Building...\n", chunk.Text)
+	assert.Equal(t, int64(30), chunk.Next)
+	assert.True(t, chunk.MoreData)
+}
+
+func Test_JobsWithBuilds(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.URL.Query().Get("tree"), "builds[number,url,result,building,duration,estimatedDuration,timestamp]{0,3}")
+
+		_, _ = w.Write([]byte(`{"jobs": [
+			{"_class": "hudson.model.FreeStyleProject", "name": "deploy", "builds": [
+				{"number": 8, "building": true, "timestamp": 1704103200000},
+				{"number": 7, "result": "SUCCESS", "duration": 60000, "timestamp": 1704099600000}
+			]}
+		]}`))
+	})
+
+	client, serverURL := newTestClient(t, mux)
+	client.settings.url = serverURL
+
+	jobs, err := client.JobsWithBuilds(3)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Len(t, jobs[0].Builds, 2)
+
+	assert.Equal(t, "BUILDING", jobs[0].Builds[0].State())
+	assert.Equal(t, "SUCCESS", jobs[0].Builds[1].State())
+}
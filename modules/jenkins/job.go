@@ -1,7 +1,48 @@
 package jenkins
 
+import (
+	"net/url"
+	"strings"
+)
+
 type Job struct {
 	Name  string `json:"name"`
 	Url   string `json:"url"`
 	Color string `json:"color"`
+	Class string `json:"_class"`
+	Jobs  []Job  `json:"jobs"`
+
+	// Builds is only populated when a job is fetched along with its builds
+	Builds []Build `json:"builds"`
+
+	// Path is the name of the job prefixed by the folders it is in
+	Path string `json:"-"`
+}
+
+// isFolder returns true if the job contains other jobs rather than being built itself,
+// as folders, multibranch pipelines and organization folders do
+func (job *Job) isFolder() bool {
+	if job.Jobs != nil {
+		return true
+	}
+
+	return strings.HasSuffix(job.Class, ".Folder") ||
+		strings.HasSuffix(job.Class, "MultiBranchProject") ||
+		strings.HasSuffix(job.Class, "OrganizationFolder")
+}
+
+// DisplayName returns the unescaped path of the job. Branch names in multibranch
+// pipelines are URL-escaped by Jenkins
+func (job *Job) DisplayName() string {
+	name := job.Path
+	if name == "" {
+		name = job.Name
+	}
+
+	unescaped, err := url.QueryUnescape(name)
+	if err != nil {
+		return name
+	}
+
+	return unescaped
 }
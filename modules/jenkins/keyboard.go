@@ -8,10 +8,15 @@ func (widget *Widget) initializeKeyboardControls() {
 
 	widget.SetKeyboardChar("j", widget.Next, "Select next item")
 	widget.SetKeyboardChar("k", widget.Prev, "Select previous item")
-	widget.SetKeyboardChar("o", widget.openJob, "Open job in browser")
+	widget.SetKeyboardChar("o", widget.openJob, "Open job or build in browser")
+	widget.SetKeyboardChar("b", widget.TriggerBuild, "Trigger a build")
+	widget.SetKeyboardChar("c", widget.TailConsole, "Tail console output")
+	widget.SetKeyboardChar("l", widget.ShowBuilds, "Show builds of job")
+	widget.SetKeyboardChar("h", widget.ShowJobs, "Return to job list")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
-	widget.SetKeyboardKey(tcell.KeyEnter, widget.openJob, "Open job in browser")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.ShowBuilds, "Show builds of job")
+	widget.SetKeyboardKey(tcell.KeyBackspace2, widget.ShowJobs, "Return to job list")
 	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
 }
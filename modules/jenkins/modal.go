@@ -0,0 +1,164 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+const (
+	// consolePollInterval is how often the console of a running build is polled
+	consolePollInterval = 2 * time.Second
+)
+
+// showParametersForm prompts for the parameters of a job, using each parameter's type
+// to pick the form field, and triggers a build with the submitted values
+func (widget *Widget) showParametersForm(job *Job, params []ParameterDefinition) {
+	form := view.NewModalForm(widget.settings.Common)
+	addParameterFields(form, params)
+
+	closeFn := func() {
+		widget.pages.RemovePage("modal")
+		widget.tviewApp.SetFocus(widget.View)
+		widget.Render()
+	}
+
+	buildFn := func() {
+		values := formValues(form, params)
+		closeFn()
+
+		widget.performAction(func() error {
+			return widget.client.TriggerBuild(job, values)
+		})
+	}
+
+	form.AddButton("Build", buildFn)
+	form.AddButton("Cancel", closeFn)
+	form.SetCancelFunc(closeFn)
+
+	frame := view.NewModalFrame(form, view.ModalWidth, view.FormModalHeight+len(params)*2)
+	frame.SetTitle(fmt.Sprintf(" Build %s ", job.DisplayName()))
+
+	widget.pages.AddPage("modal", frame, false, true)
+	widget.tviewApp.SetFocus(frame)
+
+	// Tell the app to force redraw the screen
+	widget.RedrawChan <- true
+}
+
+// addParameterFields adds a field to the form for each parameter, picked by its type
+func addParameterFields(form *tview.Form, params []ParameterDefinition) {
+	for _, param := range params {
+		switch param.Type {
+		case "BooleanParameterDefinition":
+			form.AddCheckbox(param.Name, param.DefaultValue() == "true", nil)
+		case "ChoiceParameterDefinition":
+			form.AddDropDown(param.Name, param.Choices, 0, nil)
+		case "PasswordParameterDefinition":
+			form.AddPasswordField(param.Name, "", 50, '*', nil)
+		default:
+			form.AddInputField(param.Name, param.DefaultValue(), 50, nil, nil)
+		}
+	}
+}
+
+// formValues reads the value of each parameter from the form. Password parameters
+// left empty are omitted
+func formValues(form *tview.Form, params []ParameterDefinition) url.Values {
+	values := url.Values{}
+
+	for idx, param := range params {
+		switch item := form.GetFormItem(idx).(type) {
+		case *tview.Checkbox:
+			values.Set(param.Name, strconv.FormatBool(item.IsChecked()))
+		case *tview.DropDown:
+			_, option := item.GetCurrentOption()
+			values.Set(param.Name, option)
+		case *tview.InputField:
+			// Password fields start out empty, so leaving one empty keeps the job's
+			// default rather than replacing it with an empty password
+			if param.Type == "PasswordParameterDefinition" && item.GetText() == "" {
+				continue
+			}
+
+			values.Set(param.Name, item.GetText())
+		}
+	}
+
+	return values
+}
+
+// showConsole displays the console output of a build in a full-screen page. While the
+// build is running, new output is appended as it arrives
+func (widget *Widget) showConsole(build *Build) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	textView := tview.NewTextView()
+	textView.SetDynamicColors(false)
+	textView.SetScrollable(true)
+	textView.SetWrap(true)
+	textView.SetMaxLines(widget.settings.consoleLines)
+	textView.SetBorder(true)
+	textView.SetTitle(fmt.Sprintf(" Console: #%d (Esc to close) ", build.Number))
+	textView.SetChangedFunc(func() {
+		textView.ScrollToEnd()
+		widget.tviewApp.Draw()
+	})
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			cancel()
+			widget.pages.RemovePage("console")
+			widget.tviewApp.SetFocus(widget.View)
+			return nil
+		}
+		return event
+	})
+
+	widget.pages.AddPage("console", textView, true, true)
+	widget.tviewApp.SetFocus(textView)
+
+	go widget.followConsole(ctx, build, textView)
+}
+
+// followConsole writes the console output of a build to textView, polling for more
+// until the build finishes or ctx is cancelled
+func (widget *Widget) followConsole(ctx context.Context, build *Build, textView *tview.TextView) {
+	ticker := time.NewTicker(consolePollInterval)
+	defer ticker.Stop()
+
+	start := int64(0)
+
+	for {
+		chunk, err := widget.client.consoleText(build, start)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			_, _ = fmt.Fprintf(textView, "\n%s\n", err.Error())
+			return
+		}
+
+		if chunk.Text != "" {
+			_, _ = fmt.Fprint(textView, chunk.Text)
+		}
+		start = chunk.Next
+
+		if !chunk.MoreData {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
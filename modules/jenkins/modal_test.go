@@ -0,0 +1,30 @@
+package jenkins
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_formValues(t *testing.T) {
+	params := []ParameterDefinition{
+		{Name: "BRANCH", Type: "StringParameterDefinition", DefaultParameterValue: &ParameterValue{Value: "main"}},
+		{Name: "DRY_RUN", Type: "BooleanParameterDefinition", DefaultParameterValue: &ParameterValue{Value: true}},
+		{Name: "ENV", Type: "ChoiceParameterDefinition", Choices: []string{"staging", "production"}},
+		{Name: "TOKEN", Type: "PasswordParameterDefinition", DefaultParameterValue: &ParameterValue{Value: "<DEFAULT>"}},
+	}
+
+	form := tview.NewForm()
+	addParameterFields(form, params)
+
+	assert.Equal(t, url.Values{
+		"BRANCH":  {"main"},
+		"DRY_RUN": {"true"},
+		"ENV":     {"staging"},
+	}, formValues(form, params))
+
+	form.GetFormItem(3).(*tview.InputField).SetText("secret")
+	assert.Equal(t, "secret", formValues(form, params).Get("TOKEN"))
+}
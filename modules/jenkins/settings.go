@@ -16,6 +16,9 @@ type Settings struct {
 	*cfg.Common
 
 	apiKey                  string `help:"Your Jenkins API key."`
+	buildCount              int    `help:"The number of builds to show when drilling into a job." optional:"true"`
+	consoleLines            int    `help:"The number of lines of console output to keep when tailing a build." optional:"true"`
+	folderDepth             int    `help:"How many levels of folders and multibranch pipelines to search for jobs." optional:"true"`
 	jobNameRegex            string `help:"A regex that filters the jobs shown in the widget." optional:"true"`
 	successBallColor        string `help:"Changes the default color of successful Jenkins jobs to the color of your choosing." values:"blue, green, purple, yellow, etc." optional:"true"`
 	url                     string `help:"The url to your Jenkins project or view."`
@@ -29,6 +32,9 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
 		apiKey:                  ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_JENKINS_API_KEY"))),
+		buildCount:              ymlConfig.UInt("buildCount", 10),
+		consoleLines:            ymlConfig.UInt("consoleLines", 500),
+		folderDepth:             ymlConfig.UInt("folderDepth", 3),
 		jobNameRegex:            ymlConfig.UString("jobNameRegex", ".*"),
 		successBallColor:        ymlConfig.UString("successBallColor", "blue"),
 		url:                     ymlConfig.UString("url"),
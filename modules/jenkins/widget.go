@@ -2,7 +2,8 @@ package jenkins
 
 import (
 	"fmt"
-	"net/url"
+	"sync"
+	"time"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
@@ -12,16 +13,27 @@ import (
 type Widget struct {
 	view.ScrollableWidget
 
+	// job is the job being drilled into, or nil when the job list is displayed
+	job    *Job
+	builds []Build
+
+	client   *Client
+	err      error
+	mutex    sync.Mutex
+	pages    *tview.Pages
 	settings *Settings
+	tviewApp *tview.Application
 	view     *View
-	err      error
 }
 
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
 		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		client:   NewClient(settings),
+		pages:    pages,
 		settings: settings,
+		tviewApp: tviewApp,
 	}
 
 	widget.SetRenderFunction(widget.Render)
@@ -37,34 +49,147 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	view, err := widget.Create(
+	view, err := widget.client.Create(
 		widget.settings.url,
 		widget.settings.user,
 		widget.settings.apiKey,
 	)
+
+	widget.mutex.Lock()
+	job := widget.job
+	widget.mutex.Unlock()
+
+	var builds []Build
+	if err == nil && job != nil {
+		builds, err = widget.client.recentBuilds(job)
+	}
+
+	widget.mutex.Lock()
 	widget.view = view
+	widget.builds = builds
+	widget.err = err
 
-	if err != nil {
-		widget.err = err
+	switch {
+	case err != nil:
 		widget.SetItemCount(0)
-	} else {
+	case widget.job != nil:
+		widget.SetItemCount(len(widget.builds))
+	default:
 		widget.SetItemCount(len(widget.view.Jobs))
 	}
+	widget.mutex.Unlock()
 
 	widget.Render()
 }
 
-/* -------------------- Unexported Functions -------------------- */
+// ShowBuilds drills into the selected job and displays its most recent builds
+func (widget *Widget) ShowBuilds() {
+	job := widget.selectedJob()
+	if job == nil {
+		return
+	}
+
+	widget.mutex.Lock()
+	widget.job = job
+	widget.builds = nil
+	widget.mutex.Unlock()
+
+	widget.Unselect()
+	widget.Refresh()
+}
+
+// ShowJobs returns from a job's builds to the job list
+func (widget *Widget) ShowJobs() {
+	widget.mutex.Lock()
+	if widget.job == nil {
+		widget.mutex.Unlock()
+		return
+	}
+	widget.job = nil
+	widget.builds = nil
+	widget.mutex.Unlock()
+
+	widget.Unselect()
+	widget.Refresh()
+}
+
+// TriggerBuild queues a build of the selected job, or of the job being drilled into.
+// Parameterized jobs prompt for their parameters first
+func (widget *Widget) TriggerBuild() {
+	job := widget.currentJob()
+	if job == nil {
+		return
+	}
+
+	params, err := widget.client.Parameters(job)
+	if err != nil {
+		widget.setError(err)
+		return
+	}
+
+	if len(params) == 0 {
+		widget.performAction(func() error {
+			return widget.client.TriggerBuild(job, nil)
+		})
+		return
+	}
+
+	widget.showParametersForm(job, params)
+}
+
+// TailConsole displays the console output of the selected build, or of the last build
+// of the selected job, and follows it while the build is running
+func (widget *Widget) TailConsole() {
+	build := widget.selectedBuild()
+
+	if build == nil {
+		job := widget.currentJob()
+		if job == nil {
+			return
+		}
+
+		builds, err := widget.client.recentBuilds(job)
+		if err == nil && len(builds) == 0 {
+			err = fmt.Errorf("%s has no builds", job.DisplayName())
+		}
+		if err != nil {
+			widget.setError(err)
+			return
+		}
+		build = &builds[0]
+	}
+
+	widget.showConsole(build)
+}
 
 func (widget *Widget) Render() {
 	widget.Redraw(widget.content)
 }
 
+/* -------------------- Unexported Functions -------------------- */
+
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s: [red]%s", widget.CommonSettings().Title, widget.view.Name)
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	viewName := ""
+	if widget.view != nil {
+		viewName = widget.view.Name
+	}
+
+	title := fmt.Sprintf("%s: [red]%s", widget.CommonSettings().Title, viewName)
+	if widget.job != nil {
+		title = fmt.Sprintf("%s: [red]%s", widget.CommonSettings().Title, widget.job.DisplayName())
+	}
+
 	if widget.err != nil {
 		return title, widget.err.Error(), true
 	}
+
+	if widget.job != nil {
+		return title, widget.buildRows(), false
+	}
+
 	if widget.view == nil || len(widget.view.Jobs) == 0 {
 		return title, "No content to display", false
 	}
@@ -72,21 +197,47 @@ func (widget *Widget) content() (string, string, bool) {
 	var str string
 	jobs := widget.view.Jobs
 	for idx, job := range jobs {
-		jobName, _ := url.QueryUnescape(job.Name)
+		jobName := job.DisplayName()
 
 		row := fmt.Sprintf(
 			`[%s] [%s]%-6s[white]`,
 			widget.RowColor(idx),
 			widget.jobColor(job),
-			jobName,
+			tview.Escape(jobName),
 		)
 
-		str += utils.HighlightableHelper(widget.View, row, idx, len(job.Name))
+		str += utils.HighlightableHelper(widget.View, row, idx, len(jobName))
 	}
 
 	return title, str, false
 }
 
+func (widget *Widget) buildRows() string {
+	if len(widget.builds) == 0 {
+		return "No builds to display"
+	}
+
+	now := time.Now()
+
+	var str string
+	for idx, build := range widget.builds {
+		row := fmt.Sprintf(
+			`[%s] [%s]%-9s[white] [%s]#%-5d %8s  %s ago`,
+			widget.RowColor(idx),
+			buildColor(&build),
+			build.State(),
+			widget.RowColor(idx),
+			build.Number,
+			formatDuration(build.Elapsed(now)),
+			formatDuration(now.Sub(build.StartedAt())),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, tview.TaggedStringWidth(row))
+	}
+
+	return str
+}
+
 func (widget *Widget) jobColor(job Job) string {
 	switch job.Color {
 	case "blue":
@@ -99,10 +250,100 @@ func (widget *Widget) jobColor(job Job) string {
 	}
 }
 
-func (widget *Widget) openJob() {
+func buildColor(build *Build) string {
+	switch build.State() {
+	case "SUCCESS":
+		return "green"
+	case "FAILURE":
+		return "red"
+	case "UNSTABLE", "BUILDING":
+		return "yellow"
+	default:
+		return "grey"
+	}
+}
+
+// formatDuration formats a duration with its two most significant units, e.g. 3m12s
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration < time.Minute:
+		return fmt.Sprintf("%ds", int(duration.Seconds()))
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm%02ds", int(duration.Minutes()), int(duration.Seconds())%60)
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(duration.Hours()), int(duration.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd%02dh", int(duration.Hours()/24), int(duration.Hours())%24)
+	}
+}
+
+// selectedJob returns the selected job in the job list
+func (widget *Widget) selectedJob() *Job {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	sel := widget.GetSelected()
+	if widget.job != nil || widget.view == nil || sel < 0 || sel >= len(widget.view.Jobs) {
+		return nil
+	}
+
+	job := widget.view.Jobs[sel]
+	return &job
+}
+
+// selectedBuild returns the selected build when drilled into a job
+func (widget *Widget) selectedBuild() *Build {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
 	sel := widget.GetSelected()
-	if sel >= 0 && widget.view != nil && sel < len(widget.view.Jobs) {
-		job := &widget.view.Jobs[sel]
+	if widget.job == nil || sel < 0 || sel >= len(widget.builds) {
+		return nil
+	}
+
+	build := widget.builds[sel]
+	return &build
+}
+
+// currentJob returns the job being drilled into, or otherwise the selected job
+func (widget *Widget) currentJob() *Job {
+	widget.mutex.Lock()
+	job := widget.job
+	widget.mutex.Unlock()
+
+	if job != nil {
+		return job
+	}
+
+	return widget.selectedJob()
+}
+
+func (widget *Widget) setError(err error) {
+	widget.mutex.Lock()
+	widget.err = err
+	widget.mutex.Unlock()
+
+	widget.Render()
+}
+
+// performAction runs an action against Jenkins and refreshes the widget. If the action
+// fails, its error is displayed instead
+func (widget *Widget) performAction(action func() error) {
+	if err := action(); err != nil {
+		widget.setError(err)
+		return
+	}
+
+	widget.Refresh()
+}
+
+func (widget *Widget) openJob() {
+	if build := widget.selectedBuild(); build != nil {
+		utils.OpenFile(build.Url)
+		return
+	}
+
+	if job := widget.selectedJob(); job != nil {
 		utils.OpenFile(job.Url)
 	}
 }
@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+// Transition is a workflow transition that can be applied to an issue
+type Transition struct {
+	ID   string       `json:"id"`
+	Name string       `json:"name"`
+	To   *IssueStatus `json:"to"`
+}
+
+type transitionsResult struct {
+	Transitions []Transition `json:"transitions"`
+}
+
+// User is a Jira user. Jira Cloud identifies users by account ID, Jira Server by name
+type User struct {
+	AccountID   string `json:"accountId"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// IssueDetail is an issue along with the fields and history shown in the detail modal
+type IssueDetail struct {
+	Key       string             `json:"key"`
+	Fields    *IssueDetailFields `json:"fields"`
+	Changelog *Changelog         `json:"changelog"`
+}
+
+type IssueDetailFields struct {
+	Summary     string       `json:"summary"`
+	Description string       `json:"description"`
+	Created     string       `json:"created"`
+	Updated     string       `json:"updated"`
+	IssueType   *IssueType   `json:"issuetype"`
+	IssueStatus *IssueStatus `json:"status"`
+	Assignee    *User        `json:"assignee"`
+	Reporter    *User        `json:"reporter"`
+	Subtasks    []Issue      `json:"subtasks"`
+}
+
+type Changelog struct {
+	Histories []History `json:"histories"`
+}
+
+type History struct {
+	Author  *User         `json:"author"`
+	Created string        `json:"created"`
+	Items   []HistoryItem `json:"items"`
+}
+
+type HistoryItem struct {
+	Field      string `json:"field"`
+	FromString string `json:"fromString"`
+	ToString   string `json:"toString"`
+}
+
+// StatusChange is a single change of an issue's status
+type StatusChange struct {
+	Author  string
+	Created string
+	From    string
+	To      string
+}
+
+// StatusHistory returns the status changes of the issue, oldest first
+func (detail *IssueDetail) StatusHistory() []StatusChange {
+	changes := []StatusChange{}
+
+	if detail.Changelog == nil {
+		return changes
+	}
+
+	for _, history := range detail.Changelog.Histories {
+		for _, item := range history.Items {
+			if item.Field != "status" {
+				continue
+			}
+
+			change := StatusChange{
+				Created: history.Created,
+				From:    item.FromString,
+				To:      item.ToString,
+			}
+			if history.Author != nil {
+				change.Author = history.Author.DisplayName
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) transitionsFor(issueKey string) ([]Transition, error) {
+	resp, err := widget.jiraRequest(fmt.Sprintf("/rest/api/2/issue/%s/transitions", url.PathEscape(issueKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &transitionsResult{}
+	err = utils.ParseJSON(result, bytes.NewReader(resp))
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Transitions, nil
+}
+
+func (widget *Widget) transitionIssue(issueKey, transitionID string) error {
+	payload := map[string]interface{}{
+		"transition": map[string]string{"id": transitionID},
+	}
+
+	_, err := widget.jiraRequestWithBody("POST", fmt.Sprintf("/rest/api/2/issue/%s/transitions", url.PathEscape(issueKey)), payload)
+	return err
+}
+
+func (widget *Widget) currentUser() (*User, error) {
+	resp, err := widget.jiraRequest("/rest/api/2/myself")
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{}
+	err = utils.ParseJSON(user, bytes.NewReader(resp))
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// assignToMe assigns an issue to the authenticated user
+func (widget *Widget) assignToMe(issueKey string) error {
+	user, err := widget.currentUser()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]string{"name": user.Name}
+	if user.AccountID != "" {
+		payload = map[string]string{"accountId": user.AccountID}
+	}
+
+	_, err = widget.jiraRequestWithBody("PUT", fmt.Sprintf("/rest/api/2/issue/%s/assignee", url.PathEscape(issueKey)), payload)
+	return err
+}
+
+func (widget *Widget) addComment(issueKey, body string) error {
+	payload := map[string]string{"body": body}
+
+	_, err := widget.jiraRequestWithBody("POST", fmt.Sprintf("/rest/api/2/issue/%s/comment", url.PathEscape(issueKey)), payload)
+	return err
+}
+
+func (widget *Widget) issueDetail(issueKey string) (*IssueDetail, error) {
+	v := url.Values{}
+	v.Set("expand", "changelog")
+	v.Set("fields", "summary,description,created,updated,issuetype,status,assignee,reporter,subtasks")
+
+	resp, err := widget.jiraRequest(fmt.Sprintf("/rest/api/2/issue/%s?%s", url.PathEscape(issueKey), v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &IssueDetail{}
+	err = utils.ParseJSON(detail, bytes.NewReader(resp))
+	if err != nil {
+		return nil, err
+	}
+
+	return detail, nil
+}
@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWidget(t *testing.T, handler http.Handler) *Widget {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Widget{
+		settings: &Settings{
+			domain:                  server.URL,
+			email:                   "me@example.com",
+			apiKey:                  "secret",
+			verifyServerCertificate: true,
+		},
+	}
+}
+
+func decodeBody(t *testing.T, r *http.Request) map[string]interface{} {
+	body := map[string]interface{}{}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	return body
+}
+
+func Test_transitions(t *testing.T) {
+	var applied map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/WTF-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			applied = decodeBody(t, r)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"transitions": [{"id": "21", "name": "Start", "to": {"name": "In Progress"}}]}`))
+	})
+
+	widget := newTestWidget(t, mux)
+
+	transitions, err := widget.transitionsFor("WTF-1")
+	require.NoError(t, err)
+	require.Len(t, transitions, 1)
+	assert.Equal(t, "21", transitions[0].ID)
+	assert.Equal(t, "In Progress", transitions[0].To.IName)
+
+	require.NoError(t, widget.transitionIssue("WTF-1", "21"))
+	assert.Equal(t, map[string]interface{}{"transition": map[string]interface{}{"id": "21"}}, applied)
+}
+
+func Test_assignToMe(t *testing.T) {
+	tests := []struct {
+		name     string
+		myself   string
+		expected map[string]interface{}
+	}{
+		{
+			name:     "on Jira Cloud",
+			myself:   `{"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Me"}`,
+			expected: map[string]interface{}{"accountId": "5b10ac8d82e05b22cc7d4ef5"},
+		},
+		{
+			name:     "on Jira Server",
+			myself:   `{"name": "me", "displayName": "Me"}`,
+			expected: map[string]interface{}{"name": "me"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var assigned map[string]interface{}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.myself))
+			})
+			mux.HandleFunc("/rest/api/2/issue/WTF-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PUT", r.Method)
+				assigned = decodeBody(t, r)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			require.NoError(t, newTestWidget(t, mux).assignToMe("WTF-1"))
+			assert.Equal(t, tt.expected, assigned)
+		})
+	}
+}
+
+func Test_issueDetail(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/WTF-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+
+		_, _ = w.Write([]byte(`{
+			"key": "WTF-1",
+			"fields": {"summary": "Fix it", "subtasks": [{"key": "WTF-2", "fields": {"summary": "Part", "status": {"name": "Done"}}}]},
+			"changelog": {"histories": [
+				{"author": {"displayName": "Ann"}, "created": "2024-03-05T14:30:00.000+0000", "items": [
+					{"field": "assignee", "fromString": "", "toString": "Ann"},
+					{"field": "status", "fromString": "To Do", "toString": "In Progress"}
+				]}
+			]}
+		}`))
+	})
+
+	detail, err := newTestWidget(t, mux).issueDetail("WTF-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fix it", detail.Fields.Summary)
+	assert.Equal(t, "WTF-2", detail.Fields.Subtasks[0].Key)
+	assert.Equal(
+		t,
+		[]StatusChange{{Author: "Ann", Created: "2024-03-05T14:30:00.000+0000", From: "To Do", To: "In Progress"}},
+		detail.StatusHistory(),
+	)
+	assert.Equal(t, "2024-03-05 14:30", formatJiraTime(detail.StatusHistory()[0].Created))
+}
+
+func Test_requestError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/WTF-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	assert.Error(t, newTestWidget(t, mux).addComment("WTF-1", "hello"))
+}
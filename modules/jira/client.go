@@ -3,6 +3,7 @@ package jira
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -57,12 +58,30 @@ func buildJql(key string, value string) string {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) jiraRequest(path string) ([]byte, error) {
+	return widget.jiraRequestWithBody("GET", path, nil)
+}
+
+// jiraRequestWithBody sends a request to the Jira API. If payload isn't nil, it is
+// sent as the JSON body of the request
+func (widget *Widget) jiraRequestWithBody(method, path string, payload interface{}) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", widget.settings.domain, path)
 
-	req, err := http.NewRequest("GET", url, http.NoBody)
+	var reqBody io.Reader = http.NoBody
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	if widget.settings.personalAccessToken != "" {
 		req.Header.Set("Authorization", "Bearer "+widget.settings.personalAccessToken)
 	} else {
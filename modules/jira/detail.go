@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+// jiraTimeFormat is the format Jira uses for timestamps
+const jiraTimeFormat = "2006-01-02T15:04:05.000-0700"
+
+/* -------------------- Unexported Functions -------------------- */
+
+// formatDetail renders an issue's description, status history and subtasks for the
+// detail modal
+func (widget *Widget) formatDetail(detail *IssueDetail) string {
+	subheading := widget.settings.Colors.Subheading
+	fields := detail.Fields
+	if fields == nil {
+		fields = &IssueDetailFields{}
+	}
+
+	str := fmt.Sprintf("[::b]%s[::-] %s\n\n", detail.Key, tview.Escape(fields.Summary))
+
+	if fields.IssueType != nil {
+		str += fmt.Sprintf("Type:     %s\n", tview.Escape(fields.IssueType.Name))
+	}
+	if fields.IssueStatus != nil {
+		str += fmt.Sprintf("Status:   [yellow]%s[white]\n", tview.Escape(fields.IssueStatus.IName))
+	}
+	str += fmt.Sprintf("Assignee: %s\n", userName(fields.Assignee))
+	str += fmt.Sprintf("Reporter: %s\n", userName(fields.Reporter))
+	str += fmt.Sprintf("Created:  %s\n", formatJiraTime(fields.Created))
+	str += fmt.Sprintf("Updated:  %s\n", formatJiraTime(fields.Updated))
+
+	str += fmt.Sprintf("\n[%s]Description[white]\n", subheading)
+	if strings.TrimSpace(fields.Description) == "" {
+		str += "[grey]none[white]\n"
+	} else {
+		str += tview.Escape(strings.TrimSpace(fields.Description)) + "\n"
+	}
+
+	str += fmt.Sprintf("\n[%s]Status History[white]\n", subheading)
+	history := detail.StatusHistory()
+	if len(history) == 0 {
+		str += "[grey]none[white]\n"
+	}
+	for _, change := range history {
+		str += fmt.Sprintf(
+			"%s  %s → [yellow]%s[white] [grey]%s[white]\n",
+			formatJiraTime(change.Created),
+			tview.Escape(change.From),
+			tview.Escape(change.To),
+			tview.Escape(change.Author),
+		)
+	}
+
+	str += fmt.Sprintf("\n[%s]Subtasks[white]\n", subheading)
+	if len(fields.Subtasks) == 0 {
+		str += "[grey]none[white]\n"
+	}
+	for _, subtask := range fields.Subtasks {
+		status := ""
+		summary := ""
+		if subtask.IssueFields != nil {
+			summary = subtask.IssueFields.Summary
+			if subtask.IssueFields.IssueStatus != nil {
+				status = subtask.IssueFields.IssueStatus.IName
+			}
+		}
+
+		str += fmt.Sprintf("[green]%s[white] [yellow]%s[white] %s\n", subtask.Key, tview.Escape(status), tview.Escape(summary))
+	}
+
+	return str
+}
+
+func userName(user *User) string {
+	if user == nil {
+		return "[grey]unassigned[white]"
+	}
+
+	if user.DisplayName != "" {
+		return tview.Escape(user.DisplayName)
+	}
+
+	return tview.Escape(user.Name)
+}
+
+// formatJiraTime shortens a Jira timestamp to its date and time. Timestamps that can't
+// be parsed are returned unchanged
+func formatJiraTime(value string) string {
+	t, err := time.Parse(jiraTimeFormat, value)
+	if err != nil {
+		return value
+	}
+
+	return t.Format("2006-01-02 15:04")
+}
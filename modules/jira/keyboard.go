@@ -10,10 +10,18 @@ func (widget *Widget) initializeKeyboardControls() {
 
 	widget.SetKeyboardChar("j", widget.Next, "Select next item")
 	widget.SetKeyboardChar("k", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("l", widget.NextSource, "Select next query")
+	widget.SetKeyboardChar("h", widget.PrevSource, "Select previous query")
 	widget.SetKeyboardChar("o", widget.openItem, "Open item in browser")
+	widget.SetKeyboardChar("t", widget.transitionSelected, "Transition issue")
+	widget.SetKeyboardChar("a", widget.assignSelectedToMe, "Assign issue to me")
+	widget.SetKeyboardChar("c", widget.commentOnSelected, "Comment on issue")
+	widget.SetKeyboardChar("d", widget.showDetail, "Show issue details")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+	widget.SetKeyboardKey(tcell.KeyRight, widget.NextSource, "Select next query")
+	widget.SetKeyboardKey(tcell.KeyLeft, widget.PrevSource, "Select previous query")
 	widget.SetKeyboardKey(tcell.KeyEnter, widget.openItem, "Open item in browser")
 	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
 }
@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+func (widget *Widget) closeModal() {
+	widget.pages.RemovePage("modal")
+	widget.tviewApp.SetFocus(widget.View)
+	widget.Render()
+}
+
+// showForm displays a form in a titled modal, with a button that calls onSave and a
+// button that closes the modal
+func (widget *Widget) showForm(form *tview.Form, title, label string, onSave func()) {
+	saveFn := func() {
+		widget.closeModal()
+		onSave()
+	}
+
+	form.AddButton(label, saveFn)
+	form.AddButton("Cancel", widget.closeModal)
+	form.SetCancelFunc(widget.closeModal)
+
+	frame := view.NewModalFrame(form, view.ModalWidth, view.FormModalHeight)
+	frame.SetTitle(title)
+	widget.pages.AddPage("modal", frame, false, true)
+	widget.tviewApp.SetFocus(frame)
+
+	// Tell the app to force redraw the screen
+	widget.RedrawChan <- true
+}
@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	defaultFocusable = true
-	defaultTitle     = "Jira"
+	defaultFocusable  = true
+	defaultTitle      = "Jira"
+	defaultQueryTitle = "Assigned Issues"
 )
 
 type colors struct {
@@ -29,6 +30,7 @@ type Settings struct {
 	email                   string   `help:"The email address associated with your Jira account (or username for basic auth)."`
 	jql                     string   `help:"Custom JQL to be appended to the search query." values:"See Search Jira like a boss with JQL for details." optional:"true"`
 	projects                []string `help:"An array of projects to get data from"`
+	queries                 []query  `help:"Named JQL queries to switch between. Each query is run as-is, without the project, username and jql filters." optional:"true"`
 	username                string   `help:"Your Jira username. If provided, will filter issues by this username." optional:"true"`
 	verifyServerCertificate bool     `help:"Determines whether or not the server’s certificate chain and host name are verified." values:"true or false" optional:"true"`
 }
@@ -54,10 +56,16 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings.rows.odd = ymlConfig.UString("colors.odd", "white")
 
 	settings.projects = settings.arrayifyProjects(ymlConfig)
+	settings.queries = parseQueries(ymlConfig)
 
 	return &settings
 }
 
+type query struct {
+	title string `help:"Display title for this query"`
+	jql   string `help:"The JQL to search with"`
+}
+
 /* -------------------- Unexported functions -------------------- */
 
 // arrayifyProjects figures out if we're dealing with a single project or an array of projects
@@ -81,3 +89,26 @@ func (settings *Settings) arrayifyProjects(ymlConfig *config.Config) []string {
 
 	return projects
 }
+
+// parseQueries reads the named queries. If there are none, a single query built from the
+// project, username and jql settings is used instead
+func parseQueries(ymlConfig *config.Config) []query {
+	result := []query{}
+
+	for _, item := range ymlConfig.UList("queries") {
+		values, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		q := query{}
+		q.title, _ = values["title"].(string)
+		q.jql, _ = values["jql"].(string)
+
+		if q.title != "" && q.jql != "" {
+			result = append(result, q)
+		}
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
@@ -9,21 +10,37 @@ import (
 )
 
 type Widget struct {
+	view.MultiSourceWidget
 	view.ScrollableWidget
 
-	result   *SearchResult
-	settings *Settings
-	err      error
+	result    *SearchResult
+	resultErr error
+	err       error
+	pages     *tview.Pages
+	settings  *Settings
+	tviewApp  *tview.Application
 }
 
 func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
+		MultiSourceWidget: view.NewMultiSourceWidget(settings.Common, "query", "queries"),
+		ScrollableWidget:  view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		pages:    pages,
 		settings: settings,
+		tviewApp: tviewApp,
+	}
+
+	widget.Sources = []string{defaultQueryTitle}
+	if len(settings.queries) > 0 {
+		widget.Sources = []string{}
+		for _, q := range settings.queries {
+			widget.Sources = append(widget.Sources, q.title)
+		}
 	}
 
 	widget.SetRenderFunction(widget.Render)
+	widget.SetDisplayFunction(widget.Render)
 	widget.initializeKeyboardControls()
 
 	return &widget
@@ -31,22 +48,21 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 
 /* -------------------- Exported Functions -------------------- */
 
+// Refresh runs the current query and redisplays its results
 func (widget *Widget) Refresh() {
-	searchResult, err := widget.IssuesFor(
-		widget.settings.username,
-		widget.settings.projects,
-		widget.settings.jql,
-	)
+	idx := widget.Idx
+	result, err := widget.search(idx)
 
-	if err != nil {
-		widget.err = err
-		widget.result = nil
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
-		widget.result = searchResult
-		widget.SetItemCount(len(searchResult.Issues))
+	// The query may have been switched while it was running
+	if idx != widget.Idx {
+		return
 	}
+
+	widget.result = result
+	widget.resultErr = err
+	widget.err = nil
+	widget.SetItemCount(len(widget.currentIssues()))
+
 	widget.Render()
 }
 
@@ -54,12 +70,182 @@ func (widget *Widget) Render() {
 	widget.Redraw(widget.content)
 }
 
+// NextSource runs the next query and displays its results
+func (widget *Widget) NextSource() {
+	widget.MultiSourceWidget.NextSource()
+	widget.Unselect()
+	widget.Refresh()
+}
+
+// PrevSource runs the previous query and displays its results
+func (widget *Widget) PrevSource() {
+	widget.MultiSourceWidget.PrevSource()
+	widget.Unselect()
+	widget.Refresh()
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) openItem() {
+// search runs the query at idx. Without named queries, the project, username and jql
+// settings are combined into a single query
+func (widget *Widget) search(idx int) (*SearchResult, error) {
+	if len(widget.settings.queries) == 0 {
+		return widget.IssuesFor(
+			widget.settings.username,
+			widget.settings.projects,
+			widget.settings.jql,
+		)
+	}
+
+	return widget.IssuesFor("", nil, widget.settings.queries[idx].jql)
+}
+
+func (widget *Widget) currentResult() (*SearchResult, error) {
+	return widget.result, widget.resultErr
+}
+
+func (widget *Widget) currentIssues() []Issue {
+	result, err := widget.currentResult()
+	if err != nil || result == nil {
+		return nil
+	}
+
+	return result.Issues
+}
+
+// selectedIssue returns the currently-selected issue, if there is one
+func (widget *Widget) selectedIssue() *Issue {
+	issues := widget.currentIssues()
+
 	sel := widget.GetSelected()
-	if sel >= 0 && widget.result != nil && sel < len(widget.result.Issues) {
-		issue := &widget.result.Issues[sel]
+	if sel < 0 || sel >= len(issues) {
+		return nil
+	}
+
+	return &issues[sel]
+}
+
+// performAction runs an action against the selected issue and refreshes the widget. If
+// the action fails, its error is displayed above the issues
+func (widget *Widget) performAction(action func() error) {
+	err := action()
+	widget.Refresh()
+
+	if err != nil {
+		widget.err = err
+		widget.Render()
+	}
+}
+
+// transitionSelected displays a picker of the transitions available to the selected
+// issue and applies the chosen one
+func (widget *Widget) transitionSelected() {
+	issue := widget.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	transitions, err := widget.transitionsFor(issue.Key)
+	if err != nil || len(transitions) == 0 {
+		widget.err = err
+		if err == nil {
+			widget.err = fmt.Errorf("%s has no available transitions", issue.Key)
+		}
+		widget.Render()
+		return
+	}
+
+	options := make([]string, len(transitions))
+	for idx, transition := range transitions {
+		options[idx] = transition.Name
+		if transition.To != nil && transition.To.IName != "" && transition.To.IName != transition.Name {
+			options[idx] += " → " + transition.To.IName
+		}
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddDropDown("Transition:", options, 0, nil)
+
+	key := issue.Key
+	widget.showForm(form, fmt.Sprintf(" Transition %s ", issue.Key), "Apply", func() {
+		idx, _ := form.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+		if idx < 0 {
+			return
+		}
+
+		widget.performAction(func() error {
+			return widget.transitionIssue(key, transitions[idx].ID)
+		})
+	})
+}
+
+func (widget *Widget) assignSelectedToMe() {
+	issue := widget.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	key := issue.Key
+	widget.performAction(func() error {
+		return widget.assignToMe(key)
+	})
+}
+
+func (widget *Widget) commentOnSelected() {
+	issue := widget.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddInputField("Comment:", "", 60, nil, nil)
+
+	key := issue.Key
+	widget.showForm(form, fmt.Sprintf(" Comment on %s ", issue.Key), "Save", func() {
+		body := strings.TrimSpace(form.GetFormItem(0).(*tview.InputField).GetText())
+		if body == "" {
+			return
+		}
+
+		widget.performAction(func() error {
+			return widget.addComment(key, body)
+		})
+	})
+}
+
+// showDetail displays the description, status history and subtasks of the selected
+// issue in a modal
+func (widget *Widget) showDetail() {
+	issue := widget.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	text := ""
+	detail, err := widget.issueDetail(issue.Key)
+	if err != nil {
+		text = err.Error()
+	} else {
+		text = widget.formatDetail(detail)
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("detail")
+		widget.tviewApp.SetFocus(widget.View)
+	}
+
+	modal := view.NewBillboardModal(text, closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s  ", issue.Key))
+
+	widget.pages.AddPage("detail", modal, false, true)
+	widget.tviewApp.SetFocus(modal)
+
+	widget.RedrawChan <- true
+}
+
+func (widget *Widget) openItem() {
+	issue := widget.selectedIssue()
+	if issue != nil {
 		utils.OpenFile(widget.settings.domain + "/browse/" + issue.Key)
 	}
 }
@@ -68,21 +254,32 @@ const MaxIssueTypeLength = 7
 const MaxStatusNameLength = 14
 
 func (widget *Widget) content() (string, string, bool) {
-	if widget.err != nil {
-		return widget.CommonSettings().Title, widget.err.Error(), true
+	title := widget.CommonSettings().Title
+
+	result, err := widget.currentResult()
+	if err != nil {
+		return title, err.Error(), true
 	}
 
-	title := widget.CommonSettings().Title
+	str := ""
+	if len(widget.Sources) > 1 {
+		_, _, width, _ := widget.View.GetRect()
+		str += widget.settings.PaginationMarker(len(widget.Sources), widget.Idx, width) + "\n"
+	}
+
+	if widget.err != nil {
+		str += fmt.Sprintf(" [red]%s[white]\n", tview.Escape(widget.err.Error()))
+	}
 
-	str := fmt.Sprintf(" [%s]Assigned Issues[white]\n", widget.settings.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]%s[white]\n", widget.settings.Colors.Subheading, tview.Escape(widget.CurrentSource()))
 
-	if widget.result == nil || len(widget.result.Issues) == 0 {
-		return title, "No results to display", false
+	if result == nil || len(result.Issues) == 0 {
+		return title, str + "No results to display", false
 	}
 
-	longestIssueTypeLength, longestKeyLength, longestStatusNameLength := getLongestColumnLengths(widget.result.Issues)
+	longestIssueTypeLength, longestKeyLength, longestStatusNameLength := getLongestColumnLengths(result.Issues)
 
-	for idx, issue := range widget.result.Issues {
+	for idx, issue := range result.Issues {
 		row := fmt.Sprintf(
 			`[%s] [%s]%-*s[white] [green]%-*s[white] [yellow]%-*s[white] [%s]%s`,
 			widget.RowColor(idx),
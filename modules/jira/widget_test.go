@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Refresh_currentQueryOnly(t *testing.T) {
+	searched := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searched = append(searched, r.URL.Query().Get("jql"))
+		_, _ = w.Write([]byte(`{"issues": [{"key": "WTF-1", "fields": {"summary": "Crash", "issuetype": {"name": "Bug"}, "status": {"name": "Open"}}}]}`))
+	}))
+	defer server.Close()
+
+	ymlConfig, _ := config.ParseYaml(`
+domain: ` + server.URL + `
+apiKey: secret
+queries:
+  - title: Mine
+    jql: assignee = currentUser()
+  - title: Bugs
+    jql: type = Bug
+`)
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+	settings := NewSettingsFromYAML("jira", ymlConfig, globalConfig)
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+	assert.Equal(t, []string{"Mine", "Bugs"}, widget.Sources)
+	assert.Contains(t, widget.AssignedChars(), "d")
+
+	widget.Refresh()
+	assert.Equal(t, []string{"assignee = currentUser()"}, searched)
+	assert.Len(t, widget.currentIssues(), 1)
+
+	widget.NextSource()
+	assert.Equal(t, []string{"assignee = currentUser()", "type = Bug"}, searched)
+}
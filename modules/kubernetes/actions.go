@@ -0,0 +1,125 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// restartedAtAnnotation is the pod template annotation kubectl uses to trigger a rollout restart
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// restartDeployment triggers a rolling restart of a deployment in the same way
+// that `kubectl rollout restart` does
+func (client *clientInstance) restartDeployment(namespace, name string) error {
+	patch := fmt.Sprintf(
+		`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation,
+		time.Now().Format(time.RFC3339),
+	)
+
+	_, err := client.Client.AppsV1().Deployments(namespace).Patch(
+		context.Background(),
+		name,
+		types.StrategicMergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// scaleDeployment sets the number of desired replicas of a deployment
+func (client *clientInstance) scaleDeployment(namespace, name string, replicas int32) error {
+	deployments := client.Client.AppsV1().Deployments(namespace)
+
+	deployment, err := deployments.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	deployment.Spec.Replicas = &replicas
+
+	_, err = deployments.Update(context.Background(), deployment, metav1.UpdateOptions{})
+	return err
+}
+
+// deletePod deletes a pod
+func (client *clientInstance) deletePod(namespace, name string) error {
+	return client.Client.CoreV1().Pods(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// getEvents returns the most recent events for a resource, newest first
+func (client *clientInstance) getEvents(res resource, count int) ([]corev1.Event, error) {
+	selector := fields.Set{
+		"involvedObject.name": res.Name,
+	}
+	if res.Namespace != "" {
+		selector["involvedObject.namespace"] = res.Namespace
+	}
+
+	events, err := client.Client.CoreV1().Events(res.Namespace).List(
+		context.Background(),
+		metav1.ListOptions{FieldSelector: selector.AsSelector().String()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	items := events.Items
+	sort.Slice(items, func(i, j int) bool {
+		return eventTime(items[i]).After(eventTime(items[j]))
+	})
+
+	if count > 0 && len(items) > count {
+		items = items[:count]
+	}
+
+	return items, nil
+}
+
+// streamLogs follows the logs of the first container of a pod, starting with the last
+// tailLines lines. The stream is closed when the context is cancelled
+func (client *clientInstance) streamLogs(ctx context.Context, namespace, name string, tailLines int64) (io.ReadCloser, string, error) {
+	pod, err := client.Client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	container := ""
+	if len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	opts := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    true,
+		TailLines: &tailLines,
+	}
+
+	stream, err := client.Client.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return stream, container, nil
+}
+
+// eventTime returns the best available timestamp for an event
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
@@ -9,6 +9,10 @@ import (
 
 type clientInstance struct {
 	Client kubernetes.Interface
+
+	// watcher serves object lists from informer caches when watching is enabled.
+	// When it is nil, every list goes to the API server
+	watcher *watcher
 }
 
 // getInstance returns a Kubernetes interface for a clientset
@@ -18,6 +22,20 @@ func (widget *Widget) getInstance() (*clientInstance, error) {
 	widget.clientOnce.Do(func() {
 		widget.client = &clientInstance{}
 		widget.client.Client, err = widget.getKubeClient()
+		if err != nil {
+			return
+		}
+
+		if widget.settings.watch {
+			// If the caches cannot be synced (for example because the user is not allowed
+			// to watch these objects) fall back to listing them on each refresh
+			widget.client.watcher, _ = newWatcher(
+				widget.client.Client,
+				widget.namespaces,
+				widget.objects,
+				widget.queueRefresh,
+			)
+		}
 	})
 
 	return widget.client, err
@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func newTestClient() *clientInstance {
+	clientset := fake.NewClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Reason: "KubeletReady", Status: "True"},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: corev1.PodPending},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "kube-system"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(2)},
+			Status:     appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 1},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "web-1.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Name: "web-1", Namespace: "default"},
+			Reason:         "Scheduled",
+			LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "web-1.2", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Name: "web-1", Namespace: "default"},
+			Reason:         "Pulling",
+			LastTimestamp:  metav1.NewTime(time.Now()),
+		},
+	)
+
+	return &clientInstance{Client: clientset}
+}
+
+func Test_getResources(t *testing.T) {
+	client := newTestClient()
+
+	nodes, err := client.getNodes()
+	assert.NoError(t, err)
+	assert.Equal(t, []resource{{Kind: kindNode, Name: "node-1", Status: "Ready"}}, nodes)
+
+	pods, err := client.getPods([]string{"default"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pods))
+	assert.Equal(t, "web-1", pods[0].Name)
+	assert.Equal(t, "Pending", pods[0].Status)
+
+	allPods, err := client.getPods(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(allPods))
+	assert.Equal(t, "kube-system", allPods[2].Namespace)
+
+	deployments, err := client.getDeployments(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []resource{{Kind: kindDeployment, Namespace: "default", Name: "web", Status: "(1/2)"}}, deployments)
+}
+
+func Test_nodeStatus(t *testing.T) {
+	tests := []struct {
+		status   corev1.ConditionStatus
+		expected string
+	}{
+		{status: "True", expected: "Ready"},
+		{status: "False", expected: "NotReady"},
+		{status: "Unknown", expected: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		node := &corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Reason: "KubeletReady", Status: tt.status},
+				},
+			},
+		}
+
+		assert.Equal(t, tt.expected, nodeStatus(node))
+	}
+}
+
+func Test_resourceRow(t *testing.T) {
+	pod := resource{Kind: kindPod, Namespace: "default", Name: "web-1", Status: "Running"}
+	node := resource{Kind: kindNode, Name: "node-1", Status: "Ready"}
+
+	assert.Equal(t, "web-1                                              Running", pod.row(false))
+	assert.Equal(t, "default              web-1                                              Running", pod.row(true))
+	assert.Equal(t, "node-1                                             Ready", node.row(true))
+}
+
+func Test_getEvents(t *testing.T) {
+	client := newTestClient()
+
+	events, err := client.getEvents(resource{Kind: kindPod, Namespace: "default", Name: "web-1"}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, "Pulling", events[0].Reason)
+
+	events, err = client.getEvents(resource{Kind: kindPod, Namespace: "default", Name: "web-1"}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(events))
+}
+
+func Test_actions(t *testing.T) {
+	client := newTestClient()
+	deployments := client.Client.AppsV1().Deployments("default")
+
+	assert.NoError(t, client.scaleDeployment("default", "web", 5))
+	deployment, err := deployments.Get(context.Background(), "web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), *deployment.Spec.Replicas)
+
+	assert.NoError(t, client.restartDeployment("default", "web"))
+	deployment, err = deployments.Get(context.Background(), "web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, deployment.Spec.Template.Annotations[restartedAtAnnotation])
+
+	assert.NoError(t, client.deletePod("default", "web-1"))
+	pods, err := client.getPods([]string{"default"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pods))
+
+	assert.Error(t, client.deletePod("default", "missing"))
+}
+
+func Test_watcher(t *testing.T) {
+	client := newTestClient()
+
+	changed := make(chan struct{}, 10)
+	w, err := newWatcher(client.Client, []string{"default"}, []string{"nodes", "pods", "deployments"}, func() {
+		changed <- struct{}{}
+	})
+	assert.NoError(t, err)
+	defer w.stop()
+
+	client.watcher = w
+
+	pods, err := client.getPods([]string{"default"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(pods))
+
+	nodes, err := client.getNodes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(nodes))
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a change notification")
+	}
+}
@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// describe returns a human-readable description of a resource, followed by its recent events
+func (client *clientInstance) describe(res resource, eventCount int) (string, error) {
+	var str string
+	var err error
+
+	switch res.Kind {
+	case kindNode:
+		str, err = client.describeNode(res.Name)
+	case kindDeployment:
+		str, err = client.describeDeployment(res.Namespace, res.Name)
+	case kindPod:
+		str, err = client.describePod(res.Namespace, res.Name)
+	default:
+		return "", fmt.Errorf("unknown resource kind: %s", res.Kind)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	events, err := client.getEvents(res, eventCount)
+	if err != nil {
+		return "", err
+	}
+
+	str += "\n [green]Events[white]\n"
+	if len(events) == 0 {
+		str += " [gray]none[white]\n"
+	}
+	for _, event := range events {
+		color := "white"
+		if event.Type == "Warning" {
+			color = "yellow"
+		}
+
+		str += fmt.Sprintf(
+			" [%s]%-8s %-20s %s[white]\n",
+			color,
+			age(eventTime(event)),
+			event.Reason,
+			tview.Escape(event.Message),
+		)
+	}
+
+	return str, nil
+}
+
+func (client *clientInstance) describeNode(name string) (string, error) {
+	node, err := client.Client.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	addresses := []string{}
+	for _, address := range node.Status.Addresses {
+		addresses = append(addresses, address.Address)
+	}
+
+	str := detailLine("Name", node.Name)
+	str += detailLine("Status", nodeStatus(node))
+	str += detailLine("Age", age(node.CreationTimestamp.Time))
+	str += detailLine("Addresses", strings.Join(addresses, ", "))
+	str += detailLine("Kubelet", node.Status.NodeInfo.KubeletVersion)
+	str += detailLine("OS", node.Status.NodeInfo.OSImage)
+	str += detailLine("CPU", node.Status.Capacity.Cpu().String())
+	str += detailLine("Memory", node.Status.Capacity.Memory().String())
+	str += detailLine("Pods", node.Status.Capacity.Pods().String())
+
+	return str, nil
+}
+
+func (client *clientInstance) describeDeployment(namespace, name string) (string, error) {
+	deployment, err := client.Client.AppsV1().Deployments(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	var desired int32
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
+	str := detailLine("Name", deployment.Name)
+	str += detailLine("Namespace", deployment.Namespace)
+	str += detailLine("Age", age(deployment.CreationTimestamp.Time))
+	str += detailLine(
+		"Replicas",
+		fmt.Sprintf(
+			"%d desired, %d updated, %d ready, %d available",
+			desired,
+			deployment.Status.UpdatedReplicas,
+			deployment.Status.ReadyReplicas,
+			deployment.Status.AvailableReplicas,
+		),
+	)
+	str += detailLine("Strategy", string(deployment.Spec.Strategy.Type))
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		str += detailLine("Image", container.Image)
+	}
+
+	return str, nil
+}
+
+func (client *clientInstance) describePod(namespace, name string) (string, error) {
+	pod, err := client.Client.CoreV1().Pods(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	str := detailLine("Name", pod.Name)
+	str += detailLine("Namespace", pod.Namespace)
+	str += detailLine("Status", string(pod.Status.Phase))
+	str += detailLine("Age", age(pod.CreationTimestamp.Time))
+	str += detailLine("Node", pod.Spec.NodeName)
+	str += detailLine("IP", pod.Status.PodIP)
+
+	str += "\n [green]Containers[white]\n"
+	for _, status := range pod.Status.ContainerStatuses {
+		state := "waiting"
+		switch {
+		case status.State.Running != nil:
+			state = "running"
+		case status.State.Terminated != nil:
+			state = "terminated: " + status.State.Terminated.Reason
+		case status.State.Waiting != nil && status.State.Waiting.Reason != "":
+			state = "waiting: " + status.State.Waiting.Reason
+		}
+
+		str += fmt.Sprintf(
+			" %-30s %-30s restarts: %d\n",
+			status.Name,
+			state,
+			status.RestartCount,
+		)
+	}
+
+	return str, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func detailLine(label, value string) string {
+	return fmt.Sprintf(" [gray]%-10s[white] %s\n", label+":", tview.Escape(value))
+}
+
+// age returns a short, kubectl-style representation of how long ago the given time was
+func age(since time.Time) string {
+	if since.IsZero() {
+		return "-"
+	}
+
+	duration := time.Since(since)
+
+	switch {
+	case duration < time.Minute:
+		return fmt.Sprintf("%ds", int(duration.Seconds()))
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm", int(duration.Minutes()))
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(duration.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(duration.Hours()/24))
+	}
+}
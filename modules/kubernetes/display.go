@@ -0,0 +1,46 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.itemsMutex.Lock()
+	defer widget.itemsMutex.Unlock()
+
+	title := widget.generateTitle()
+
+	if widget.err != nil {
+		return title, fmt.Sprintf("[red] %s [white]\n", widget.err.Error()), true
+	}
+
+	showNamespace := len(widget.namespaces) != 1
+
+	var content string
+	idx := 0
+
+	for _, sec := range widget.sections {
+		content += fmt.Sprintf("[%s]%s[white]\n", widget.settings.Colors.Subheading, sec.title)
+
+		for _, res := range sec.resources {
+			row := res.row(showNamespace)
+			content += utils.HighlightableHelper(
+				widget.View,
+				fmt.Sprintf("[%s]%s", widget.RowColor(idx), row),
+				idx,
+				len(row),
+			)
+			idx++
+		}
+
+		content += "\n"
+	}
+
+	return title, content, false
+}
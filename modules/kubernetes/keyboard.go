@@ -0,0 +1,20 @@
+package kubernetes
+
+import "github.com/gdamore/tcell/v2"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next item")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("l", widget.showLogs, "Tail logs of the selected pod")
+	widget.SetKeyboardChar("d", widget.deleteSelectedPod, "Delete the selected pod")
+	widget.SetKeyboardChar("R", widget.restartSelectedDeployment, "Rollout restart the selected deployment")
+	widget.SetKeyboardChar("s", widget.scaleSelectedDeployment, "Scale the selected deployment")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.showDetails, "Show details and events of the selected item")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
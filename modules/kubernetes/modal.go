@@ -0,0 +1,158 @@
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+)
+
+/* -------------------- Details -------------------- */
+
+// showDetails shows a modal window with the details and recent events of the selected resource
+func (widget *Widget) showDetails() {
+	res := widget.selectedResource()
+	if res == nil || widget.client == nil {
+		return
+	}
+
+	text, err := widget.client.describe(*res, widget.settings.eventCount)
+	if err != nil {
+		text = fmt.Sprintf("[red]%s[white]\n", tview.Escape(err.Error()))
+	}
+	text += "\n" + utils.CenterText("Esc to close", 80)
+
+	closeFunc := func() {
+		widget.pages.RemovePage("details")
+		widget.tviewApp.SetFocus(widget.View)
+	}
+
+	modal := view.NewBillboardModal(text, closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s/%s  ", res.Kind, res.Name))
+
+	widget.pages.AddPage("details", modal, false, true)
+	widget.tviewApp.SetFocus(modal)
+
+	widget.RedrawChan <- true
+}
+
+/* -------------------- Logs -------------------- */
+
+// showLogs tails the logs of the selected pod in a full-screen view until it is closed
+func (widget *Widget) showLogs() {
+	res := widget.selectedResource()
+	if res == nil || res.Kind != kindPod || widget.client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	textView := tview.NewTextView()
+	textView.SetDynamicColors(false)
+	textView.SetScrollable(true)
+	textView.SetWrap(true)
+	textView.SetBorder(true)
+	textView.SetTitle(fmt.Sprintf(" Logs: %s (Esc to close) ", res.Name))
+	textView.SetChangedFunc(func() {
+		textView.ScrollToEnd()
+		widget.tviewApp.Draw()
+	})
+
+	textView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			cancel()
+			widget.pages.RemovePage("logs")
+			widget.tviewApp.SetFocus(widget.View)
+			return nil
+		}
+		return event
+	})
+
+	widget.pages.AddPage("logs", textView, true, true)
+	widget.tviewApp.SetFocus(textView)
+
+	go func() {
+		stream, container, err := widget.client.streamLogs(ctx, res.Namespace, res.Name, int64(widget.settings.logLines))
+		if err != nil {
+			_, _ = fmt.Fprintf(textView, "%s\n", err.Error())
+			return
+		}
+		defer func() { _ = stream.Close() }()
+
+		textView.SetTitle(fmt.Sprintf(" Logs: %s/%s (Esc to close) ", res.Name, container))
+
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			_, _ = fmt.Fprintln(textView, scanner.Text())
+		}
+	}()
+}
+
+/* -------------------- Actions -------------------- */
+
+// deleteSelectedPod deletes the selected pod after asking for confirmation
+func (widget *Widget) deleteSelectedPod() {
+	res := widget.selectedResource()
+	if res == nil || res.Kind != kindPod || widget.client == nil {
+		return
+	}
+
+	widget.ShowConfirm(fmt.Sprintf("Delete pod %s/%s?", res.Namespace, res.Name), func() {
+		widget.performAction(func() error {
+			return widget.client.deletePod(res.Namespace, res.Name)
+		})
+	})
+}
+
+// restartSelectedDeployment restarts the selected deployment after asking for confirmation
+func (widget *Widget) restartSelectedDeployment() {
+	res := widget.selectedResource()
+	if res == nil || res.Kind != kindDeployment || widget.client == nil {
+		return
+	}
+
+	widget.ShowConfirm(fmt.Sprintf("Restart deployment %s/%s?", res.Namespace, res.Name), func() {
+		widget.performAction(func() error {
+			return widget.client.restartDeployment(res.Namespace, res.Name)
+		})
+	})
+}
+
+// scaleSelectedDeployment prompts for a replica count and scales the selected deployment to it
+func (widget *Widget) scaleSelectedDeployment() {
+	res := widget.selectedResource()
+	if res == nil || res.Kind != kindDeployment || widget.client == nil {
+		return
+	}
+
+	widget.ShowInputForm("Replicas:", func(text string) {
+		replicas, err := strconv.ParseInt(strings.TrimSpace(text), 10, 32)
+		if err != nil || replicas < 0 {
+			return
+		}
+
+		widget.ShowConfirm(fmt.Sprintf("Scale deployment %s/%s to %d replicas?", res.Namespace, res.Name, replicas), func() {
+			widget.performAction(func() error {
+				return widget.client.scaleDeployment(res.Namespace, res.Name, int32(replicas))
+			})
+		})
+	})
+}
+
+// performAction runs an action against the cluster and refreshes the widget. If the
+// action fails, the error is displayed in place of the resource list
+func (widget *Widget) performAction(action func() error) {
+	if err := action(); err != nil {
+		widget.setSections(nil, err)
+		widget.display()
+		return
+	}
+
+	widget.Refresh()
+}
@@ -0,0 +1,196 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	kindNode       = "node"
+	kindDeployment = "deployment"
+	kindPod        = "pod"
+)
+
+// resource is a single node, deployment or pod as displayed in the widget
+type resource struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Status    string
+}
+
+// row returns the text representation of the resource. The namespace is only
+// included when more than one namespace is being displayed
+func (res resource) row(showNamespace bool) string {
+	if res.Kind == kindNode || !showNamespace {
+		return fmt.Sprintf("%-50s %s", res.Name, res.Status)
+	}
+
+	return fmt.Sprintf("%-20s %-50s %s", res.Namespace, res.Name, res.Status)
+}
+
+// getPods returns a slice of pod resources
+func (client *clientInstance) getPods(namespaces []string) ([]resource, error) {
+	var podList []resource
+
+	for _, namespace := range namespacesOrAll(namespaces) {
+		pods, err := client.listPods(namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range pods {
+			podList = append(podList, resource{
+				Kind:      kindPod,
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+				Status:    string(pod.Status.Phase),
+			})
+		}
+	}
+
+	sortResources(podList)
+
+	return podList, nil
+}
+
+// getDeployments returns a slice of deployment resources
+func (client *clientInstance) getDeployments(namespaces []string) ([]resource, error) {
+	var deploymentList []resource
+
+	for _, namespace := range namespacesOrAll(namespaces) {
+		deployments, err := client.listDeployments(namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployment := range deployments {
+			deploymentList = append(deploymentList, resource{
+				Kind:      kindDeployment,
+				Namespace: deployment.Namespace,
+				Name:      deployment.Name,
+				Status:    fmt.Sprintf("(%d/%d)", deployment.Status.ReadyReplicas, deployment.Status.Replicas),
+			})
+		}
+	}
+
+	sortResources(deploymentList)
+
+	return deploymentList, nil
+}
+
+// getNodes returns a slice of node resources
+func (client *clientInstance) getNodes() ([]resource, error) {
+	var nodeList []resource
+
+	nodes, err := client.listNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		nodeList = append(nodeList, resource{
+			Kind:   kindNode,
+			Name:   node.Name,
+			Status: nodeStatus(node),
+		})
+	}
+
+	sortResources(nodeList)
+
+	return nodeList, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (client *clientInstance) listPods(namespace string) ([]*corev1.Pod, error) {
+	if client.watcher != nil {
+		lister := client.watcher.factories[namespace].Core().V1().Pods().Lister()
+		return lister.Pods(namespace).List(labels.Everything())
+	}
+
+	pods, err := client.Client.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*corev1.Pod, len(pods.Items))
+	for i := range pods.Items {
+		result[i] = &pods.Items[i]
+	}
+
+	return result, nil
+}
+
+func (client *clientInstance) listDeployments(namespace string) ([]*appsv1.Deployment, error) {
+	if client.watcher != nil {
+		lister := client.watcher.factories[namespace].Apps().V1().Deployments().Lister()
+		return lister.Deployments(namespace).List(labels.Everything())
+	}
+
+	deployments, err := client.Client.AppsV1().Deployments(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*appsv1.Deployment, len(deployments.Items))
+	for i := range deployments.Items {
+		result[i] = &deployments.Items[i]
+	}
+
+	return result, nil
+}
+
+func (client *clientInstance) listNodes() ([]*corev1.Node, error) {
+	if client.watcher != nil {
+		return client.watcher.cluster.Core().V1().Nodes().Lister().List(labels.Everything())
+	}
+
+	nodes, err := client.Client.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*corev1.Node, len(nodes.Items))
+	for i := range nodes.Items {
+		result[i] = &nodes.Items[i]
+	}
+
+	return result, nil
+}
+
+func nodeStatus(node *corev1.Node) string {
+	var status string
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Reason == "KubeletReady" {
+			switch {
+			case condition.Status == "True":
+				status = "Ready"
+			case condition.Status == "False":
+				status = "NotReady"
+			default:
+				status = "Unknown"
+			}
+		}
+	}
+
+	return status
+}
+
+// sortResources orders resources by namespace and then by name, since informer
+// caches do not return them in a stable order
+func sortResources(resources []resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Namespace != resources[j].Namespace {
+			return resources[i].Namespace < resources[j].Namespace
+		}
+		return resources[i].Name < resources[j].Name
+	})
+}
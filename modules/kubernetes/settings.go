@@ -7,7 +7,7 @@ import (
 )
 
 const (
-	defaultFocusable = false
+	defaultFocusable = true
 	defaultTitle     = "Kubernetes"
 )
 
@@ -19,6 +19,9 @@ type Settings struct {
 	kubeconfig string   `help:"Location of a kubeconfig file."`
 	namespaces []string `help:"List of namespaces to watch. If blank, defaults to all namespaces."`
 	context    string   `help:"Kubernetes context to use. If blank, uses default context"`
+	watch      bool     `help:"Whether to watch the Kubernetes API for changes instead of polling it on every refresh." optional:"true"`
+	logLines   int      `help:"The number of existing log lines to show when tailing pod logs." optional:"true"`
+	eventCount int      `help:"The number of recent events to show in the details of a resource." optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -31,6 +34,9 @@ func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig
 		kubeconfig: moduleConfig.UString("kubeconfig"),
 		namespaces: utils.ToStrs(moduleConfig.UList("namespaces")),
 		context:    moduleConfig.UString("context"),
+		watch:      moduleConfig.UBool("watch", true),
+		logLines:   moduleConfig.UInt("logLines", 100),
+		eventCount: moduleConfig.UInt("eventCount", 10),
 	}
 
 	return &settings
@@ -0,0 +1,133 @@
+package kubernetes
+
+import (
+	"errors"
+	"time"
+
+	"github.com/wtfutil/wtf/utils"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// watchSyncTimeout is how long to wait for the informer caches to fill before giving up
+	watchSyncTimeout = 30 * time.Second
+
+	// watchRedrawInterval limits how often a busy cluster can cause the widget to redraw
+	watchRedrawInterval = time.Second
+)
+
+// watcher keeps local caches of the watched objects up to date using shared informers
+type watcher struct {
+	// factories holds one informer factory per watched namespace, keyed by namespace.
+	// The empty namespace means all namespaces
+	factories map[string]informers.SharedInformerFactory
+
+	// cluster is the factory that serves cluster-scoped objects such as nodes
+	cluster informers.SharedInformerFactory
+
+	stopCh chan struct{}
+}
+
+// newWatcher starts informers for the given objects in the given namespaces and waits for
+// their caches to sync. After that, onChange is called whenever any of the objects change
+func newWatcher(client kubernetes.Interface, namespaces []string, objects []string, onChange func()) (*watcher, error) {
+	w := &watcher{
+		factories: make(map[string]informers.SharedInformerFactory),
+		stopCh:    make(chan struct{}),
+	}
+
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	}
+
+	synced := []cache.InformerSynced{}
+	addInformer := func(informer cache.SharedIndexInformer) error {
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return err
+		}
+		synced = append(synced, informer.HasSynced)
+		return nil
+	}
+
+	for _, namespace := range namespacesOrAll(namespaces) {
+		factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace(namespace))
+
+		if w.cluster == nil {
+			w.cluster = factory
+
+			if utils.Includes(objects, "nodes") {
+				if err := addInformer(factory.Core().V1().Nodes().Informer()); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if utils.Includes(objects, "deployments") {
+			if err := addInformer(factory.Apps().V1().Deployments().Informer()); err != nil {
+				return nil, err
+			}
+		}
+
+		if utils.Includes(objects, "pods") {
+			if err := addInformer(factory.Core().V1().Pods().Informer()); err != nil {
+				return nil, err
+			}
+		}
+
+		w.factories[namespace] = factory
+	}
+
+	for _, factory := range w.factories {
+		factory.Start(w.stopCh)
+	}
+
+	timeout := make(chan struct{})
+	timer := time.AfterFunc(watchSyncTimeout, func() { close(timeout) })
+	defer timer.Stop()
+
+	if !cache.WaitForCacheSync(timeout, synced...) {
+		w.stop()
+		return nil, errors.New("timed out waiting for the watch caches to sync")
+	}
+
+	go func() {
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-changes:
+				onChange()
+				time.Sleep(watchRedrawInterval)
+			}
+		}
+	}()
+
+	return w, nil
+}
+
+// stop shuts down all of the informers
+func (w *watcher) stop() {
+	close(w.stopCh)
+}
+
+// namespacesOrAll returns the given namespaces, or the single empty namespace that
+// represents all namespaces if none are given
+func namespacesOrAll(namespaces []string) []string {
+	if len(namespaces) == 0 {
+		return []string{""}
+	}
+
+	return namespaces
+}
@@ -1,36 +1,47 @@
 package kubernetes
 
 import (
-	"context"
 	"fmt"
 	"sync"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
-
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// section is a titled group of resources of the same kind
+type section struct {
+	title     string
+	resources []resource
+}
+
 // Widget contains all the config for the widget
 type Widget struct {
-	view.TextWidget
+	view.ScrollableWidget
 
 	client     *clientInstance
 	clientOnce sync.Once
 
+	// items is every selectable resource, in display order
+	items      []resource
+	sections   []section
+	err        error
+	itemsMutex sync.Mutex
+
 	objects    []string
 	title      string
 	kubeconfig string
 	namespaces []string
 	context    string
+	pages      *tview.Pages
 	settings   *Settings
+	tviewApp   *tview.Application
 }
 
 // NewWidget creates a new instance of the widget
-func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Settings) *Widget {
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(tviewApp, redrawChan, nil, settings.Common),
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
 		objects:    settings.objects,
 		title:      settings.title,
@@ -38,68 +49,101 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Sett
 		namespaces: settings.namespaces,
 		settings:   settings,
 		context:    settings.context,
+		pages:      pages,
+		tviewApp:   tviewApp,
 	}
 
-	widget.View.SetWrap(true)
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
 
 	return &widget
 }
 
-// Refresh executes the command and updates the view with the results
+// Refresh fetches the configured objects and updates the view with the results.
+// When watching is enabled the objects are read from the local watch caches
 func (widget *Widget) Refresh() {
-	title := widget.generateTitle()
 	client, err := widget.getInstance()
-
 	if err != nil {
-		widget.Redraw(func() (string, string, bool) { return title, err.Error(), true })
+		widget.setSections(nil, err)
+		widget.display()
 		return
 	}
 
-	var content string
+	sections, err := widget.fetchSections(client)
+	widget.setSections(sections, err)
+	widget.display()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// queueRefresh refreshes the widget from the app's event loop. The watcher calls it
+// from its own goroutine, which must not update the view directly
+func (widget *Widget) queueRefresh() {
+	widget.tviewApp.QueueUpdate(widget.Refresh)
+}
+
+// fetchSections retrieves the resources for each of the configured object types
+func (widget *Widget) fetchSections(client *clientInstance) ([]section, error) {
+	sections := []section{}
 
 	if utils.Includes(widget.objects, "nodes") {
-		nodeList, nodeError := client.getNodes()
-		if nodeError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting node data [white]\n", true })
-			return
-		}
-		content += fmt.Sprintf("[%s]Nodes[white]\n", widget.settings.Colors.Subheading)
-		for _, node := range nodeList {
-			content += fmt.Sprintf("%s\n", node)
+		nodeList, err := client.getNodes()
+		if err != nil {
+			return nil, fmt.Errorf("error getting node data: %w", err)
 		}
-		content += "\n"
+		sections = append(sections, section{title: "Nodes", resources: nodeList})
 	}
 
 	if utils.Includes(widget.objects, "deployments") {
-		deploymentList, deploymentError := client.getDeployments(widget.namespaces)
-		if deploymentError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting deployment data [white]\n", true })
-			return
-		}
-		content += fmt.Sprintf("[%s]Deployments[white]\n", widget.settings.Colors.Subheading)
-		for _, deployment := range deploymentList {
-			content += fmt.Sprintf("%s\n", deployment)
+		deploymentList, err := client.getDeployments(widget.namespaces)
+		if err != nil {
+			return nil, fmt.Errorf("error getting deployment data: %w", err)
 		}
-		content += "\n"
+		sections = append(sections, section{title: "Deployments", resources: deploymentList})
 	}
 
 	if utils.Includes(widget.objects, "pods") {
-		podList, podError := client.getPods(widget.namespaces)
-		if podError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting pod data [white]\n", false })
-			return
-		}
-		content += fmt.Sprintf("[%s]Pods[white]\n", widget.settings.Colors.Subheading)
-		for _, pod := range podList {
-			content += fmt.Sprintf("%s\n", pod)
+		podList, err := client.getPods(widget.namespaces)
+		if err != nil {
+			return nil, fmt.Errorf("error getting pod data: %w", err)
 		}
-		content += "\n"
+		sections = append(sections, section{title: "Pods", resources: podList})
 	}
 
-	widget.Redraw(func() (string, string, bool) { return title, content, false })
+	return sections, nil
 }
 
-/* -------------------- Unexported Functions -------------------- */
+func (widget *Widget) setSections(sections []section, err error) {
+	widget.itemsMutex.Lock()
+	defer widget.itemsMutex.Unlock()
+
+	widget.sections = sections
+	widget.err = err
+
+	widget.items = []resource{}
+	for _, sec := range sections {
+		widget.items = append(widget.items, sec.resources...)
+	}
+
+	widget.SetItemCount(len(widget.items))
+	if widget.Selected >= len(widget.items) {
+		widget.Selected = len(widget.items) - 1
+	}
+}
+
+// selectedResource returns the currently-selected resource, if there is one
+func (widget *Widget) selectedResource() *resource {
+	widget.itemsMutex.Lock()
+	defer widget.itemsMutex.Unlock()
+
+	if widget.Selected < 0 || widget.Selected >= len(widget.items) {
+		return nil
+	}
+
+	res := widget.items[widget.Selected]
+	return &res
+}
 
 // generateTitle generates a title for the widget
 func (widget *Widget) generateTitle() string {
@@ -119,102 +163,3 @@ func (widget *Widget) generateTitle() string {
 	}
 	return title
 }
-
-// getPods returns a slice of pod strings
-func (client *clientInstance) getPods(namespaces []string) ([]string, error) {
-	var podList []string
-	if len(namespaces) != 0 {
-		for _, namespace := range namespaces {
-			pods, err := client.Client.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{})
-			if err != nil {
-				return nil, err
-			}
-
-			for _, pod := range pods.Items {
-				var podString string
-				status := pod.Status.Phase
-				name := pod.Name
-				if len(namespaces) == 1 {
-					podString = fmt.Sprintf("%-50s %s", name, status)
-				} else {
-					podString = fmt.Sprintf("%-20s %-50s %s", namespace, name, status)
-				}
-				podList = append(podList, podString)
-			}
-		}
-	} else {
-		pods, err := client.Client.CoreV1().Pods("").List(context.Background(), metav1.ListOptions{})
-		if err != nil {
-			return nil, err
-		}
-		for _, pod := range pods.Items {
-			podString := fmt.Sprintf("%-20s %-50s %s", pod.Namespace, pod.Name, pod.Status.Phase)
-			podList = append(podList, podString)
-		}
-	}
-
-	return podList, nil
-}
-
-// get Deployments returns a string slice of pod strings
-func (client *clientInstance) getDeployments(namespaces []string) ([]string, error) {
-	var deploymentList []string
-	if len(namespaces) != 0 {
-		for _, namespace := range namespaces {
-			deployments, err := client.Client.AppsV1().Deployments(namespace).List(context.Background(), metav1.ListOptions{})
-			if err != nil {
-				return nil, err
-			}
-
-			for _, deployment := range deployments.Items {
-				var deployString string
-				if len(namespaces) == 1 {
-					deployString = fmt.Sprintf("%-50s (%d/%d)", deployment.Name, deployment.Status.ReadyReplicas, deployment.Status.Replicas)
-				} else {
-					deployString = fmt.Sprintf("%-20s %-50s (%d/%d)", deployment.Namespace, deployment.Name, deployment.Status.ReadyReplicas, deployment.Status.Replicas)
-				}
-				deploymentList = append(deploymentList, deployString)
-			}
-		}
-	} else {
-		deployments, err := client.Client.AppsV1().Deployments("").List(context.Background(), metav1.ListOptions{})
-		if err != nil {
-			return nil, err
-		}
-
-		for _, deployment := range deployments.Items {
-			deployString := fmt.Sprintf("%-20s %-50s (%d/%d)", deployment.Namespace, deployment.Name, deployment.Status.ReadyReplicas, deployment.Status.Replicas)
-			deploymentList = append(deploymentList, deployString)
-		}
-	}
-	return deploymentList, nil
-}
-
-// getNodes returns a string slice of nodes
-func (client *clientInstance) getNodes() ([]string, error) {
-	var nodeList []string
-
-	nodes, err := client.Client.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
-	if err != nil {
-		return nil, err
-	}
-
-	for _, node := range nodes.Items {
-		var nodeStatus string
-		for _, condition := range node.Status.Conditions {
-			if condition.Reason == "KubeletReady" {
-				switch {
-				case condition.Status == "True":
-					nodeStatus = "Ready"
-				case condition.Reason == "False":
-					nodeStatus = "NotReady"
-				default:
-					nodeStatus = "Unknown"
-				}
-			}
-		}
-		nodeString := fmt.Sprintf("%-50s %s", node.Name, nodeStatus)
-		nodeList = append(nodeList, nodeString)
-	}
-	return nodeList, nil
-}
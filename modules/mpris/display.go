@@ -0,0 +1,121 @@
+package mpris
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// progressWidth is how many characters the progress bar of the track is wide
+const progressWidth = 20
+
+func (widget *Widget) content() string {
+	if widget.err != nil {
+		return tview.Escape(widget.err.Error())
+	}
+
+	if widget.state == nil || widget.player == nil {
+		return ""
+	}
+
+	width := widget.CommonSettings().Width
+	label, text := widget.settings.label, widget.settings.text
+	state := widget.state
+
+	str := utils.CenterText(fmt.Sprintf("[%s]%s [%s](%s)\n", label, playbackStatus(state.status), text, tview.Escape(widget.player.name)), width)
+	str += utils.CenterText(fmt.Sprintf("[%s]Title:[%s] %s\n", label, text, tview.Escape(state.track.title)), width)
+
+	if len(state.track.artists) > 0 {
+		str += utils.CenterText(fmt.Sprintf("[%s]Artist:[%s] %s\n", label, text, tview.Escape(strings.Join(state.track.artists, ", "))), width)
+	}
+
+	if state.track.album != "" {
+		str += utils.CenterText(fmt.Sprintf("[%s]Album:[%s] %s\n", label, text, tview.Escape(state.track.album)), width)
+	}
+
+	str += utils.CenterText(progress(state.position, state.track.length, progressWidth)+"\n", width)
+
+	if modes := widget.modes(); modes != "" {
+		str += utils.CenterText(modes+"\n", width)
+	}
+
+	if widget.actionErr != nil {
+		str += fmt.Sprintf("\n[red]%s[%s]\n", tview.Escape(widget.actionErr.Error()), text)
+	}
+
+	return str
+}
+
+// modes displays the shuffle, repeat and volume of the player, leaving out those that
+// it doesn't support
+func (widget *Widget) modes() string {
+	label, text := widget.settings.label, widget.settings.text
+	state := widget.state
+
+	modes := []string{}
+
+	if state.shuffle != nil {
+		shuffle := "off"
+		if *state.shuffle {
+			shuffle = "on"
+		}
+		modes = append(modes, fmt.Sprintf("[%s]Shuffle:[%s] %s", label, text, shuffle))
+	}
+
+	if state.loopStatus != "" {
+		repeat := map[string]string{"None": "off", "Playlist": "all", "Track": "one"}[state.loopStatus]
+		if repeat == "" {
+			repeat = strings.ToLower(state.loopStatus)
+		}
+		modes = append(modes, fmt.Sprintf("[%s]Repeat:[%s] %s", label, text, repeat))
+	}
+
+	if state.volume != nil {
+		modes = append(modes, fmt.Sprintf("[%s]Volume:[%s] %.0f%%", label, text, *state.volume*100))
+	}
+
+	return strings.Join(modes, "  ")
+}
+
+// playbackStatus describes the PlaybackStatus of a player
+func playbackStatus(status string) string {
+	switch status {
+	case "Playing", "Paused", "Stopped":
+		return "Now " + status
+	default:
+		return "Idle"
+	}
+}
+
+// progress displays how far into a track the player is, as a bar between the position
+// and the length. Tracks without a length, such as streams, only show the position
+func progress(position, length time.Duration, width int) string {
+	if length <= 0 {
+		return formatDuration(position)
+	}
+
+	position = min(max(position, 0), length)
+	filled := int(float64(width) * float64(position) / float64(length))
+
+	return fmt.Sprintf(
+		"%s %s%s %s",
+		formatDuration(position),
+		strings.Repeat("━", filled),
+		strings.Repeat("─", width-filled),
+		formatDuration(length),
+	)
+}
+
+// formatDuration displays a duration as m:ss, or h:mm:ss when it's an hour or longer
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,22 @@
+package mpris
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_progress(t *testing.T) {
+	assert.Equal(t, "1:00 ━━━━━─────────────── 4:00", progress(time.Minute, 4*time.Minute, 20))
+	assert.Equal(t, "0:00 ──────────────────── 4:00", progress(-time.Second, 4*time.Minute, 20))
+	assert.Equal(t, "4:00 ━━━━━━━━━━━━━━━━━━━━ 4:00", progress(5*time.Minute, 4*time.Minute, 20))
+	assert.Equal(t, "12:03", progress(12*time.Minute+3*time.Second, 0, 20))
+}
+
+func Test_formatDuration(t *testing.T) {
+	assert.Equal(t, "0:00", formatDuration(0))
+	assert.Equal(t, "3:45", formatDuration(225*time.Second))
+	assert.Equal(t, "0:02", formatDuration(1600*time.Millisecond))
+	assert.Equal(t, "1:02:03", formatDuration(time.Hour+2*time.Minute+3*time.Second))
+}
@@ -0,0 +1,110 @@
+package mpris
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar(" ", widget.playPause, "Play/pause")
+	widget.SetKeyboardChar("h", widget.previous, "Previous track")
+	widget.SetKeyboardChar("l", widget.next, "Next track")
+	widget.SetKeyboardChar("+", widget.volumeUp, "Turn the volume up")
+	widget.SetKeyboardChar("=", widget.volumeUp, "Turn the volume up")
+	widget.SetKeyboardChar("-", widget.volumeDown, "Turn the volume down")
+	widget.SetKeyboardChar("s", widget.toggleShuffle, "Toggle shuffle")
+	widget.SetKeyboardChar("R", widget.cycleRepeat, "Switch between repeating nothing, the playlist and the track")
+	widget.SetKeyboardChar("p", widget.nextPlayer, "Switch to the next player")
+
+	widget.SetKeyboardKey(tcell.KeyLeft, widget.seekBackward, "Seek backward")
+	widget.SetKeyboardKey(tcell.KeyRight, widget.seekForward, "Seek forward")
+}
+
+func (widget *Widget) playPause() {
+	widget.act(func(p *player) error { return p.playPause() })
+}
+
+func (widget *Widget) previous() {
+	widget.act(func(p *player) error { return p.previous() })
+}
+
+func (widget *Widget) next() {
+	widget.act(func(p *player) error { return p.next() })
+}
+
+func (widget *Widget) seekBackward() {
+	widget.act(func(p *player) error { return p.seek(-widget.settings.seekStep) })
+}
+
+func (widget *Widget) seekForward() {
+	widget.act(func(p *player) error { return p.seek(widget.settings.seekStep) })
+}
+
+func (widget *Widget) volumeUp() {
+	widget.changeVolume(widget.settings.volumeStep)
+}
+
+func (widget *Widget) volumeDown() {
+	widget.changeVolume(-widget.settings.volumeStep)
+}
+
+func (widget *Widget) changeVolume(step float64) {
+	widget.act(func(p *player) error {
+		if widget.state == nil || widget.state.volume == nil {
+			return fmt.Errorf("%s doesn't support changing the volume", p.name)
+		}
+
+		return p.setVolume(*widget.state.volume + step)
+	})
+}
+
+func (widget *Widget) toggleShuffle() {
+	widget.act(func(p *player) error {
+		if widget.state == nil || widget.state.shuffle == nil {
+			return fmt.Errorf("%s doesn't support shuffle", p.name)
+		}
+
+		return p.setShuffle(!*widget.state.shuffle)
+	})
+}
+
+func (widget *Widget) cycleRepeat() {
+	widget.act(func(p *player) error {
+		if widget.state == nil || widget.state.loopStatus == "" {
+			return fmt.Errorf("%s doesn't support repeat", p.name)
+		}
+
+		return p.setLoopStatus(nextLoopStatus(widget.state.loopStatus))
+	})
+}
+
+// nextPlayer switches to the player that comes after the current one
+func (widget *Widget) nextPlayer() {
+	if widget.player == nil || len(widget.names) < 2 {
+		return
+	}
+
+	for i, name := range widget.names {
+		if name == widget.player.name {
+			widget.player = newPlayer(widget.conn, widget.names[(i+1)%len(widget.names)])
+			break
+		}
+	}
+
+	widget.actionErr = nil
+	widget.Refresh()
+}
+
+// act controls the player, and shows why if it can't
+func (widget *Widget) act(action func(*player) error) {
+	if widget.player == nil {
+		return
+	}
+
+	widget.actionErr = action(widget.player)
+	widget.Refresh()
+}
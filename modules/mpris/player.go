@@ -0,0 +1,242 @@
+package mpris
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	busPrefix       = "org.mpris.MediaPlayer2."
+	objectPath      = "/org/mpris/MediaPlayer2"
+	playerInterface = "org.mpris.MediaPlayer2.Player"
+	propsInterface  = "org.freedesktop.DBus.Properties"
+)
+
+// loopStatuses are the repeat modes of a player, in the order that they're switched
+// between
+var loopStatuses = []string{"None", "Playlist", "Track"}
+
+// track is the track that a player is playing
+type track struct {
+	album   string
+	artists []string
+	id      dbus.ObjectPath
+	length  time.Duration
+	title   string
+}
+
+// playerState is what a player is doing, read from its properties. Players don't have
+// to support shuffle, repeat or volume, so those are only set when they do
+type playerState struct {
+	loopStatus string
+	position   time.Duration
+	shuffle    *bool
+	status     string
+	track      track
+	volume     *float64
+}
+
+// player controls a media player through its MPRIS D-Bus interface
+type player struct {
+	name   string
+	object dbus.BusObject
+}
+
+// players returns the names of the media players on the session bus, without their
+// MPRIS prefix, such as spotify or vlc.instance1234
+func players(conn *dbus.Conn) ([]string, error) {
+	var names []string
+	if err := conn.BusObject().Call("org.freedesktop.DBus.ListNames", 0).Store(&names); err != nil {
+		return nil, err
+	}
+
+	found := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(name, busPrefix) {
+			found = append(found, strings.TrimPrefix(name, busPrefix))
+		}
+	}
+
+	sort.Strings(found)
+
+	return found, nil
+}
+
+// choosePlayer returns the player that matches the preferred name, or the one that's
+// current. Otherwise, the first player that's playing is chosen, and then the first
+// player. Names match by their prefix, so that vlc matches vlc.instance1234
+func choosePlayer(names []string, preferred, current string, isPlaying func(string) bool) (string, error) {
+	if len(names) == 0 {
+		return "", fmt.Errorf("no media player is running")
+	}
+
+	for _, wanted := range []string{current, preferred} {
+		if wanted == "" {
+			continue
+		}
+
+		for _, name := range names {
+			if name == wanted || strings.HasPrefix(name, wanted+".") {
+				return name, nil
+			}
+		}
+	}
+
+	if preferred != "" {
+		return "", fmt.Errorf("%s isn't running", preferred)
+	}
+
+	for _, name := range names {
+		if isPlaying(name) {
+			return name, nil
+		}
+	}
+
+	return names[0], nil
+}
+
+func newPlayer(conn *dbus.Conn, name string) *player {
+	return &player{
+		name:   name,
+		object: conn.Object(busPrefix+name, objectPath),
+	}
+}
+
+// state reads the properties of the player
+func (p *player) state() (*playerState, error) {
+	var props map[string]dbus.Variant
+	if err := p.object.Call(propsInterface+".GetAll", 0, playerInterface).Store(&props); err != nil {
+		return nil, err
+	}
+
+	return parseState(props), nil
+}
+
+func (p *player) playPause() error {
+	return p.object.Call(playerInterface+".PlayPause", 0).Err
+}
+
+func (p *player) next() error {
+	return p.object.Call(playerInterface+".Next", 0).Err
+}
+
+func (p *player) previous() error {
+	return p.object.Call(playerInterface+".Previous", 0).Err
+}
+
+// seek moves the position within the track by offset, which can be negative
+func (p *player) seek(offset time.Duration) error {
+	return p.object.Call(playerInterface+".Seek", 0, offset.Microseconds()).Err
+}
+
+func (p *player) setVolume(volume float64) error {
+	return p.set("Volume", min(max(volume, 0), 1))
+}
+
+func (p *player) setShuffle(shuffle bool) error {
+	return p.set("Shuffle", shuffle)
+}
+
+func (p *player) setLoopStatus(status string) error {
+	return p.set("LoopStatus", status)
+}
+
+func (p *player) set(property string, value interface{}) error {
+	return p.object.Call(propsInterface+".Set", 0, playerInterface, property, dbus.MakeVariant(value)).Err
+}
+
+/* -------------------- Properties -------------------- */
+
+// parseState reads the properties of a player. See
+// https://specifications.freedesktop.org/mpris-spec/latest/Player_Interface.html
+func parseState(props map[string]dbus.Variant) *playerState {
+	state := &playerState{
+		loopStatus: stringValue(props["LoopStatus"]),
+		position:   microseconds(props["Position"]),
+		status:     stringValue(props["PlaybackStatus"]),
+	}
+
+	if shuffle, ok := props["Shuffle"].Value().(bool); ok {
+		state.shuffle = &shuffle
+	}
+
+	if volume, ok := props["Volume"].Value().(float64); ok {
+		state.volume = &volume
+	}
+
+	if metadata, ok := props["Metadata"].Value().(map[string]dbus.Variant); ok {
+		state.track = parseMetadata(metadata)
+	}
+
+	return state
+}
+
+// parseMetadata reads the metadata of a track. See
+// https://www.freedesktop.org/wiki/Specifications/mpris-spec/metadata/
+func parseMetadata(metadata map[string]dbus.Variant) track {
+	t := track{
+		album:  stringValue(metadata["xesam:album"]),
+		length: microseconds(metadata["mpris:length"]),
+		title:  stringValue(metadata["xesam:title"]),
+	}
+
+	if id, ok := metadata["mpris:trackid"].Value().(dbus.ObjectPath); ok {
+		t.id = id
+	}
+
+	switch artists := metadata["xesam:artist"].Value().(type) {
+	case []string:
+		t.artists = artists
+	case string:
+		// Some players send a single artist, which the specification doesn't allow
+		t.artists = []string{artists}
+	}
+
+	// Players such as mpv leave the title out of streams and files without tags
+	if t.title == "" {
+		t.title = stringValue(metadata["xesam:url"])
+	}
+
+	return t
+}
+
+func stringValue(variant dbus.Variant) string {
+	str, _ := variant.Value().(string)
+	return str
+}
+
+// microseconds reads a time in microseconds. The specification says it's a signed
+// 64 bit integer, but players also send other integer types
+func microseconds(variant dbus.Variant) time.Duration {
+	var us int64
+
+	switch value := variant.Value().(type) {
+	case int64:
+		us = value
+	case uint64:
+		us = int64(value)
+	case int32:
+		us = int64(value)
+	case uint32:
+		us = int64(value)
+	case float64:
+		us = int64(value)
+	}
+
+	return time.Duration(us) * time.Microsecond
+}
+
+// nextLoopStatus returns the repeat mode that comes after status
+func nextLoopStatus(status string) string {
+	for i, known := range loopStatuses {
+		if strings.EqualFold(known, status) {
+			return loopStatuses[(i+1)%len(loopStatuses)]
+		}
+	}
+
+	return loopStatuses[0]
+}
@@ -0,0 +1,102 @@
+package mpris
+
+import (
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseState(t *testing.T) {
+	state := parseState(map[string]dbus.Variant{
+		"PlaybackStatus": dbus.MakeVariant("Playing"),
+		"LoopStatus":     dbus.MakeVariant("Playlist"),
+		"Shuffle":        dbus.MakeVariant(true),
+		"Volume":         dbus.MakeVariant(0.65),
+		"Position":       dbus.MakeVariant(int64(83_500_000)),
+		"Metadata": dbus.MakeVariant(map[string]dbus.Variant{
+			"mpris:trackid": dbus.MakeVariant(dbus.ObjectPath("/org/mpris/MediaPlayer2/Track/1")),
+			"mpris:length":  dbus.MakeVariant(uint64(225_000_000)),
+			"xesam:title":   dbus.MakeVariant("Teardrop"),
+			"xesam:artist":  dbus.MakeVariant([]string{"Massive Attack"}),
+			"xesam:album":   dbus.MakeVariant("Mezzanine"),
+		}),
+	})
+
+	assert.Equal(t, "Playing", state.status)
+	assert.Equal(t, "Playlist", state.loopStatus)
+	assert.Equal(t, 83500*time.Millisecond, state.position)
+	assert.True(t, *state.shuffle)
+	assert.Equal(t, 0.65, *state.volume)
+	assert.Equal(t, track{
+		album:   "Mezzanine",
+		artists: []string{"Massive Attack"},
+		id:      "/org/mpris/MediaPlayer2/Track/1",
+		length:  225 * time.Second,
+		title:   "Teardrop",
+	}, state.track)
+}
+
+func Test_parseState_unsupported(t *testing.T) {
+	state := parseState(map[string]dbus.Variant{
+		"PlaybackStatus": dbus.MakeVariant("Paused"),
+		"Metadata": dbus.MakeVariant(map[string]dbus.Variant{
+			"xesam:url":    dbus.MakeVariant("https://radio.example.com/stream"),
+			"xesam:artist": dbus.MakeVariant("Radio"),
+		}),
+	})
+
+	assert.Nil(t, state.shuffle)
+	assert.Nil(t, state.volume)
+	assert.Empty(t, state.loopStatus)
+	assert.Zero(t, state.track.length)
+	assert.Equal(t, "https://radio.example.com/stream", state.track.title)
+	assert.Equal(t, []string{"Radio"}, state.track.artists)
+}
+
+func Test_choosePlayer(t *testing.T) {
+	names := []string{"mpv", "spotify", "vlc.instance1234"}
+	playing := func(name string) bool { return name == "spotify" }
+	idle := func(string) bool { return false }
+
+	tests := []struct {
+		name      string
+		preferred string
+		current   string
+		isPlaying func(string) bool
+		expected  string
+		err       string
+	}{
+		{name: "playing", isPlaying: playing, expected: "spotify"},
+		{name: "first", isPlaying: idle, expected: "mpv"},
+		{name: "preferred", preferred: "vlc", isPlaying: playing, expected: "vlc.instance1234"},
+		{name: "current", preferred: "vlc", current: "mpv", isPlaying: playing, expected: "mpv"},
+		{name: "current gone", current: "rhythmbox", isPlaying: playing, expected: "spotify"},
+		{name: "preferred gone", preferred: "rhythmbox", isPlaying: playing, err: "rhythmbox isn't running"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := choosePlayer(names, tt.preferred, tt.current, tt.isPlaying)
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+
+	_, err := choosePlayer(nil, "", "", idle)
+	assert.EqualError(t, err, "no media player is running")
+}
+
+func Test_nextLoopStatus(t *testing.T) {
+	assert.Equal(t, "Playlist", nextLoopStatus("None"))
+	assert.Equal(t, "Track", nextLoopStatus("Playlist"))
+	assert.Equal(t, "None", nextLoopStatus("Track"))
+	assert.Equal(t, "None", nextLoopStatus(""))
+}
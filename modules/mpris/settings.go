@@ -0,0 +1,48 @@
+package mpris
+
+import (
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "Now Playing"
+)
+
+type colors struct {
+	label string
+	text  string
+}
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	colors
+	*cfg.Common
+
+	player     string        `help:"The player to control, such as spotify, mpv or vlc. When not set, a player that's playing is preferred." optional:"true"`
+	seekStep   time.Duration `help:"How far the seek keys move within the track." values:"A duration, such as 10s." optional:"true"`
+	volumeStep float64       `help:"How many percent the volume keys change the volume by." values:"A positive integer." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		player:     ymlConfig.UString("player", ""),
+		seekStep:   cfg.ParseTimeString(ymlConfig, "seekStep", "10s"),
+		volumeStep: float64(ymlConfig.UInt("volumeStep", 5)) / 100,
+	}
+
+	settings.RefreshInterval = cfg.ParseTimeString(ymlConfig, "refreshInterval", "1s")
+
+	settings.label = ymlConfig.UString("colors.label", "green")
+	settings.text = ymlConfig.UString("colors.text", "white")
+
+	settings.SetDocumentationPath("mpris")
+
+	return &settings
+}
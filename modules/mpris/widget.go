@@ -0,0 +1,94 @@
+package mpris
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+// Widget displays and controls a media player through MPRIS, the D-Bus interface that
+// players such as Spotify, mpv and VLC implement on Linux
+type Widget struct {
+	view.TextWidget
+
+	actionErr error
+	conn      *dbus.Conn
+	err       error
+	names     []string
+	player    *player
+	settings  *Settings
+	state     *playerState
+}
+
+// NewWidget creates and returns an instance of Widget
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		settings: settings,
+	}
+
+	widget.initializeKeyboardControls()
+
+	widget.View.SetWrap(true)
+	widget.View.SetWordWrap(true)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh reads the state of the player and displays it
+func (widget *Widget) Refresh() {
+	widget.state, widget.err = widget.fetch()
+
+	widget.display()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetch finds the player to display, which is the same one as before while it's still
+// running, and reads its state
+func (widget *Widget) fetch() (*playerState, error) {
+	if widget.conn == nil {
+		conn, err := dbus.SessionBus()
+		if err != nil {
+			return nil, err
+		}
+		widget.conn = conn
+	}
+
+	names, err := players(widget.conn)
+	if err != nil {
+		return nil, err
+	}
+	widget.names = names
+
+	current := ""
+	if widget.player != nil {
+		current = widget.player.name
+	}
+
+	isPlaying := func(name string) bool {
+		state, err := newPlayer(widget.conn, name).state()
+		return err == nil && state.status == "Playing"
+	}
+
+	name, err := choosePlayer(names, widget.settings.player, current, isPlaying)
+	if err != nil {
+		widget.player = nil
+		return nil, err
+	}
+
+	if widget.player == nil || widget.player.name != name {
+		widget.player = newPlayer(widget.conn, name)
+	}
+
+	return widget.player.state()
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(func() (string, string, bool) {
+		return widget.CommonSettings().Title, widget.content(), true
+	})
+}
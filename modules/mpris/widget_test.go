@@ -0,0 +1,20 @@
+package mpris
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewWidget(t *testing.T) {
+	ymlConfig, _ := config.ParseYaml("enabled: true")
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+	settings := NewSettingsFromYAML("mpris", ymlConfig, globalConfig)
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+
+	assert.Contains(t, widget.AssignedChars(), "r")
+	assert.Contains(t, widget.AssignedChars(), "R")
+}
@@ -1,9 +1,14 @@
 package opsgenie
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 )
 
 type OnCallResponse struct {
@@ -24,6 +29,44 @@ type Parent struct {
 	Enabled bool   `json:"enabled"`
 }
 
+// AlertsResponse is the body returned by the list alerts endpoint
+type AlertsResponse struct {
+	Alerts    []*Alert `json:"data"`
+	Message   string   `json:"message"`
+	RequestID string   `json:"requestId"`
+	Took      float32  `json:"took"`
+}
+
+// Alert is a single OpsGenie alert
+type Alert struct {
+	ID           string      `json:"id"`
+	TinyID       string      `json:"tinyId"`
+	Message      string      `json:"message"`
+	Status       string      `json:"status"`
+	Acknowledged bool        `json:"acknowledged"`
+	Snoozed      bool        `json:"snoozed"`
+	SnoozedUntil time.Time   `json:"snoozedUntil"`
+	Priority     string      `json:"priority"`
+	Owner        string      `json:"owner"`
+	Tags         []string    `json:"tags"`
+	Teams        []AlertTeam `json:"teams"`
+	Count        int         `json:"count"`
+	CreatedAt    time.Time   `json:"createdAt"`
+}
+
+// AlertTeam identifies a team an alert has been routed to
+type AlertTeam struct {
+	ID string `json:"id"`
+}
+
+// ActionResponse is the body returned by the asynchronous alert action endpoints
+type ActionResponse struct {
+	Result    string  `json:"result"`
+	Message   string  `json:"message"`
+	RequestID string  `json:"requestId"`
+	Took      float32 `json:"took"`
+}
+
 var opsGenieAPIUrl = map[string]string{
 	"us": "https://api.opsgenie.com",
 	"eu": "https://api.eu.opsgenie.com",
@@ -34,43 +77,136 @@ var opsGenieAPIUrl = map[string]string{
 func (widget *Widget) Fetch(scheduleIdentifierType string, schedules []string) ([]*OnCallResponse, error) {
 	agregatedResponses := []*OnCallResponse{}
 
-	if regionURL, regionErr := opsGenieAPIUrl[widget.settings.region]; regionErr {
-		for _, sched := range schedules {
-			scheduleURL := fmt.Sprintf("%s/v2/schedules/%s/on-calls?scheduleIdentifierType=%s&flat=true", regionURL, sched, scheduleIdentifierType)
-			response, err := opsGenieRequest(scheduleURL, widget.settings.apiKey)
-			agregatedResponses = append(agregatedResponses, response)
-			if err != nil {
-				return nil, err
-			}
+	regionURL, err := widget.regionURL()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sched := range schedules {
+		scheduleURL := fmt.Sprintf("%s/v2/schedules/%s/on-calls?scheduleIdentifierType=%s&flat=true", regionURL, sched, scheduleIdentifierType)
+		response := &OnCallResponse{}
+		err := opsGenieRequest(http.MethodGet, scheduleURL, widget.settings.apiKey, nil, response)
+		agregatedResponses = append(agregatedResponses, response)
+		if err != nil {
+			return nil, err
 		}
-		return agregatedResponses, nil
-	} else {
-		return nil, fmt.Errorf("you specified wrong region. Possible options are only 'us' and 'eu'")
 	}
+
+	return agregatedResponses, nil
+}
+
+// FetchAlerts returns the alerts matching the configured query, priorities and teams
+func (widget *Widget) FetchAlerts() ([]*Alert, error) {
+	regionURL, err := widget.regionURL()
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("query", widget.settings.alertQueryString())
+	params.Set("limit", fmt.Sprintf("%d", widget.settings.alertLimit))
+	params.Set("sort", "createdAt")
+	params.Set("order", "desc")
+
+	alertsURL := fmt.Sprintf("%s/v2/alerts?%s", regionURL, params.Encode())
+
+	response := &AlertsResponse{}
+	if err := opsGenieRequest(http.MethodGet, alertsURL, widget.settings.apiKey, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response.Alerts, nil
+}
+
+// AcknowledgeAlert acknowledges the alert with the given ID
+func (widget *Widget) AcknowledgeAlert(alertID string) error {
+	return widget.alertAction(alertID, "acknowledge", map[string]interface{}{})
+}
+
+// CloseAlert closes the alert with the given ID
+func (widget *Widget) CloseAlert(alertID string) error {
+	return widget.alertAction(alertID, "close", map[string]interface{}{})
+}
+
+// SnoozeAlert snoozes the alert with the given ID until the given time
+func (widget *Widget) SnoozeAlert(alertID string, until time.Time) error {
+	body := map[string]interface{}{
+		"endTime": until.UTC().Format(time.RFC3339),
+	}
+
+	return widget.alertAction(alertID, "snooze", body)
+}
+
+// AddAlertNote adds a note to the alert with the given ID
+func (widget *Widget) AddAlertNote(alertID, note string) error {
+	body := map[string]interface{}{
+		"note": note,
+	}
+
+	return widget.alertAction(alertID, "notes", body)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func opsGenieRequest(url string, apiKey string) (*OnCallResponse, error) {
-	req, err := http.NewRequest("GET", url, http.NoBody)
+func (widget *Widget) regionURL() (string, error) {
+	regionURL, ok := opsGenieAPIUrl[widget.settings.region]
+	if !ok {
+		return "", fmt.Errorf("you specified wrong region. Possible options are only 'us' and 'eu'")
+	}
+
+	return regionURL, nil
+}
+
+// alertAction POSTs to one of the alert action endpoints. OpsGenie processes these
+// asynchronously, so a successful return only means the request was accepted
+func (widget *Widget) alertAction(alertID, action string, body map[string]interface{}) error {
+	regionURL, err := widget.regionURL()
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	body["source"] = "wtf"
+	if widget.settings.user != "" {
+		body["user"] = widget.settings.user
+	}
+
+	actionURL := fmt.Sprintf("%s/v2/alerts/%s/%s?identifierType=id", regionURL, url.PathEscape(alertID), action)
+
+	return opsGenieRequest(http.MethodPost, actionURL, widget.settings.apiKey, body, &ActionResponse{})
+}
+
+func opsGenieRequest(method, url string, apiKey string, body interface{}, response interface{}) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, url, reqBody)
+	if err != nil {
+		return err
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("GenieKey %s", apiKey))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	client := &http.Client{}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	response := &OnCallResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
-		return nil, err
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(msg)))
 	}
 
-	return response, nil
+	return json.NewDecoder(resp.Body).Decode(response)
 }
@@ -0,0 +1,141 @@
+package opsgenie
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWidget(t *testing.T, handler http.Handler) *Widget {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	regionURL := opsGenieAPIUrl["us"]
+	opsGenieAPIUrl["us"] = server.URL
+	t.Cleanup(func() { opsGenieAPIUrl["us"] = regionURL })
+
+	return &Widget{
+		settings: &Settings{
+			apiKey:       "key",
+			region:       "us",
+			alertQuery:   "status: open",
+			alertLimit:   20,
+			alertTeams:   []string{"ops"},
+			displayEmpty: true,
+			user:         "me@example.com",
+		},
+	}
+}
+
+func Test_Fetch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/schedules/{schedule}/on-calls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GenieKey key", r.Header.Get("Authorization"))
+		assert.Equal(t, "name", r.URL.Query().Get("scheduleIdentifierType"))
+		assert.Equal(t, "true", r.URL.Query().Get("flat"))
+
+		_, _ = w.Write([]byte(`{"data": {
+			"onCallRecipients": ["jane@example.com"],
+			"_parent": {"id": "1", "name": "` + r.PathValue("schedule") + `", "enabled": true}
+		}}`))
+	})
+
+	widget := newTestWidget(t, mux)
+
+	onCalls, err := widget.Fetch("name", []string{"primary", "secondary"})
+	require.NoError(t, err)
+	require.Len(t, onCalls, 2)
+
+	assert.Equal(t, "primary", onCalls[0].OnCallData.Parent.Name)
+	assert.Equal(t, "secondary", onCalls[1].OnCallData.Parent.Name)
+	assert.Equal(t, []string{"jane@example.com"}, onCalls[0].OnCallData.Recipients)
+}
+
+func Test_Fetch_error(t *testing.T) {
+	widget := newTestWidget(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message": "Key format is not valid!"}`, http.StatusUnprocessableEntity)
+	}))
+
+	_, err := widget.Fetch("id", []string{"primary"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "422")
+	assert.Contains(t, err.Error(), "Key format is not valid!")
+}
+
+func Test_Fetch_wrongRegion(t *testing.T) {
+	widget := &Widget{settings: &Settings{region: "au"}}
+
+	_, err := widget.Fetch("id", []string{"primary"})
+	assert.Error(t, err)
+}
+
+func Test_FetchAlerts(t *testing.T) {
+	widget := newTestWidget(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v2/alerts", r.URL.Path)
+		assert.Equal(t, `status: open AND teams: ("ops")`, r.URL.Query().Get("query"))
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "a1", "tinyId": "12", "message": "Disk full", "priority": "P1", "acknowledged": true},
+			{"id": "a2", "tinyId": "13", "message": "High load", "priority": "P3", "owner": "me@example.com"}
+		]}`))
+	}))
+
+	alerts, err := widget.FetchAlerts()
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+
+	assert.Equal(t, "a1", alerts[0].ID)
+	assert.True(t, alerts[0].Acknowledged)
+	assert.True(t, widget.isMine(alerts[1]))
+}
+
+func Test_alertActions(t *testing.T) {
+	type request struct {
+		path string
+		body map[string]interface{}
+	}
+
+	requests := []request{}
+
+	widget := newTestWidget(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "id", r.URL.Query().Get("identifierType"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body := map[string]interface{}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, request{path: r.URL.Path, body: body})
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result": "Request will be processed"}`))
+	}))
+
+	until := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, widget.AcknowledgeAlert("a1"))
+	require.NoError(t, widget.CloseAlert("a1"))
+	require.NoError(t, widget.SnoozeAlert("a1", until))
+	require.NoError(t, widget.AddAlertNote("a1", "on it"))
+
+	require.Len(t, requests, 4)
+
+	assert.Equal(t, "/v2/alerts/a1/acknowledge", requests[0].path)
+	assert.Equal(t, "/v2/alerts/a1/close", requests[1].path)
+	assert.Equal(t, "/v2/alerts/a1/snooze", requests[2].path)
+	assert.Equal(t, "/v2/alerts/a1/notes", requests[3].path)
+
+	for _, req := range requests {
+		assert.Equal(t, "wtf", req.body["source"])
+		assert.Equal(t, "me@example.com", req.body["user"])
+	}
+
+	assert.Equal(t, "2024-05-01T12:00:00Z", requests[2].body["endTime"])
+	assert.Equal(t, "on it", requests[3].body["note"])
+}
@@ -0,0 +1,114 @@
+package opsgenie
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+)
+
+var priorityColors = map[string]string{
+	"P1": "red",
+	"P2": "orange",
+	"P3": "yellow",
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	switch widget.CurrentSource() {
+	case sourceAlerts:
+		if widget.alertsErr != nil {
+			widget.SetItemCount(0)
+			return title, widget.alertsErr.Error(), true
+		}
+
+		widget.SetItemCount(len(widget.alerts))
+		return fmt.Sprintf("%s - Alerts (%d)", title, len(widget.alerts)), widget.alertsContent(), false
+	case sourceOnCall:
+		widget.SetItemCount(0)
+
+		if widget.onCallErr != nil {
+			return title, widget.onCallErr.Error(), true
+		}
+
+		return fmt.Sprintf("%s - On Call", title), widget.onCallContent(), false
+	default:
+		widget.SetItemCount(0)
+		return title, " [gray]no alerts or schedules configured[white]", false
+	}
+}
+
+func (widget *Widget) alertsContent() string {
+	if len(widget.alerts) == 0 {
+		return " [gray]no open alerts[white]"
+	}
+
+	var content string
+
+	for idx, alert := range widget.alerts {
+		rowColor := widget.RowColor(idx)
+		if widget.isMine(alert) && idx != widget.Selected {
+			rowColor = widget.settings.assignedColor
+		}
+
+		priorityColor, ok := priorityColors[alert.Priority]
+		if !ok {
+			priorityColor = rowColor
+		}
+
+		state := ""
+		switch {
+		case alert.Snoozed:
+			state = " [gray](snoozed)"
+		case alert.Acknowledged:
+			state = " [gray](acked)"
+		}
+
+		row := fmt.Sprintf(
+			"[%s]%s [%s]#%s %s%s",
+			priorityColor,
+			alert.Priority,
+			rowColor,
+			alert.TinyID,
+			tview.Escape(alert.Message),
+			state,
+		)
+
+		content += utils.HighlightableHelper(widget.View, row, idx, len(alert.Priority)+len(alert.TinyID)+len(alert.Message)+3)
+	}
+
+	return content
+}
+
+func (widget *Widget) onCallContent() string {
+	var content string
+
+	for _, data := range widget.onCalls {
+		if (len(data.OnCallData.Recipients) == 0) && !widget.settings.displayEmpty {
+			continue
+		}
+
+		var msg string
+		if len(data.OnCallData.Recipients) == 0 {
+			msg = " [gray]no one[white]\n\n"
+		} else {
+			msg = fmt.Sprintf(" %s\n\n", strings.Join(utils.NamesFromEmails(data.OnCallData.Recipients), ", "))
+		}
+
+		content += widget.cleanScheduleName(data.OnCallData.Parent.Name)
+		content += msg
+	}
+
+	return content
+}
+
+func (widget *Widget) cleanScheduleName(schedule string) string {
+	cleanedName := strings.ReplaceAll(schedule, "_", " ")
+	return fmt.Sprintf(" [green]%s[white]\n", cleanedName)
+}
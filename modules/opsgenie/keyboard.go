@@ -0,0 +1,23 @@
+package opsgenie
+
+import "github.com/gdamore/tcell/v2"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next alert")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous alert")
+	widget.SetKeyboardChar("h", widget.PrevSource, "Show previous source")
+	widget.SetKeyboardChar("l", widget.NextSource, "Show next source")
+	widget.SetKeyboardChar("a", widget.acknowledgeSelected, "Acknowledge alert")
+	widget.SetKeyboardChar("c", widget.closeSelected, "Close alert")
+	widget.SetKeyboardChar("s", widget.snoozeSelected, "Snooze alert")
+	widget.SetKeyboardChar("n", widget.addNoteToSelected, "Add note to alert")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next alert")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous alert")
+	widget.SetKeyboardKey(tcell.KeyLeft, widget.PrevSource, "Show previous source")
+	widget.SetKeyboardKey(tcell.KeyRight, widget.NextSource, "Show next source")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
@@ -1,25 +1,38 @@
 package opsgenie
 
 import (
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
-	defaultFocusable = false
-	defaultTitle     = "OpsGenie"
+	defaultFocusable      = true
+	defaultTitle          = "OpsGenie"
+	defaultSnoozeDuration = time.Hour
 )
 
 type Settings struct {
 	*cfg.Common
 
-	apiKey                 string   `help:"Your OpsGenie API token."`
-	region                 string   `help:"Defines region to use. Possible options: us (by default), eu." optional:"true"`
-	displayEmpty           bool     `help:"Whether schedules with no assigned person on-call should be displayed." optional:"true"`
-	schedule               []string `help:"A list of names of the schedule(s) to retrieve."`
-	scheduleIdentifierType string   `help:"Type of the schedule identifier." values:"id or name" optional:"true"`
+	apiKey                 string        `help:"Your OpsGenie API token."`
+	region                 string        `help:"Defines region to use. Possible options: us (by default), eu." optional:"true"`
+	displayEmpty           bool          `help:"Whether schedules with no assigned person on-call should be displayed." optional:"true"`
+	schedule               []string      `help:"A list of names of the schedule(s) to retrieve."`
+	scheduleIdentifierType string        `help:"Type of the schedule identifier." values:"id or name" optional:"true"`
+	displayAlerts          bool          `help:"Whether the list of alerts should be displayed." optional:"true"`
+	alertQuery             string        `help:"An OpsGenie search query used to filter alerts." optional:"true"`
+	alertPriorities        []string      `help:"Only show alerts with these priorities." values:"P1, P2, P3, P4, P5" optional:"true"`
+	alertTeams             []string      `help:"Only show alerts assigned to these teams." optional:"true"`
+	alertLimit             int           `help:"The maximum number of alerts to display." optional:"true"`
+	snoozeDuration         time.Duration `help:"How long an alert is snoozed for, for example 30m or 2h." optional:"true"`
+	user                   string        `help:"Your OpsGenie username. Alerts owned by this user are highlighted, and actions are recorded against it." optional:"true"`
+	assignedColor          string        `help:"The color used to highlight alerts owned by you." optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -31,15 +44,51 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		region:                 ymlConfig.UString("region", "us"),
 		displayEmpty:           ymlConfig.UBool("displayEmpty", true),
 		scheduleIdentifierType: ymlConfig.UString("scheduleIdentifierType", "id"),
+		displayAlerts:          ymlConfig.UBool("displayAlerts", false),
+		alertQuery:             ymlConfig.UString("alertQuery", "status: open"),
+		alertPriorities:        utils.ToStrs(ymlConfig.UList("alertPriorities")),
+		alertTeams:             utils.ToStrs(ymlConfig.UList("alertTeams")),
+		alertLimit:             ymlConfig.UInt("alertLimit", 20),
+		user:                   ymlConfig.UString("user"),
+		assignedColor:          ymlConfig.UString("colors.assigned", "yellow"),
 	}
 
 	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).Load()
 
 	settings.schedule = settings.arrayifySchedules(ymlConfig)
 
+	settings.snoozeDuration = defaultSnoozeDuration
+	if duration, err := time.ParseDuration(ymlConfig.UString("snoozeDuration")); err == nil && duration > 0 {
+		settings.snoozeDuration = duration
+	}
+
 	return &settings
 }
 
+// alertQueryString combines the configured query, priorities and teams into a single
+// OpsGenie search query
+func (settings *Settings) alertQueryString() string {
+	parts := []string{}
+
+	if settings.alertQuery != "" {
+		parts = append(parts, settings.alertQuery)
+	}
+
+	if len(settings.alertPriorities) > 0 {
+		parts = append(parts, fmt.Sprintf("priority: (%s)", strings.Join(settings.alertPriorities, " OR ")))
+	}
+
+	if len(settings.alertTeams) > 0 {
+		teams := make([]string, len(settings.alertTeams))
+		for i, team := range settings.alertTeams {
+			teams[i] = fmt.Sprintf("%q", team)
+		}
+		parts = append(parts, fmt.Sprintf("teams: (%s)", strings.Join(teams, " OR ")))
+	}
+
+	return strings.Join(parts, " AND ")
+}
+
 // arrayifySchedules figures out if we're dealing with a single project or an array of projects
 func (settings *Settings) arrayifySchedules(ymlConfig *config.Config) []string {
 	schedules := []string{}
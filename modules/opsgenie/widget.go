@@ -1,73 +1,165 @@
 package opsgenie
 
 import (
-	"fmt"
-	"strings"
+	"time"
 
 	"github.com/rivo/tview"
-	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
 
-type Widget struct {
-	view.TextWidget
+const (
+	sourceAlerts = "alerts"
+	sourceOnCall = "on-call"
+)
 
-	settings *Settings
+type Widget struct {
+	view.MultiSourceWidget
+	view.ScrollableWidget
+
+	alerts    []*Alert
+	alertsErr error
+	onCalls   []*OnCallResponse
+	onCallErr error
+	pages     *tview.Pages
+	settings  *Settings
+	tviewApp  *tview.Application
 }
 
-func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Settings) *Widget {
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(tviewApp, redrawChan, nil, settings.Common),
+		MultiSourceWidget: view.NewMultiSourceWidget(settings.Common, "source", "sources"),
+		ScrollableWidget:  view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		pages:    pages,
 		settings: settings,
+		tviewApp: tviewApp,
+	}
+
+	widget.Sources = []string{}
+	if settings.displayAlerts {
+		widget.Sources = append(widget.Sources, sourceAlerts)
+	}
+	if len(settings.schedule) > 0 {
+		widget.Sources = append(widget.Sources, sourceOnCall)
 	}
 
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
+	widget.SetDisplayFunction(widget.display)
+
 	return &widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.Redraw(widget.content)
+	if widget.Disabled() {
+		return
+	}
+
+	if widget.settings.displayAlerts {
+		widget.alerts, widget.alertsErr = widget.FetchAlerts()
+	}
+
+	if len(widget.settings.schedule) > 0 {
+		widget.onCalls, widget.onCallErr = widget.Fetch(
+			widget.settings.scheduleIdentifierType,
+			widget.settings.schedule,
+		)
+	}
+
+	widget.display()
+}
+
+func (widget *Widget) NextSource() {
+	widget.MultiSourceWidget.NextSource()
+	widget.Unselect()
+}
+
+func (widget *Widget) PrevSource() {
+	widget.MultiSourceWidget.PrevSource()
+	widget.Unselect()
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) content() (string, string, bool) {
-	onCallResponses, err := widget.Fetch(
-		widget.settings.scheduleIdentifierType,
-		widget.settings.schedule,
-	)
-	title := widget.CommonSettings().Title
-
-	var content string
-	wrap := false
-	if err != nil {
-		wrap = true
-		content = err.Error()
-	} else {
-
-		for _, data := range onCallResponses {
-			if (len(data.OnCallData.Recipients) == 0) && !widget.settings.displayEmpty {
-				continue
-			}
-
-			var msg string
-			if len(data.OnCallData.Recipients) == 0 {
-				msg = " [gray]no one[white]\n\n"
-			} else {
-				msg = fmt.Sprintf(" %s\n\n", strings.Join(utils.NamesFromEmails(data.OnCallData.Recipients), ", "))
-			}
-
-			content += widget.cleanScheduleName(data.OnCallData.Parent.Name)
-			content += msg
-		}
+// selectedAlert returns the currently-selected alert, if there is one
+func (widget *Widget) selectedAlert() *Alert {
+	if widget.CurrentSource() != sourceAlerts {
+		return nil
 	}
 
-	return title, content, wrap
+	if widget.Selected < 0 || widget.Selected >= len(widget.alerts) {
+		return nil
+	}
+
+	return widget.alerts[widget.Selected]
 }
 
-func (widget *Widget) cleanScheduleName(schedule string) string {
-	cleanedName := strings.ReplaceAll(schedule, "_", " ")
-	return fmt.Sprintf(" [green]%s[white]\n", cleanedName)
+// isMine returns true if the alert is owned by the configured user
+func (widget *Widget) isMine(alert *Alert) bool {
+	return widget.settings.user != "" && alert.Owner == widget.settings.user
+}
+
+func (widget *Widget) acknowledgeSelected() {
+	alert := widget.selectedAlert()
+	if alert == nil || alert.Acknowledged {
+		return
+	}
+
+	widget.performAction(func() error {
+		return widget.AcknowledgeAlert(alert.ID)
+	})
+}
+
+func (widget *Widget) closeSelected() {
+	alert := widget.selectedAlert()
+	if alert == nil {
+		return
+	}
+
+	widget.performAction(func() error {
+		return widget.CloseAlert(alert.ID)
+	})
+}
+
+func (widget *Widget) snoozeSelected() {
+	alert := widget.selectedAlert()
+	if alert == nil {
+		return
+	}
+
+	widget.performAction(func() error {
+		return widget.SnoozeAlert(alert.ID, time.Now().Add(widget.settings.snoozeDuration))
+	})
+}
+
+func (widget *Widget) addNoteToSelected() {
+	alert := widget.selectedAlert()
+	if alert == nil {
+		return
+	}
+
+	widget.ShowInputForm("Note:", func(note string) {
+		if note == "" {
+			return
+		}
+
+		widget.performAction(func() error {
+			return widget.AddAlertNote(alert.ID, note)
+		})
+	})
+}
+
+// performAction runs an alert action and refreshes the widget. If the action
+// fails, the error is displayed in place of the alert list
+func (widget *Widget) performAction(action func() error) {
+	if err := action(); err != nil {
+		widget.alertsErr = err
+		widget.display()
+		return
+	}
+
+	widget.Refresh()
 }
@@ -0,0 +1,221 @@
+package resourceusage
+
+import (
+	"fmt"
+	"math"
+
+	"code.cloudfoundry.org/bytefmt"
+	"github.com/rivo/tview"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+)
+
+// processNameWidth is how much of a process's name is shown
+const processNameWidth = 20
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	title := widget.CommonSettings().Title
+	config := widget.settings.Config
+
+	str := view.BuildStars(
+		widget.bars(widget.stats),
+		config.UInt("graphStars", 20),
+		config.UString("graphIcon", "|"),
+	)
+	str += widget.statLines(widget.stats)
+
+	if widget.settings.showProcesses {
+		str += widget.processList()
+	}
+
+	return title, str, false
+}
+
+// bars are the CPU, memory, swap and disk usages
+func (widget *Widget) bars(stats systemStats) []view.Bar {
+	bars := []view.Bar{}
+
+	if widget.settings.showCPU {
+		for i, stat := range stats.cpuStats {
+			// Stats sometimes jump outside the 0-100 range, possibly due to timing
+			stat = math.Min(100, stat)
+			stat = math.Max(0, stat)
+
+			var label string
+			if widget.settings.cpuCombined {
+				label = "CPU"
+			} else {
+				label = fmt.Sprint(i)
+			}
+
+			bars = append(bars, view.Bar{
+				Label:      label,
+				Percent:    int(stat),
+				ValueLabel: fmt.Sprintf("%d%%", int(stat)),
+				LabelColor: "red",
+			})
+		}
+	}
+
+	if widget.settings.showMem {
+		bars = append(bars, view.Bar{
+			Label:      "Mem",
+			Percent:    int(stats.memInfo.UsedPercent),
+			ValueLabel: usageLabel(stats.memInfo.Used, stats.memInfo.Total),
+			LabelColor: "green",
+		})
+	}
+
+	if widget.settings.showSwp {
+		bars = append(bars, swapBar(stats.memInfo))
+	}
+
+	if widget.settings.showDisk {
+		for _, usage := range stats.disks {
+			bars = append(bars, diskBar(usage))
+		}
+	}
+
+	return bars
+}
+
+// statLines are the disk and network rates, the load average and the temperatures
+func (widget *Widget) statLines(stats systemStats) string {
+	str := ""
+
+	if widget.settings.showDiskIO {
+		str += fmt.Sprintf(
+			"[blue]Disk[white] R %s  W %s\n",
+			rateLabel(stats.diskIO, "read"),
+			rateLabel(stats.diskIO, "write"),
+		)
+	}
+
+	if widget.settings.showNetwork {
+		str += fmt.Sprintf(
+			"[blue]Net[white]  ↓ %s  ↑ %s\n",
+			rateLabel(stats.network, "recv"),
+			rateLabel(stats.network, "sent"),
+		)
+	}
+
+	if widget.settings.showLoad && stats.load != nil {
+		str += fmt.Sprintf(
+			"[blue]Load[white] %.2f %.2f %.2f\n",
+			stats.load.Load1,
+			stats.load.Load5,
+			stats.load.Load15,
+		)
+	}
+
+	if widget.settings.showTemperatures {
+		for _, temperature := range stats.temperatures {
+			str += fmt.Sprintf(
+				"[blue]%s[white] %.0f°C\n",
+				tview.Escape(temperature.SensorKey),
+				temperature.Temperature,
+			)
+		}
+	}
+
+	return str
+}
+
+// processList lists the processes using the most CPU or memory, which can be selected
+func (widget *Widget) processList() string {
+	sortBy := "CPU"
+	if widget.sortBy == sortByMemory {
+		sortBy = "memory"
+	}
+
+	str := fmt.Sprintf("\n[blue]Processes by %s[white]\n", sortBy)
+
+	if widget.err != nil {
+		str += fmt.Sprintf("[red]%s[white]\n", tview.Escape(widget.err.Error()))
+	}
+
+	str += fmt.Sprintf("%7s %-*s %6s %6s\n", "PID", processNameWidth, "Name", "CPU%", "Mem%")
+
+	for idx, proc := range widget.procs {
+		name := []rune(proc.name)
+		if len(name) > processNameWidth {
+			name = name[:processNameWidth]
+		}
+
+		row := fmt.Sprintf(
+			"%7d %-*s %6.1f %6.1f",
+			proc.pid,
+			processNameWidth,
+			string(name),
+			proc.cpu,
+			proc.memory,
+		)
+
+		str += utils.HighlightableHelper(
+			widget.View,
+			fmt.Sprintf("[%s]%s", widget.RowColor(idx), tview.Escape(row)),
+			idx,
+			len(row),
+		)
+	}
+
+	return str
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func swapBar(memInfo mem.VirtualMemoryStat) view.Bar {
+	swapUsed := memInfo.SwapTotal - memInfo.SwapFree
+	var swapPercent float64
+	if memInfo.SwapTotal > 0 {
+		swapPercent = float64(swapUsed) / float64(memInfo.SwapTotal)
+	}
+
+	return view.Bar{
+		Label:      "Swp",
+		Percent:    int(swapPercent * 100),
+		ValueLabel: usageLabel(swapUsed, memInfo.SwapTotal),
+		LabelColor: "yellow",
+	}
+}
+
+func diskBar(usage disk.UsageStat) view.Bar {
+	return view.Bar{
+		Label:      usage.Path,
+		Percent:    int(usage.UsedPercent),
+		ValueLabel: usageLabel(usage.Used, usage.Total),
+		LabelColor: "blue",
+	}
+}
+
+// usageLabel displays how much of a total is used, leaving out the unit of the used
+// amount when it's the same as the total's
+func usageLabel(used, total uint64) string {
+	usedLabel := bytefmt.ByteSize(used)
+	totalLabel := bytefmt.ByteSize(total)
+
+	if usedLabel[len(usedLabel)-1] == totalLabel[len(totalLabel)-1] {
+		usedLabel = usedLabel[:len(usedLabel)-1]
+	}
+
+	return fmt.Sprintf("%s/%s", usedLabel, totalLabel)
+}
+
+// rateLabel displays a rate in bytes per second, or a dash until there is one
+func rateLabel(rates map[string]float64, key string) string {
+	rate, ok := rates[key]
+	if !ok {
+		return fmt.Sprintf("%8s", "-")
+	}
+
+	return fmt.Sprintf("%8s", bytefmt.ByteSize(uint64(rate))+"/s")
+}
@@ -0,0 +1,17 @@
+package resourceusage
+
+import "github.com/gdamore/tcell/v2"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeHelpTextKeyboardControl(widget.ShowHelp)
+	widget.InitializeRefreshKeyboardControl(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next process")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous process")
+	widget.SetKeyboardChar("s", widget.toggleSort, "Sort processes by CPU or memory")
+	widget.SetKeyboardChar("x", widget.killSelected, "Kill process")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next process")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous process")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
@@ -0,0 +1,133 @@
+package resourceusage
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shirou/gopsutil/process"
+)
+
+// processInfo is what a process is using, at the last refresh
+type processInfo struct {
+	pid    int32
+	name   string
+	cpu    float64
+	memory float32
+}
+
+// processCollector lists the processes, keeping track of how much CPU time each had
+// used so that their CPU usage is over the time since the last refresh rather than
+// since they started
+type processCollector struct {
+	cpuTimes *rateCounter
+}
+
+func newProcessCollector() *processCollector {
+	return &processCollector{cpuTimes: newRateCounter()}
+}
+
+// collect returns what every process is using. Processes that exit while they're
+// being looked at are left out
+func (collector *processCollector) collect(now time.Time) ([]processInfo, error) {
+	procs, err := process.Processes()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := []processInfo{}
+	cpuTimes := map[string]float64{}
+
+	for _, proc := range procs {
+		name, err := proc.Name()
+		if err != nil {
+			continue
+		}
+
+		times, err := proc.Times()
+		if err != nil {
+			continue
+		}
+
+		memory, _ := proc.MemoryPercent()
+
+		cpuTimes[strconv.Itoa(int(proc.Pid))] = times.User + times.System
+		infos = append(infos, processInfo{pid: proc.Pid, name: name, memory: memory})
+	}
+
+	// CPU seconds per second, as a percentage of one core like top shows it
+	rates := collector.cpuTimes.update(cpuTimes, now)
+	for i := range infos {
+		infos[i].cpu = rates[strconv.Itoa(int(infos[i].pid))] * 100
+	}
+
+	return infos, nil
+}
+
+// topProcesses returns the count processes using the most CPU or memory, depending
+// on sortBy
+func topProcesses(infos []processInfo, sortBy string, count int) []processInfo {
+	sorted := append([]processInfo{}, infos...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sortBy == sortByMemory {
+			return sorted[i].memory > sorted[j].memory
+		}
+
+		return sorted[i].cpu > sorted[j].cpu
+	})
+
+	if count >= 0 && len(sorted) > count {
+		sorted = sorted[:count]
+	}
+
+	return sorted
+}
+
+// withoutProcess returns the processes other than the one with the given pid
+func withoutProcess(infos []processInfo, pid int32) []processInfo {
+	remaining := make([]processInfo, 0, len(infos))
+	for _, info := range infos {
+		if info.pid != pid {
+			remaining = append(remaining, info)
+		}
+	}
+
+	return remaining
+}
+
+// killTimeout is how long a process has to exit after SIGTERM before it's sent SIGKILL
+const killTimeout = 5 * time.Second
+
+// killProcess sends SIGTERM to a process so that it can exit cleanly, then SIGKILL if
+// it's still running after killTimeout. It returns once SIGTERM has been sent
+func killProcess(pid int32) error {
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	if err := proc.Terminate(); err != nil {
+		return err
+	}
+
+	go killAfter(proc, killTimeout)
+
+	return nil
+}
+
+// killAfter sends SIGKILL to a process unless it exits within the timeout
+func killAfter(proc *process.Process, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		running, err := proc.IsRunning()
+		if err != nil || !running {
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	_ = proc.Kill()
+}
@@ -0,0 +1,38 @@
+package resourceusage
+
+import "time"
+
+// rateCounter turns counters that only go up, such as the bytes read from disks, into
+// rates per second by comparing them with their values at the previous refresh
+type rateCounter struct {
+	at     time.Time
+	values map[string]float64
+}
+
+func newRateCounter() *rateCounter {
+	return &rateCounter{values: map[string]float64{}}
+}
+
+// update records the current values of the counters and returns how fast each has
+// gone up per second since the last update. Counters that weren't there then, or
+// that have since been reset, have no rate yet
+func (counter *rateCounter) update(values map[string]float64, now time.Time) map[string]float64 {
+	rates := map[string]float64{}
+
+	elapsed := now.Sub(counter.at).Seconds()
+	if !counter.at.IsZero() && elapsed > 0 {
+		for key, value := range values {
+			last, ok := counter.values[key]
+			if !ok || value < last {
+				continue
+			}
+
+			rates[key] = (value - last) / elapsed
+		}
+	}
+
+	counter.at = now
+	counter.values = values
+
+	return rates
+}
@@ -0,0 +1,58 @@
+package resourceusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_rateCounter(t *testing.T) {
+	counter := newRateCounter()
+	start := time.Now()
+
+	// There's nothing to compare the first values with
+	rates := counter.update(map[string]float64{"read": 1000, "write": 500}, start)
+	assert.Empty(t, rates)
+
+	rates = counter.update(map[string]float64{"read": 3000, "write": 400, "new": 10}, start.Add(2*time.Second))
+	assert.Equal(t, map[string]float64{"read": 1000}, rates)
+
+	rates = counter.update(map[string]float64{"read": 3000, "write": 900, "new": 20}, start.Add(3*time.Second))
+	assert.Equal(t, map[string]float64{"read": 0, "write": 500, "new": 10}, rates)
+}
+
+func Test_topProcesses(t *testing.T) {
+	infos := []processInfo{
+		{pid: 1, name: "init", cpu: 0.1, memory: 0.5},
+		{pid: 2, name: "browser", cpu: 40, memory: 20},
+		{pid: 3, name: "compiler", cpu: 95, memory: 5},
+	}
+
+	top := topProcesses(infos, sortByCPU, 2)
+	assert.Equal(t, []int32{3, 2}, []int32{top[0].pid, top[1].pid})
+
+	top = topProcesses(infos, sortByMemory, 5)
+	assert.Equal(t, []int32{2, 3, 1}, []int32{top[0].pid, top[1].pid, top[2].pid})
+
+	// The processes that were passed in keep their order
+	assert.Equal(t, int32(1), infos[0].pid)
+}
+
+func Test_withoutProcess(t *testing.T) {
+	infos := []processInfo{{pid: 1}, {pid: 2}, {pid: 3}}
+
+	remaining := withoutProcess(infos, 2)
+	assert.Equal(t, []processInfo{{pid: 1}, {pid: 3}}, remaining)
+	assert.Len(t, infos, 3)
+
+	assert.Equal(t, infos, withoutProcess(infos, 4))
+}
+
+func Test_labels(t *testing.T) {
+	assert.Equal(t, "1.5/4G", usageLabel(1536*1024*1024, 4*1024*1024*1024))
+	assert.Equal(t, "512M/4G", usageLabel(512*1024*1024, 4*1024*1024*1024))
+
+	assert.Equal(t, "       -", rateLabel(nil, "read"))
+	assert.Equal(t, "  1.5K/s", rateLabel(map[string]float64{"read": 1536}, "read"))
+}
@@ -3,33 +3,61 @@ package resourceusage
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
-	defaultFocusable       = false
 	defaultRefreshInterval = "1s"
 	defaultTitle           = "ResourceUsage"
+
+	sortByCPU    = "cpu"
+	sortByMemory = "memory"
 )
 
 type Settings struct {
 	*cfg.Common
 
-	cpuCombined bool
-	showCPU     bool
-	showMem     bool
-	showSwp     bool
+	cpuCombined      bool
+	mounts           []string `help:"The mount points to show the disk usage of. Defaults to every physical disk." optional:"true"`
+	processCount     int      `help:"How many processes to list." optional:"true" default:"10"`
+	processSort      string   `help:"What to sort the processes by." values:"cpu or memory" optional:"true" default:"cpu"`
+	showCPU          bool
+	showDisk         bool `help:"Whether or not to show the disk usage of each mount point." optional:"true" default:"false"`
+	showDiskIO       bool `help:"Whether or not to show how fast the disks are read from and written to." optional:"true" default:"false"`
+	showLoad         bool `help:"Whether or not to show the load average." optional:"true" default:"false"`
+	showMem          bool
+	showNetwork      bool `help:"Whether or not to show how fast data is received and sent over the network." optional:"true" default:"false"`
+	showProcesses    bool `help:"Whether or not to list the processes using the most CPU or memory. Makes the widget focusable, to kill them." optional:"true" default:"false"`
+	showSwp          bool
+	showTemperatures bool `help:"Whether or not to show the temperatures of the sensors." optional:"true" default:"false"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	// The process list can only be scrolled and acted on when the widget can be focused
+	showProcesses := ymlConfig.UBool("showProcesses", false)
+
 	settings := Settings{
-		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, showProcesses, ymlConfig, globalConfig),
 
-		cpuCombined: ymlConfig.UBool("cpuCombined", false),
-		showCPU:     ymlConfig.UBool("showCPU", true),
-		showMem:     ymlConfig.UBool("showMem", true),
-		showSwp:     ymlConfig.UBool("showSwp", true),
+		cpuCombined:      ymlConfig.UBool("cpuCombined", false),
+		mounts:           utils.ToStrs(ymlConfig.UList("mounts")),
+		processCount:     ymlConfig.UInt("processCount", 10),
+		processSort:      ymlConfig.UString("processSort", sortByCPU),
+		showCPU:          ymlConfig.UBool("showCPU", true),
+		showDisk:         ymlConfig.UBool("showDisk", false),
+		showDiskIO:       ymlConfig.UBool("showDiskIO", false),
+		showLoad:         ymlConfig.UBool("showLoad", false),
+		showMem:          ymlConfig.UBool("showMem", true),
+		showNetwork:      ymlConfig.UBool("showNetwork", false),
+		showProcesses:    showProcesses,
+		showSwp:          ymlConfig.UBool("showSwp", true),
+		showTemperatures: ymlConfig.UBool("showTemperatures", false),
 	}
 	settings.RefreshInterval = cfg.ParseTimeString(ymlConfig, "refreshInterval", defaultRefreshInterval)
 
+	if settings.processSort != sortByMemory {
+		settings.processSort = sortByCPU
+	}
+
 	return &settings
 }
@@ -0,0 +1,193 @@
+package resourceusage
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/load"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/net"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// systemStats is what the system was using at the last refresh. Rates are in bytes
+// per second, and are missing until there have been two refreshes to compare
+type systemStats struct {
+	cpuStats     []float64
+	memInfo      mem.VirtualMemoryStat
+	disks        []disk.UsageStat
+	diskIO       map[string]float64
+	network      map[string]float64
+	load         *load.AvgStat
+	temperatures []host.TemperatureStat
+}
+
+// collector gathers the stats of the sections that are shown
+type collector struct {
+	diskIO   *rateCounter
+	network  *rateCounter
+	settings *Settings
+}
+
+func newCollector(settings *Settings) *collector {
+	return &collector{
+		diskIO:   newRateCounter(),
+		network:  newRateCounter(),
+		settings: settings,
+	}
+}
+
+// collect looks up the stats. A section whose stats can't be looked up is left empty
+func (collector *collector) collect(now time.Time) systemStats {
+	settings := collector.settings
+	stats := systemStats{}
+
+	if settings.showCPU {
+		rCPUStats, err := cpu.Percent(time.Duration(0), !settings.cpuCombined)
+		if err == nil {
+			stats.cpuStats = rCPUStats
+		}
+	}
+
+	if settings.showMem || settings.showSwp {
+		rMemInfo, err := mem.VirtualMemory()
+		if err == nil {
+			stats.memInfo = *rMemInfo
+		}
+	}
+
+	if settings.showDisk {
+		stats.disks = diskUsages(settings.mounts)
+	}
+
+	if settings.showDiskIO {
+		counters, err := disk.IOCounters()
+		if err == nil {
+			totals := map[string]float64{}
+			for _, counter := range counters {
+				// Partitions and virtual devices repeat the IO of the disks they're on
+				if !isWholeDisk(counter.Name) {
+					continue
+				}
+				totals["read"] += float64(counter.ReadBytes)
+				totals["write"] += float64(counter.WriteBytes)
+			}
+			stats.diskIO = collector.diskIO.update(totals, now)
+		}
+	}
+
+	if settings.showNetwork {
+		counters, err := net.IOCounters(true)
+		if err == nil {
+			totals := map[string]float64{}
+			for _, counter := range counters {
+				if counter.Name == "lo" || strings.HasPrefix(counter.Name, "lo0") {
+					continue
+				}
+				totals["recv"] += float64(counter.BytesRecv)
+				totals["sent"] += float64(counter.BytesSent)
+			}
+			stats.network = collector.network.update(totals, now)
+		}
+	}
+
+	if settings.showLoad {
+		avg, err := load.Avg()
+		if err == nil {
+			stats.load = avg
+		}
+	}
+
+	if settings.showTemperatures {
+		// Some sensors failing to be read is reported as an error along with the others
+		temperatures, _ := host.SensorsTemperatures()
+		stats.temperatures = validTemperatures(temperatures)
+	}
+
+	return stats
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+var (
+	// partitionName matches partitions such as sda1, nvme0n1p2, mmcblk0p1 and disk0s2
+	partitionName = regexp.MustCompile(`^((sd|hd|vd|xvd)[a-z]+\d+|(nvme\d+n\d+|mmcblk\d+)p\d+|disk\d+s\d+)$`)
+
+	// virtualDevicePrefixes are the block devices that aren't backed by a disk of their
+	// own, such as loop devices, RAM disks and device mapper volumes
+	virtualDevicePrefixes = []string{"loop", "ram", "zram", "dm-"}
+)
+
+// isWholeDisk returns true if the block device is a physical disk rather than one
+// of its partitions or a virtual device
+func isWholeDisk(name string) bool {
+	for _, prefix := range virtualDevicePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return !partitionName.MatchString(name)
+}
+
+// diskUsages returns the usage of the given mount points, or of every physical disk
+// when there are none
+func diskUsages(mounts []string) []disk.UsageStat {
+	if len(mounts) == 0 {
+		partitions, err := disk.Partitions(false)
+		if err != nil {
+			return nil
+		}
+
+		devices := map[string]bool{}
+		for _, partition := range partitions {
+			// Snaps and the like are mounted read-only images, which are always full
+			if partition.Fstype == "squashfs" || devices[partition.Device] {
+				continue
+			}
+
+			devices[partition.Device] = true
+			mounts = append(mounts, partition.Mountpoint)
+		}
+	}
+
+	usages := []disk.UsageStat{}
+	for _, mount := range mounts {
+		path, err := utils.ExpandHomeDir(mount)
+		if err != nil {
+			continue
+		}
+
+		usage, err := disk.Usage(path)
+		if err != nil || usage.Total == 0 {
+			continue
+		}
+
+		usages = append(usages, *usage)
+	}
+
+	return usages
+}
+
+// validTemperatures leaves out the sensors that have no reading, and sorts the rest
+// by name
+func validTemperatures(temperatures []host.TemperatureStat) []host.TemperatureStat {
+	valid := []host.TemperatureStat{}
+
+	for _, temperature := range temperatures {
+		if temperature.Temperature > 0 {
+			valid = append(valid, temperature)
+		}
+	}
+
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].SensorKey < valid[j].SensorKey
+	})
+
+	return valid
+}
@@ -0,0 +1,17 @@
+package resourceusage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isWholeDisk(t *testing.T) {
+	for _, name := range []string{"sda", "nvme0n1", "mmcblk0", "vdb", "xvda", "disk0", "PhysicalDrive0"} {
+		assert.True(t, isWholeDisk(name), name)
+	}
+
+	for _, name := range []string{"sda1", "nvme0n1p2", "mmcblk0p1", "vdb3", "disk0s2", "loop7", "ram0", "zram0", "dm-1"} {
+		assert.False(t, isWholeDisk(name), name)
+	}
+}
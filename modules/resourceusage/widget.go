@@ -2,154 +2,131 @@ package resourceusage
 
 import (
 	"fmt"
-	"math"
+	"sync"
 	"time"
 
-	"code.cloudfoundry.org/bytefmt"
 	"github.com/rivo/tview"
-	"github.com/shirou/gopsutil/cpu"
-	"github.com/shirou/gopsutil/mem"
 	"github.com/wtfutil/wtf/view"
 )
 
 // Widget define wtf widget to register widget later
 type Widget struct {
-	settings *Settings
-	tviewApp *tview.Application
-	view.BarGraph
+	view.ScrollableWidget
+
+	collector *collector
+	err       error
+	infos     []processInfo
+	mutex     sync.Mutex
+	pages     *tview.Pages
+	processes *processCollector
+	procs     []processInfo
+	settings  *Settings
+	sortBy    string
+	stats     systemStats
+	tviewApp  *tview.Application
 }
 
 // NewWidget Make new instance of widget
-func NewWidget(tviewApp *tview.Application, redrawChan chan bool, settings *Settings) *Widget {
+func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		BarGraph: view.NewBarGraph(tviewApp, redrawChan, settings.Name, settings.Common),
-
-		tviewApp: tviewApp,
-		settings: settings,
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
+
+		collector: newCollector(settings),
+		pages:     pages,
+		processes: newProcessCollector(),
+		settings:  settings,
+		sortBy:    settings.processSort,
+		tviewApp:  tviewApp,
 	}
 
 	widget.View.SetWrap(false)
 	widget.View.SetWordWrap(false)
 
+	widget.initializeKeyboardControls()
+
+	widget.SetRenderFunction(widget.display)
+
 	return &widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
-// MakeGraph - Load the dead drop stats
-func MakeGraph(widget *Widget) {
-	cpuStats, memInfo := getDataFromSystem(widget)
-
-	var itemsCount = 0
-	if widget.settings.showCPU {
-		itemsCount += len(cpuStats)
-	}
-
-	if widget.settings.showMem {
-		itemsCount++
+// Refresh & update after interval time
+func (widget *Widget) Refresh() {
+	if widget.Disabled() {
+		return
 	}
 
-	if widget.settings.showSwp {
-		itemsCount++
-	}
+	now := time.Now()
+	stats := widget.collector.collect(now)
 
-	var stats = make([]view.Bar, itemsCount)
-	var nextIndex = 0
-
-	if widget.settings.showCPU && len(cpuStats) > 0 {
-		for i, stat := range cpuStats {
-			// Stats sometimes jump outside the 0-100 range, possibly due to timing
-			stat = math.Min(100, stat)
-			stat = math.Max(0, stat)
-
-			var label string
-			if widget.settings.cpuCombined {
-				label = "CPU"
-			} else {
-				label = fmt.Sprint(i)
-			}
-
-			bar := view.Bar{
-				Label:      label,
-				Percent:    int(stat),
-				ValueLabel: fmt.Sprintf("%d%%", int(stat)),
-				LabelColor: "red",
-			}
-
-			stats[nextIndex] = bar
-			nextIndex++
-		}
+	var infos []processInfo
+	var err error
+	if widget.settings.showProcesses {
+		infos, err = widget.processes.collect(now)
 	}
 
-	if widget.settings.showMem {
-		usedMemLabel := bytefmt.ByteSize(memInfo.Used)
-		totalMemLabel := bytefmt.ByteSize(memInfo.Total)
-
-		if usedMemLabel[len(usedMemLabel)-1] == totalMemLabel[len(totalMemLabel)-1] {
-			usedMemLabel = usedMemLabel[:len(usedMemLabel)-1]
-		}
-
-		stats[nextIndex] = view.Bar{
-			Label:      "Mem",
-			Percent:    int(memInfo.UsedPercent),
-			ValueLabel: fmt.Sprintf("%s/%s", usedMemLabel, totalMemLabel),
-			LabelColor: "green",
-		}
-		nextIndex++
-	}
+	widget.mutex.Lock()
+	widget.stats = stats
+	widget.infos = infos
+	widget.procs = topProcesses(infos, widget.sortBy, widget.settings.processCount)
+	widget.err = err
+	widget.SetItemCount(len(widget.procs))
+	widget.mutex.Unlock()
 
-	if widget.settings.showSwp {
-		swapUsed := memInfo.SwapTotal - memInfo.SwapFree
-		var swapPercent float64
-		if memInfo.SwapTotal > 0 {
-			swapPercent = float64(swapUsed) / float64(memInfo.SwapTotal)
-		}
+	widget.display()
+}
 
-		usedSwapLabel := bytefmt.ByteSize(swapUsed)
-		totalSwapLabel := bytefmt.ByteSize(memInfo.SwapTotal)
+/* -------------------- Unexported Functions -------------------- */
 
-		if usedSwapLabel[len(usedSwapLabel)-1] == totalSwapLabel[len(totalSwapLabel)-1] {
-			usedSwapLabel = usedSwapLabel[:len(usedSwapLabel)-1]
-		}
+func (widget *Widget) selectedProcess() *processInfo {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
 
-		stats[nextIndex] = view.Bar{
-			Label:      "Swp",
-			Percent:    int(swapPercent * 100),
-			ValueLabel: fmt.Sprintf("%s/%s", usedSwapLabel, totalSwapLabel),
-			LabelColor: "yellow",
-		}
+	sel := widget.GetSelected()
+	if sel < 0 || sel >= len(widget.procs) {
+		return nil
 	}
 
-	widget.BuildBars(stats)
-
+	proc := widget.procs[sel]
+	return &proc
 }
 
-// Refresh & update after interval time
-func (widget *Widget) Refresh() {
-	if widget.Disabled() {
+// killSelected kills the selected process, once the user has confirmed it
+func (widget *Widget) killSelected() {
+	proc := widget.selectedProcess()
+	if proc == nil || widget.pages == nil {
 		return
 	}
 
-	widget.View.Clear()
-	MakeGraph(widget)
-}
-
-/* -------------------- Unexported Functions -------------------- */
+	widget.ShowConfirm(fmt.Sprintf("Kill %s (%d)?", tview.Escape(proc.name), proc.pid), func() {
+		err := killProcess(proc.pid)
 
-func getDataFromSystem(widget *Widget) (cpuStats []float64, memInfo mem.VirtualMemoryStat) {
-	if widget.settings.showCPU {
-		rCPUStats, err := cpu.Percent(time.Duration(0), !widget.settings.cpuCombined)
+		// The process is dropped rather than collected again, as CPU usage measured
+		// since the last refresh would be over too short a time to mean anything
+		widget.mutex.Lock()
+		widget.err = err
 		if err == nil {
-			cpuStats = rCPUStats
+			widget.infos = withoutProcess(widget.infos, proc.pid)
+			widget.procs = topProcesses(widget.infos, widget.sortBy, widget.settings.processCount)
+			widget.SetItemCount(len(widget.procs))
 		}
-	}
+		widget.mutex.Unlock()
 
-	if widget.settings.showMem || widget.settings.showSwp {
-		rMemInfo, err := mem.VirtualMemory()
-		if err == nil {
-			memInfo = *rMemInfo
-		}
+		widget.display()
+	})
+}
+
+// toggleSort switches the processes between being sorted by CPU and by memory
+func (widget *Widget) toggleSort() {
+	widget.mutex.Lock()
+	if widget.sortBy == sortByCPU {
+		widget.sortBy = sortByMemory
+	} else {
+		widget.sortBy = sortByCPU
 	}
+	widget.procs = topProcesses(widget.infos, widget.sortBy, widget.settings.processCount)
+	widget.mutex.Unlock()
 
-	return cpuStats, memInfo
+	widget.display()
 }
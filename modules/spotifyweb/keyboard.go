@@ -14,9 +14,37 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("l", widget.selectNext, "Select next item")
 	widget.SetKeyboardChar(" ", widget.playPause, "Play/pause")
 	widget.SetKeyboardChar("s", widget.toggleShuffle, "Toggle shuffle")
+	widget.SetKeyboardChar("f", widget.search, "Search for tracks")
+	widget.SetKeyboardChar("u", widget.showQueue, "Show the queue")
+	widget.SetKeyboardChar("d", widget.showDevices, "Show the devices to play on")
+	widget.SetKeyboardChar("a", widget.addToQueue, "Add the selected track to the queue")
+	widget.SetKeyboardChar("j", widget.Next, "Select the next track or device")
+	widget.SetKeyboardChar("k", widget.Prev, "Select the previous track or device")
 
-	widget.SetKeyboardKey(tcell.KeyDown, widget.selectNext, "Select next item")
-	widget.SetKeyboardKey(tcell.KeyUp, widget.selectPrevious, "Select previous item")
+	widget.SetKeyboardKey(tcell.KeyDown, widget.down, "Select next item, or the next track or device in a list")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.up, "Select previous item, or the previous track or device in a list")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.activate, "Play the selected track, or play on the selected device")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.showPlayer, "Return to the player")
+}
+
+// down selects the next item in the player, and otherwise moves down the list
+func (widget *Widget) down() {
+	if widget.mode == modePlayer {
+		widget.selectNext()
+		return
+	}
+
+	widget.Next()
+}
+
+// up selects the previous item in the player, and otherwise moves up the list
+func (widget *Widget) up() {
+	if widget.mode == modePlayer {
+		widget.selectPrevious()
+		return
+	}
+
+	widget.Prev()
 }
 
 func (widget *Widget) selectPrevious() {
@@ -0,0 +1,76 @@
+package spotifyweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zmb3/spotify"
+)
+
+// queueURL is the endpoint of the user's queue, which the spotify client doesn't have
+// a method for
+var queueURL = "https://api.spotify.com/v1/me/player/queue"
+
+// queueItem is a track or podcast episode in the queue
+type queueItem struct {
+	Artists []struct {
+		Name string `json:"name"`
+	} `json:"artists"`
+	DurationMs int    `json:"duration_ms"`
+	Name       string `json:"name"`
+	Show       *struct {
+		Name string `json:"name"`
+	} `json:"show"`
+}
+
+// by returns the artists of a track, or the show of an episode
+func (item queueItem) by() string {
+	if item.Show != nil {
+		return item.Show.Name
+	}
+
+	names := []string{}
+	for _, artist := range item.Artists {
+		names = append(names, artist.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// fetchQueue returns the tracks that will play after the current one
+func fetchQueue(client *spotify.Client) ([]queueItem, error) {
+	// The token is refreshed by the client when it expires
+	token, err := client.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queueURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("fetching the queue failed: %s", resp.Status)
+	}
+
+	var queue struct {
+		Queue []queueItem `json:"queue"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&queue); err != nil {
+		return nil, err
+	}
+
+	return queue.Queue, nil
+}
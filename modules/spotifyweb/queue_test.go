@@ -0,0 +1,62 @@
+package spotifyweb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zmb3/spotify"
+	"golang.org/x/oauth2"
+)
+
+func Test_fetchQueue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		_, _ = w.Write([]byte(`{
+			"currently_playing": {"name": "Teardrop", "artists": [{"name": "Massive Attack"}]},
+			"queue": [
+				{"name": "Angel", "duration_ms": 379000, "artists": [{"name": "Massive Attack"}, {"name": "Horace Andy"}]},
+				{"name": "Episode 12", "show": {"name": "The Podcast"}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	original := queueURL
+	queueURL = server.URL
+	defer func() { queueURL = original }()
+
+	client := spotify.NewClient(&http.Client{
+		Transport: &oauth2.Transport{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "secret"})},
+	})
+
+	queue, err := fetchQueue(&client)
+	require.NoError(t, err)
+
+	require.Len(t, queue, 2)
+	assert.Equal(t, "Angel", queue[0].Name)
+	assert.Equal(t, "Massive Attack, Horace Andy", queue[0].by())
+	assert.Equal(t, "Episode 12", queue[1].Name)
+	assert.Equal(t, "The Podcast", queue[1].by())
+}
+
+func Test_fetchQueue_status(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	original := queueURL
+	queueURL = server.URL
+	defer func() { queueURL = original }()
+
+	client := spotify.NewClient(&http.Client{
+		Transport: &oauth2.Transport{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "secret"})},
+	})
+
+	_, err := fetchQueue(&client)
+	assert.EqualError(t, err, "fetching the queue failed: 401 Unauthorized")
+}
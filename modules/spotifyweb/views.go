@@ -0,0 +1,192 @@
+package spotifyweb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/zmb3/spotify"
+)
+
+const (
+	modePlayer  = "player"
+	modeDevices = "devices"
+	modeQueue   = "queue"
+	modeSearch  = "search"
+
+	// searchLimit is how many tracks a search returns
+	searchLimit = 20
+)
+
+// listOutput displays the search results, the queue or the devices, with the selected
+// one highlighted
+func (w *Widget) listOutput() (string, string, bool) {
+	title := w.CommonSettings().Title
+	rows := []string{}
+
+	switch w.mode {
+	case modeSearch:
+		title += " - Search"
+		for _, track := range w.results {
+			rows = append(rows, fmt.Sprintf("%s [grey]%s[white]", tview.Escape(track.Name), tview.Escape(artistNames(track.Artists))))
+		}
+	case modeQueue:
+		title += " - Queue"
+		for _, item := range w.queue {
+			rows = append(rows, fmt.Sprintf("%s [grey]%s[white]", tview.Escape(item.Name), tview.Escape(item.by())))
+		}
+	case modeDevices:
+		title += " - Devices"
+		for _, device := range w.devices {
+			row := fmt.Sprintf("%s [grey]%s[white]", tview.Escape(device.Name), device.Type)
+			if device.Active {
+				row += " [green](playing)[white]"
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	w.SetItemCount(len(rows))
+
+	if len(rows) == 0 {
+		return title, "Nothing to display" + w.statusLine(), false
+	}
+
+	str := ""
+	for idx, row := range rows {
+		line := fmt.Sprintf("[%s]%2d. %s", w.RowColor(idx), idx+1, row)
+		str += utils.HighlightableHelper(w.View, line, idx, len(row))
+	}
+
+	return title, str + w.statusLine(), false
+}
+
+// statusLine displays the outcome of the last action
+func (w *Widget) statusLine() string {
+	switch {
+	case w.actionErr != nil:
+		return fmt.Sprintf("\n[red]%s[white]\n", tview.Escape(w.actionErr.Error()))
+	case w.message != "":
+		return fmt.Sprintf("\n[green]%s[white]\n", tview.Escape(w.message))
+	default:
+		return ""
+	}
+}
+
+// showMode switches to a view and loads what it displays
+func (w *Widget) showMode(mode string) {
+	w.mode = mode
+	w.actionErr = nil
+	w.message = ""
+	w.Selected = 0
+	if mode == modePlayer {
+		w.Selected = -1
+	}
+
+	w.Refresh()
+}
+
+func (w *Widget) showPlayer() {
+	w.showMode(modePlayer)
+}
+
+func (w *Widget) showQueue() {
+	w.showMode(modeQueue)
+}
+
+func (w *Widget) showDevices() {
+	w.showMode(modeDevices)
+}
+
+// search asks for what to search for and displays the tracks that match it
+func (w *Widget) search() {
+	if w.client == nil {
+		return
+	}
+
+	w.ShowInputForm("Search:", func(query string) {
+		if query == "" {
+			return
+		}
+
+		limit := searchLimit
+		result, err := w.client.SearchOpt(query, spotify.SearchTypeTrack, &spotify.Options{Limit: &limit})
+
+		w.results = nil
+		if err == nil && result.Tracks != nil {
+			w.results = result.Tracks.Tracks
+		}
+
+		w.showMode(modeSearch)
+		w.actionErr = err
+		w.display()
+	})
+}
+
+// activate plays the selected search result or moves playback to the selected device
+func (w *Widget) activate() {
+	switch w.mode {
+	case modeSearch:
+		track := w.selectedTrack()
+		if track == nil {
+			return
+		}
+
+		w.act(w.client.PlayOpt(&spotify.PlayOptions{URIs: []spotify.URI{track.URI}}), "")
+		if w.actionErr == nil {
+			w.showPlayer()
+		}
+	case modeDevices:
+		if w.Selected < 0 || w.Selected >= len(w.devices) {
+			return
+		}
+
+		device := w.devices[w.Selected]
+		playing := w.playerState != nil && w.playerState.Playing
+
+		w.act(w.client.TransferPlayback(device.ID, playing), "Playing on "+device.Name)
+	}
+}
+
+// addToQueue queues the selected search result
+func (w *Widget) addToQueue() {
+	track := w.selectedTrack()
+	if track == nil {
+		return
+	}
+
+	w.act(w.client.QueueSong(track.ID), "Added "+track.Name+" to the queue")
+}
+
+func (w *Widget) selectedTrack() *spotify.FullTrack {
+	if w.mode != modeSearch || w.Selected < 0 || w.Selected >= len(w.results) {
+		return nil
+	}
+
+	return &w.results[w.Selected]
+}
+
+// act shows the outcome of an action once Spotify has had time to carry it out
+func (w *Widget) act(err error, message string) {
+	w.actionErr = err
+	w.message = ""
+	if err == nil {
+		w.message = message
+	}
+
+	time.Sleep(time.Millisecond * 500)
+	w.Refresh()
+}
+
+func artistNames(artists []spotify.SimpleArtist) string {
+	names := ""
+	for i, artist := range artists {
+		if i > 0 {
+			names += ", "
+		}
+		names += artist.Name
+	}
+
+	return names
+}
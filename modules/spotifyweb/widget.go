@@ -33,14 +33,23 @@ type Info struct {
 
 // Widget is the struct used by all WTF widgets to transfer to the main widget controller
 type Widget struct {
-	view.TextWidget
+	view.ScrollableWidget
 
 	Info
 
+	actionErr   error
 	client      *spotify.Client
 	clientChan  chan *spotify.Client
+	devices     []spotify.PlayerDevice
+	err         error
+	message     string
+	mode        string
+	pages       *tview.Pages
 	playerState *spotify.PlayerState
+	queue       []queueItem
+	results     []spotify.FullTrack
 	settings    *Settings
+	tviewApp    *tview.Application
 }
 
 func authHandler(w http.ResponseWriter, r *http.Request) {
@@ -72,14 +81,17 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	var playerState *spotify.PlayerState
 
 	widget := Widget{
-		TextWidget: view.NewTextWidget(tviewApp, redrawChan, pages, settings.Common),
+		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
 		Info: Info{},
 
 		client:      client,
 		clientChan:  tempClientChan,
+		mode:        modePlayer,
+		pages:       pages,
 		playerState: playerState,
 		settings:    settings,
+		tviewApp:    tviewApp,
 	}
 
 	http.HandleFunc("/callback", authHandler)
@@ -123,6 +135,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	widget.settings.RefreshInterval = 5 * time.Second
 
 	widget.initializeKeyboardControls()
+	widget.SetRenderFunction(widget.display)
 
 	widget.View.SetWrap(true)
 	widget.View.SetWordWrap(true)
@@ -158,16 +171,41 @@ func (w *Widget) refreshSpotifyInfos() error {
 
 // Refresh refreshes the current view of the widget
 func (w *Widget) Refresh() {
+	w.err = w.fetch()
+	w.display()
+}
+
+// fetch loads what the current view displays. Search results are only loaded when
+// searching
+func (w *Widget) fetch() error {
+	err := w.refreshSpotifyInfos()
+	if err != nil {
+		return err
+	}
+
+	switch w.mode {
+	case modeDevices:
+		w.devices, err = w.client.PlayerDevices()
+	case modeQueue:
+		w.queue, err = fetchQueue(w.client)
+	}
+
+	return err
+}
+
+func (w *Widget) display() {
 	w.Redraw(w.createOutput)
 }
 
 func (w *Widget) createOutput() (string, string, bool) {
 	var output string
 
-	err := w.refreshSpotifyInfos()
-	if err != nil {
-		output = err.Error()
-	} else {
+	switch {
+	case w.err != nil:
+		output = w.err.Error()
+	case w.mode != modePlayer:
+		return w.listOutput()
+	default:
 		output += utils.CenterText(fmt.Sprintf("[green]Now %v [white]\n", w.Status), w.CommonSettings().Width)
 		output += utils.CenterText(fmt.Sprintf("[green]Title:[white] %v\n", w.Title), w.CommonSettings().Width)
 		output += utils.CenterText(fmt.Sprintf("[green]Artist:[white] %v\n", w.Artists), w.CommonSettings().Width)
@@ -177,6 +215,7 @@ func (w *Widget) createOutput() (string, string, bool) {
 		} else {
 			output += utils.CenterText("[green]Shuffle:[white] off\n", w.CommonSettings().Width)
 		}
+		output += w.statusLine()
 	}
 	return w.CommonSettings().Title, output, true
 }
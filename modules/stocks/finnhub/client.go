@@ -5,8 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
 )
 
+// candleDays is how far back intraday candles go, so that the last session is found
+// over weekends and holidays
+const candleDays = 5
+
 // Client ..
 type Client struct {
 	symbols []string
@@ -25,56 +33,100 @@ func NewClient(symbols []string, apiKey string) *Client {
 
 // Getquote ..
 func (client *Client) Getquote() ([]Quote, error) {
+	return client.quotes(client.symbols)
+}
+
+// Candles returns the prices of a symbol during its last session, in five minute
+// intervals
+func (client *Client) Candles(symbol string) ([]float64, error) {
+	end := time.Now()
+	start := end.AddDate(0, 0, -candleDays)
+
+	params := url.Values{}
+	params.Add("symbol", symbol)
+	params.Add("resolution", "5")
+	params.Add("from", strconv.FormatInt(start.Unix(), 10))
+	params.Add("to", strconv.FormatInt(end.Unix(), 10))
+
+	var candles Candles
+	if err := client.finnhubRequest("stock/candle", params, &candles); err != nil {
+		return nil, err
+	}
+
+	if candles.S != "ok" {
+		return nil, fmt.Errorf("no candles for %s", symbol)
+	}
+
+	// Finnhub doesn't say which time zone the exchange is in, so sessions are split at
+	// midnight UTC
+	return portfolio.LastSession(candles.T, candles.C, 0), nil
+}
+
+// ExchangeRate returns how much one unit of a currency is worth in another
+func (client *Client) ExchangeRate(from, to string) (float64, error) {
+	params := url.Values{}
+	params.Add("base", from)
+
+	var rates Rates
+	if err := client.finnhubRequest("forex/rates", params, &rates); err != nil {
+		return 0, err
+	}
+
+	rate, ok := rates.Quote[to]
+	if !ok {
+		return 0, fmt.Errorf("no rate for %s", to)
+	}
+
+	return rate, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+var (
+	finnhubURL = &url.URL{Scheme: "https", Host: "finnhub.io", Path: "/api/v1/"}
+)
+
+func (client *Client) quotes(symbols []string) ([]Quote, error) {
 	quotes := []Quote{}
 
-	for _, s := range client.symbols {
-		resp, err := client.finnhubRequest(s)
-		if err != nil {
-			return quotes, err
-		}
+	for _, s := range symbols {
+		params := url.Values{}
+		params.Add("symbol", s)
 
 		var quote Quote
-		quote.Stock = s
-		err = json.NewDecoder(resp.Body).Decode(&quote)
-		if err != nil {
+		if err := client.finnhubRequest("quote", params, &quote); err != nil {
 			return quotes, err
 		}
+
+		quote.Stock = s
 		quotes = append(quotes, quote)
 	}
 
 	return quotes, nil
 }
 
-/* -------------------- Unexported Functions -------------------- */
-
-var (
-	finnhubURL = &url.URL{Scheme: "https", Host: "finnhub.io", Path: "/api/v1/quote"}
-)
-
-func (client *Client) finnhubRequest(symbol string) (*http.Response, error) {
-	params := url.Values{}
-	params.Add("symbol", symbol)
+func (client *Client) finnhubRequest(path string, params url.Values, result interface{}) error {
 	params.Add("token", client.apiKey)
 
-	url := finnhubURL.ResolveReference(&url.URL{RawQuery: params.Encode()})
+	url := finnhubURL.ResolveReference(&url.URL{Path: path, RawQuery: params.Encode()})
 
 	req, err := http.NewRequest("GET", url.String(), http.NoBody)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
 	if err != nil {
-		return nil, err
+		return err
 	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
 
 	httpClient := &http.Client{}
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, fmt.Errorf("%s", resp.Status)
+		return fmt.Errorf("%s", resp.Status)
 	}
 
-	return resp, nil
+	return json.NewDecoder(resp.Body).Decode(result)
 }
@@ -0,0 +1,92 @@
+package finnhub
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	original := finnhubURL
+	finnhubURL, _ = url.Parse(server.URL + "/api/v1/")
+	t.Cleanup(func() { finnhubURL = original })
+
+	return NewClient([]string{"AAPL"}, "secret")
+}
+
+func Test_Getquote(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/quote", r.URL.Path)
+		assert.Equal(t, "AAPL", r.URL.Query().Get("symbol"))
+		assert.Equal(t, "secret", r.URL.Query().Get("token"))
+
+		_, _ = w.Write([]byte(`{"c": 201.5, "d": 1.5, "dp": 0.75, "o": 200, "pc": 200}`))
+	})
+
+	quotes, err := client.Getquote()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Quote{{C: 201.5, D: 1.5, Dp: 0.75, O: 200, Pc: 200, Stock: "AAPL"}}, quotes)
+}
+
+func Test_Candles(t *testing.T) {
+	friday := time.Date(2026, 10, 16, 19, 55, 0, 0, time.UTC).Unix()
+	monday := time.Date(2026, 10, 19, 13, 30, 0, 0, time.UTC).Unix()
+
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/stock/candle", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("resolution"))
+
+		_, _ = w.Write([]byte(`{"s": "ok", "c": [10, 11, 12], "t": [` +
+			strconv.FormatInt(friday, 10) + `,` + strconv.FormatInt(monday, 10) + `,` + strconv.FormatInt(monday+300, 10) + `]}`))
+	})
+
+	prices, err := client.Candles("AAPL")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{11, 12}, prices)
+}
+
+func Test_Candles_noData(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"s": "no_data"}`))
+	})
+
+	_, err := client.Candles("AAPL")
+
+	assert.EqualError(t, err, "no candles for AAPL")
+}
+
+func Test_ExchangeRate(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/forex/rates", r.URL.Path)
+		assert.Equal(t, "EUR", r.URL.Query().Get("base"))
+
+		_, _ = w.Write([]byte(`{"base": "EUR", "quote": {"USD": 1.08, "GBP": 0.87}}`))
+	})
+
+	rate, err := client.ExchangeRate("EUR", "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.08, rate)
+
+	_, err = client.ExchangeRate("EUR", "JPY")
+	assert.EqualError(t, err, "no rate for JPY")
+}
+
+func Test_finnhubRequest_status(t *testing.T) {
+	client := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.ExchangeRate("EUR", "USD")
+
+	assert.EqualError(t, err, "403 Forbidden")
+}
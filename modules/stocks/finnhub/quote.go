@@ -2,6 +2,8 @@ package finnhub
 
 type Quote struct {
 	C  float64 `json:"c"`
+	D  float64 `json:"d"`
+	Dp float64 `json:"dp"`
 	H  float64 `json:"h"`
 	L  float64 `json:"l"`
 	O  float64 `json:"o"`
@@ -10,3 +12,16 @@ type Quote struct {
 
 	Stock string
 }
+
+// Candles are the prices of a stock over time, as returned by the candle endpoint
+type Candles struct {
+	C []float64 `json:"c"`
+	T []int64   `json:"t"`
+	S string    `json:"s"`
+}
+
+// Rates are the exchange rates of a base currency, keyed by currency
+type Rates struct {
+	Base  string             `json:"base"`
+	Quote map[string]float64 `json:"quote"`
+}
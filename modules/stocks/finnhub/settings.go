@@ -2,9 +2,11 @@ package finnhub
 
 import (
 	"os"
+	"strings"
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -17,8 +19,11 @@ const (
 type Settings struct {
 	*cfg.Common
 
-	apiKey  string   `help:"Your finnhub API token."`
-	symbols []string `help:"An array of stocks symbols (i.e. AAPL, MSFT)"`
+	apiKey         string              `help:"Your finnhub API token."`
+	baseCurrency   string              `help:"The currency that the portfolio totals are converted to." optional:"true"`
+	holdings       []portfolio.Holding `help:"An array of holdings, each with a symbol, quantity, costBasis and optional currency, which is also the currency of the stock's price and defaults to USD. When set, the portfolio is shown instead of the symbols." optional:"true"`
+	showSparklines bool                `help:"Whether or not to show intraday sparklines from the candle endpoint." optional:"true"`
+	symbols        []string            `help:"An array of stocks symbols (i.e. AAPL, MSFT)"`
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -27,8 +32,11 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := Settings{
 		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
-		apiKey:  ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_FINNHUB_API_KEY"))),
-		symbols: utils.ToStrs(ymlConfig.UList("symbols")),
+		apiKey:         ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_FINNHUB_API_KEY"))),
+		baseCurrency:   strings.ToUpper(ymlConfig.UString("baseCurrency", "USD")),
+		holdings:       portfolio.HoldingsFromYAML(ymlConfig),
+		showSparklines: ymlConfig.UBool("showSparklines", false),
+		symbols:        utils.ToStrs(ymlConfig.UList("symbols")),
 	}
 
 	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).Load()
@@ -5,9 +5,18 @@ import (
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
 	"github.com/wtfutil/wtf/view"
 )
 
+const (
+	// defaultCurrency is the currency of the prices of holdings without a currency
+	defaultCurrency = "USD"
+
+	// sparklineWidth is how many bars the intraday sparklines of quotes have
+	sparklineWidth = 20
+)
+
 // Widget ..
 type Widget struct {
 	view.TextWidget
@@ -41,21 +50,93 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if len(widget.settings.holdings) > 0 {
+		return widget.portfolioContent()
+	}
+
 	quotes, err := widget.Getquote()
 
-	title := widget.CommonSettings().Title
 	t := table.NewWriter()
-	t.AppendHeader(table.Row{"#", "Stock", "Current Price", "Open Price", "Change"})
+	header := table.Row{"#", "Stock", "Current Price", "Open Price", "Change"}
+	if widget.settings.showSparklines {
+		header = append(header, "Today")
+	}
+	t.AppendHeader(header)
+
 	wrap := false
 	if err != nil {
 		wrap = true
 	} else {
 		for idx, q := range quotes {
-			t.AppendRows([]table.Row{
-				{idx, q.Stock, q.C, q.O, fmt.Sprintf("%.4f", (q.C-q.O)/q.C)},
-			})
+			row := table.Row{idx, q.Stock, q.C, q.O, fmt.Sprintf("%.4f", (q.C-q.O)/q.C)}
+			if widget.settings.showSparklines {
+				row = append(row, widget.sparkline(q.Stock))
+			}
+
+			t.AppendRows([]table.Row{row})
 		}
 	}
 
 	return title, t.Render(), wrap
 }
+
+// portfolioContent displays the value and profits of the holdings
+func (widget *Widget) portfolioContent() (string, string, bool) {
+	title := widget.CommonSettings().Title
+	symbols := portfolio.Symbols(widget.settings.holdings)
+
+	quotes, err := widget.quotes(symbols)
+	if err != nil {
+		return title, err.Error(), true
+	}
+
+	// Quotes don't have a currency, so prices are taken to be in the currency of the
+	// holding
+	currencies := map[string]string{}
+	for _, holding := range widget.settings.holdings {
+		currencies[holding.Symbol] = holding.Currency
+		if holding.Currency == "" {
+			currencies[holding.Symbol] = defaultCurrency
+		}
+	}
+
+	quoted := map[string]*portfolio.Quote{}
+	charts := map[string][]float64{}
+	for _, q := range quotes {
+		quoted[q.Stock] = &portfolio.Quote{
+			Symbol:    q.Stock,
+			Currency:  currencies[q.Stock],
+			Price:     q.C,
+			Change:    q.D,
+			ChangePct: q.Dp,
+		}
+
+		if widget.settings.showSparklines {
+			charts[q.Stock], _ = widget.Candles(q.Stock)
+		}
+	}
+
+	holdings := portfolio.New(widget.settings.holdings, quoted, widget.settings.baseCurrency, widget.ExchangeRate)
+
+	return title, holdings.Render(charts, trendColor), false
+}
+
+func (widget *Widget) sparkline(symbol string) string {
+	prices, err := widget.Candles(symbol)
+	if err != nil {
+		return ""
+	}
+
+	return portfolio.Sparkline(prices, sparklineWidth)
+}
+
+// trendColor colors gains green and losses red
+func trendColor(pct float64) string {
+	if pct < 0 {
+		return "red"
+	}
+
+	return "green"
+}
@@ -0,0 +1,146 @@
+package portfolio
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// sparklineWidth is how many bars the sparklines of positions have
+const sparklineWidth = 20
+
+// sparkTicks are the bars of a sparkline, from the lowest to the highest value
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// ColorFunc returns the color of a change by how large it is, in percent
+type ColorFunc func(pct float64) string
+
+// Render displays the positions of the portfolio followed by its totals. Positions
+// with prices in sparklines, which are keyed by symbol, show how their price changed
+// during the day
+func (portfolio *Portfolio) Render(sparklines map[string][]float64, color ColorFunc) string {
+	width := len("Total")
+	for _, position := range portfolio.Positions {
+		width = max(width, len(position.Symbol))
+	}
+
+	str := fmt.Sprintf(
+		"%-*s %10s %16s %18s %18s %6s\n",
+		width, "Symbol", "Qty", "Value", "Day", "Total", "Alloc",
+	)
+
+	for _, position := range portfolio.Positions {
+		if position.Err != nil {
+			str += fmt.Sprintf("%-*s [red]%s[white]\n", width, position.Symbol, tview.Escape(position.Err.Error()))
+			continue
+		}
+
+		str += fmt.Sprintf(
+			"%-*s %10s %12.2f %-3s %s %s %5.1f%% %s\n",
+			width,
+			position.Symbol,
+			formatQuantity(position.Quantity),
+			position.Value,
+			position.Currency,
+			change(position.DailyPL, position.DailyPLPct(), color),
+			totalChange(position, color),
+			position.Allocation,
+			Sparkline(sparklines[position.Symbol], sparklineWidth),
+		)
+	}
+
+	str += fmt.Sprintf(
+		"\n%-*s %10s %12.2f %-3s %s %s\n",
+		width,
+		"Total",
+		"",
+		portfolio.Value,
+		portfolio.Currency,
+		change(portfolio.DailyPL, portfolio.DailyPLPct(), color),
+		change(portfolio.TotalPL, portfolio.TotalPLPct(), color),
+	)
+
+	return str
+}
+
+// Sparkline draws values as a line of bars, scaled between the lowest and the highest
+// value. When there are more values than width, neighbouring values are averaged so
+// that it fits. A width of zero draws every value
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	if width > 0 && len(values) > width {
+		values = resample(values, width)
+	}
+
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = math.Min(low, value)
+		high = math.Max(high, value)
+	}
+
+	var line strings.Builder
+	for _, value := range values {
+		tick := len(sparkTicks) / 2
+		if high > low {
+			tick = int((value - low) / (high - low) * float64(len(sparkTicks)-1))
+		}
+
+		line.WriteRune(sparkTicks[tick])
+	}
+
+	return line.String()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// change displays an amount and its percentage, colored by the percentage
+func change(amount, pct float64, color ColorFunc) string {
+	str := fmt.Sprintf("%+10.2f %+6.2f%%", amount, pct)
+
+	if color == nil {
+		return str
+	}
+
+	return fmt.Sprintf("[%s]%s[white]", color(pct), str)
+}
+
+// totalChange displays the total profit or loss of a position, if its cost is known
+func totalChange(position *Position, color ColorFunc) string {
+	if !position.HasCost() {
+		return fmt.Sprintf("%18s", "")
+	}
+
+	return change(position.TotalPL, position.TotalPLPct(), color)
+}
+
+// formatQuantity leaves out the decimals of whole numbers of shares
+func formatQuantity(quantity float64) string {
+	if quantity == math.Trunc(quantity) {
+		return fmt.Sprintf("%.0f", quantity)
+	}
+
+	return fmt.Sprintf("%.4g", quantity)
+}
+
+// resample averages values into width buckets
+func resample(values []float64, width int) []float64 {
+	sampled := make([]float64, width)
+
+	for i := range sampled {
+		from := i * len(values) / width
+		to := (i + 1) * len(values) / width
+
+		sum := 0.0
+		for _, value := range values[from:to] {
+			sum += value
+		}
+		sampled[i] = sum / float64(to-from)
+	}
+
+	return sampled
+}
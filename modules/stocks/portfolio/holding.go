@@ -0,0 +1,78 @@
+package portfolio
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// Holding is a number of shares of a stock, bought at an average cost per share
+type Holding struct {
+	Symbol    string
+	Quantity  float64
+	CostBasis float64
+
+	// Currency is the currency that the cost basis is in. When it's empty, the cost
+	// basis is in the currency that the stock is quoted in
+	Currency string
+
+	// Address is a wallet that holds the coins, for modules that look up the quantity
+	// from its balance
+	Address string
+}
+
+// HoldingsFromYAML reads the holdings of a module's config:
+//
+//	holdings:
+//	  - symbol: AAPL
+//	    quantity: 10
+//	    costBasis: 142.50
+//	    currency: USD
+//	  - symbol: BTC
+//	    address: bc1q...
+//
+// The cost basis is optional. Holdings without a symbol are skipped
+func HoldingsFromYAML(ymlConfig *config.Config) []Holding {
+	holdings := []Holding{}
+
+	for i := range ymlConfig.UList("holdings") {
+		entry, err := ymlConfig.Get(fmt.Sprintf("holdings.%d", i))
+		if err != nil {
+			continue
+		}
+
+		holding := Holding{
+			Symbol:    strings.TrimSpace(entry.UString("symbol")),
+			Quantity:  entry.UFloat64("quantity"),
+			CostBasis: entry.UFloat64("costBasis", entry.UFloat64("costbasis")),
+			Currency:  strings.ToUpper(strings.TrimSpace(entry.UString("currency"))),
+			Address:   strings.TrimSpace(entry.UString("address")),
+		}
+
+		if holding.Symbol == "" {
+			continue
+		}
+
+		holdings = append(holdings, holding)
+	}
+
+	return holdings
+}
+
+// Symbols returns the symbols of the holdings, once each
+func Symbols(holdings []Holding) []string {
+	seen := map[string]bool{}
+	symbols := []string{}
+
+	for _, holding := range holdings {
+		if seen[holding.Symbol] {
+			continue
+		}
+
+		seen[holding.Symbol] = true
+		symbols = append(symbols, holding.Symbol)
+	}
+
+	return symbols
+}
@@ -0,0 +1,206 @@
+package portfolio
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Quote is the price of a stock and how much it has changed since the previous close
+type Quote struct {
+	Symbol    string
+	Currency  string
+	Price     float64
+	Change    float64
+	ChangePct float64
+}
+
+// RateFunc returns how much one unit of the from currency is worth in the to currency
+type RateFunc func(from, to string) (float64, error)
+
+// Position is a holding valued at the current price of its stock. Its value and
+// profits are in the currency of the holding
+type Position struct {
+	Holding
+
+	Quote *Quote
+
+	Value      float64
+	DailyPL    float64
+	TotalPL    float64
+	Allocation float64
+
+	// Err is why the position can't be valued
+	Err error
+}
+
+// HasCost returns whether the cost of the position is known, and so its total profit
+// or loss
+func (position *Position) HasCost() bool {
+	return position.CostBasis > 0
+}
+
+// TotalPLPct returns the total profit or loss of the position as a percentage of
+// its cost
+func (position *Position) TotalPLPct() float64 {
+	return percentage(position.TotalPL, position.Quantity*position.CostBasis)
+}
+
+// DailyPLPct returns the daily profit or loss of the position as a percentage of
+// its value at the previous close
+func (position *Position) DailyPLPct() float64 {
+	return percentage(position.DailyPL, position.Value-position.DailyPL)
+}
+
+// Portfolio is the positions of a set of holdings, with totals in a base currency
+type Portfolio struct {
+	Currency  string
+	Positions []*Position
+
+	Value   float64
+	DailyPL float64
+
+	// Cost and TotalPL only include the positions with a known cost
+	Cost    float64
+	TotalPL float64
+}
+
+// TotalPLPct returns the total profit or loss of the portfolio as a percentage of
+// its cost
+func (portfolio *Portfolio) TotalPLPct() float64 {
+	return percentage(portfolio.TotalPL, portfolio.Cost)
+}
+
+// DailyPLPct returns the daily profit or loss of the portfolio as a percentage of
+// its value at the previous close
+func (portfolio *Portfolio) DailyPLPct() float64 {
+	return percentage(portfolio.DailyPL, portfolio.Value-portfolio.DailyPL)
+}
+
+// New values the holdings at the prices of quotes, which are keyed by symbol, and
+// converts the totals to the base currency with rate. Positions that can't be valued
+// or converted have an Err, and are left out of the totals and allocations. Positions
+// without a cost basis are left out of the total profit or loss
+func New(holdings []Holding, quotes map[string]*Quote, base string, rate RateFunc) *Portfolio {
+	portfolio := &Portfolio{Currency: base}
+	converter := newConverter(rate)
+
+	baseValues := make([]float64, len(holdings))
+
+	for i, holding := range holdings {
+		position := &Position{Holding: holding, Quote: quotes[holding.Symbol]}
+		portfolio.Positions = append(portfolio.Positions, position)
+
+		if position.Quote == nil {
+			position.Err = fmt.Errorf("no quote for %s", holding.Symbol)
+			continue
+		}
+
+		if position.Currency == "" {
+			position.Currency = position.Quote.Currency
+		}
+
+		// The price is converted first, so that the cost basis can be in another
+		// currency than the stock is traded in
+		priceRate, err := converter.rate(position.Quote.Currency, position.Currency)
+		if err != nil {
+			position.Err = err
+			continue
+		}
+
+		position.Value = holding.Quantity * position.Quote.Price * priceRate
+		position.DailyPL = holding.Quantity * position.Quote.Change * priceRate
+		if position.HasCost() {
+			position.TotalPL = position.Value - holding.Quantity*holding.CostBasis
+		}
+
+		baseRate, err := converter.rate(position.Currency, base)
+		if err != nil {
+			position.Err = err
+			continue
+		}
+
+		baseValues[i] = position.Value * baseRate
+
+		portfolio.Value += position.Value * baseRate
+		portfolio.DailyPL += position.DailyPL * baseRate
+		if position.HasCost() {
+			portfolio.Cost += holding.Quantity * holding.CostBasis * baseRate
+			portfolio.TotalPL += position.TotalPL * baseRate
+		}
+	}
+
+	for i, position := range portfolio.Positions {
+		if position.Err == nil {
+			position.Allocation = percentage(baseValues[i], portfolio.Value)
+		}
+	}
+
+	return portfolio
+}
+
+// Sort orders the positions by allocation, largest first. Positions that can't be
+// valued are last
+func (portfolio *Portfolio) Sort() {
+	sort.SliceStable(portfolio.Positions, func(i, j int) bool {
+		return portfolio.Positions[i].Allocation > portfolio.Positions[j].Allocation
+	})
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// converter looks up each exchange rate only once
+type converter struct {
+	errs  map[string]error
+	fetch RateFunc
+	rates map[string]float64
+}
+
+func newConverter(rate RateFunc) *converter {
+	return &converter{errs: map[string]error{}, fetch: rate, rates: map[string]float64{}}
+}
+
+func (conv *converter) rate(from, to string) (float64, error) {
+	if from == "" || to == "" || from == to {
+		return 1, nil
+	}
+
+	key := from + "/" + to
+	if rate, ok := conv.rates[key]; ok {
+		return rate, conv.errs[key]
+	}
+
+	rate, err := conv.lookup(from, to)
+	if err != nil {
+		err = fmt.Errorf("can't convert %s to %s: %w", from, to, err)
+	}
+
+	conv.rates[key] = rate
+	conv.errs[key] = err
+
+	return rate, err
+}
+
+func (conv *converter) lookup(from, to string) (float64, error) {
+	if conv.fetch == nil {
+		return 0, fmt.Errorf("no exchange rates")
+	}
+
+	rate, err := conv.fetch(from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if rate <= 0 {
+		return 0, fmt.Errorf("invalid rate %v", rate)
+	}
+
+	return rate, nil
+}
+
+func percentage(part, whole float64) float64 {
+	if whole == 0 {
+		return 0
+	}
+
+	return part / whole * 100
+}
@@ -0,0 +1,178 @@
+package portfolio
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_HoldingsFromYAML(t *testing.T) {
+	ymlConfig, err := config.ParseYaml(`
+holdings:
+  - symbol: AAPL
+    quantity: 10
+    costBasis: 142.5
+  - symbol: SAP.DE
+    quantity: 2.5
+    costBasis: "120"
+    currency: eur
+  - quantity: 3
+  - symbol: AAPL
+    quantity: 5
+    costBasis: 180
+`)
+	assert.NoError(t, err)
+
+	holdings := HoldingsFromYAML(ymlConfig)
+
+	assert.Equal(t, []Holding{
+		{Symbol: "AAPL", Quantity: 10, CostBasis: 142.5},
+		{Symbol: "SAP.DE", Quantity: 2.5, CostBasis: 120, Currency: "EUR"},
+		{Symbol: "AAPL", Quantity: 5, CostBasis: 180},
+	}, holdings)
+	assert.Equal(t, []string{"AAPL", "SAP.DE"}, Symbols(holdings))
+}
+
+func Test_New(t *testing.T) {
+	holdings := []Holding{
+		{Symbol: "AAPL", Quantity: 10, CostBasis: 150},
+		{Symbol: "SAP.DE", Quantity: 4, CostBasis: 100, Currency: "EUR"},
+		{Symbol: "ASML", Quantity: 1, CostBasis: 500, Currency: "EUR"},
+		{Symbol: "MISSING", Quantity: 1, CostBasis: 1},
+	}
+
+	quotes := map[string]*Quote{
+		"AAPL":   {Symbol: "AAPL", Currency: "USD", Price: 200, Change: -2},
+		"SAP.DE": {Symbol: "SAP.DE", Currency: "EUR", Price: 125, Change: 5},
+		// The cost basis is in euros, but the stock is quoted in dollars
+		"ASML": {Symbol: "ASML", Currency: "USD", Price: 1000, Change: 10},
+	}
+
+	lookups := 0
+	rate := func(from, to string) (float64, error) {
+		lookups++
+		if from == "EUR" && to == "USD" {
+			return 1.25, nil
+		}
+		if from == "USD" && to == "EUR" {
+			return 0.8, nil
+		}
+		return 0, fmt.Errorf("unknown")
+	}
+
+	portfolio := New(holdings, quotes, "USD", rate)
+
+	aapl := portfolio.Positions[0]
+	assert.NoError(t, aapl.Err)
+	assert.Equal(t, "USD", aapl.Currency)
+	assert.InDelta(t, 2000, aapl.Value, 0.001)
+	assert.InDelta(t, -20, aapl.DailyPL, 0.001)
+	assert.InDelta(t, 500, aapl.TotalPL, 0.001)
+	assert.InDelta(t, 33.333, aapl.TotalPLPct(), 0.001)
+
+	sap := portfolio.Positions[1]
+	assert.NoError(t, sap.Err)
+	assert.InDelta(t, 500, sap.Value, 0.001)
+	assert.InDelta(t, 20, sap.DailyPL, 0.001)
+	assert.InDelta(t, 100, sap.TotalPL, 0.001)
+
+	asml := portfolio.Positions[2]
+	assert.NoError(t, asml.Err)
+	assert.Equal(t, "EUR", asml.Currency)
+	assert.InDelta(t, 800, asml.Value, 0.001)
+	assert.InDelta(t, 300, asml.TotalPL, 0.001)
+
+	assert.EqualError(t, portfolio.Positions[3].Err, "no quote for MISSING")
+
+	// 2000 USD + 500 EUR + 800 EUR
+	assert.InDelta(t, 3625, portfolio.Value, 0.001)
+	assert.InDelta(t, -20+20*1.25+8*1.25, portfolio.DailyPL, 0.001)
+	assert.InDelta(t, 500+100*1.25+300*1.25, portfolio.TotalPL, 0.001)
+	assert.InDelta(t, 2000/3625.0*100, aapl.Allocation, 0.001)
+	assert.InDelta(t, 625/3625.0*100, sap.Allocation, 0.001)
+	assert.Zero(t, portfolio.Positions[3].Allocation)
+
+	assert.Equal(t, 2, lookups)
+
+	portfolio.Sort()
+	assert.Equal(t, "AAPL", portfolio.Positions[0].Symbol)
+	assert.Equal(t, "ASML", portfolio.Positions[1].Symbol)
+	assert.Equal(t, "MISSING", portfolio.Positions[3].Symbol)
+}
+
+func Test_New_failedRate(t *testing.T) {
+	holdings := []Holding{
+		{Symbol: "SAP.DE", Quantity: 1, CostBasis: 100},
+		{Symbol: "BMW.DE", Quantity: 1, CostBasis: 50},
+		{Symbol: "AAPL", Quantity: 1, CostBasis: 100},
+	}
+
+	quotes := map[string]*Quote{
+		"SAP.DE": {Symbol: "SAP.DE", Currency: "EUR", Price: 120},
+		"BMW.DE": {Symbol: "BMW.DE", Currency: "EUR", Price: 80},
+		"AAPL":   {Symbol: "AAPL", Currency: "USD", Price: 200},
+	}
+
+	lookups := 0
+	rate := func(from, to string) (float64, error) {
+		lookups++
+		return 0, fmt.Errorf("rate limited")
+	}
+
+	portfolio := New(holdings, quotes, "USD", rate)
+
+	assert.EqualError(t, portfolio.Positions[0].Err, "can't convert EUR to USD: rate limited")
+	assert.Error(t, portfolio.Positions[1].Err)
+	assert.NoError(t, portfolio.Positions[2].Err)
+	assert.Equal(t, 1, lookups)
+
+	assert.InDelta(t, 200, portfolio.Value, 0.001)
+	assert.InDelta(t, 100, portfolio.Positions[2].Allocation, 0.001)
+}
+
+func Test_Sparkline(t *testing.T) {
+	assert.Equal(t, "", Sparkline(nil, 10))
+	assert.Equal(t, "▁▃▅█", Sparkline([]float64{1, 2, 3, 4.5}, 0))
+	assert.Equal(t, "▅▅▅", Sparkline([]float64{2, 2, 2}, 0))
+	assert.Equal(t, "▁█", Sparkline([]float64{1, 1, 5, 5}, 2))
+}
+
+func Test_LastSession(t *testing.T) {
+	friday := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC).Unix()
+	monday := time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC).Unix()
+
+	timestamps := []int64{friday, friday + 300, monday, monday + 300}
+	prices := []float64{1, 2, 3, 4}
+
+	assert.Equal(t, []float64{3, 4}, LastSession(timestamps, prices, 0))
+	// In Tokyo, 23:00 UTC on Monday is already Tuesday
+	assert.Equal(t, []float64{5}, LastSession(append(timestamps, monday+9*3600), append(prices, 5), 9*3600))
+	assert.Nil(t, LastSession(nil, nil, 0))
+}
+
+func Test_New_withoutCost(t *testing.T) {
+	holdings := []Holding{
+		{Symbol: "BTC", Quantity: 2, CostBasis: 20000},
+		{Symbol: "ETH", Quantity: 10},
+	}
+
+	quotes := map[string]*Quote{
+		"BTC": {Symbol: "BTC", Currency: "USD", Price: 30000, Change: 1000},
+		"ETH": {Symbol: "ETH", Currency: "USD", Price: 2000, Change: -100},
+	}
+
+	portfolio := New(holdings, quotes, "USD", nil)
+
+	assert.False(t, portfolio.Positions[1].HasCost())
+	assert.Zero(t, portfolio.Positions[1].TotalPL)
+
+	assert.InDelta(t, 80000, portfolio.Value, 0.001)
+	assert.InDelta(t, 1000, portfolio.DailyPL, 0.001)
+	assert.InDelta(t, 40000, portfolio.Cost, 0.001)
+	assert.InDelta(t, 20000, portfolio.TotalPL, 0.001)
+	assert.InDelta(t, 50, portfolio.TotalPLPct(), 0.001)
+	assert.InDelta(t, 25, portfolio.Positions[1].Allocation, 0.001)
+}
@@ -0,0 +1,29 @@
+package portfolio
+
+import "time"
+
+// LastSession keeps the prices of the last day that a stock traded on, so that an
+// intraday chart that spans a weekend or a holiday only shows the latest session.
+// Prices are in the order of their timestamps, which are Unix times. Days are those
+// of the exchange, which is offset seconds east of UTC
+func LastSession(timestamps []int64, prices []float64, offset int) []float64 {
+	if len(timestamps) == 0 || len(timestamps) != len(prices) {
+		return nil
+	}
+
+	zone := time.FixedZone("", offset)
+	day := func(timestamp int64) string {
+		return time.Unix(timestamp, 0).In(zone).Format(time.DateOnly)
+	}
+
+	last := day(timestamps[len(timestamps)-1])
+
+	session := []float64{}
+	for i, timestamp := range timestamps {
+		if day(timestamp) == last {
+			session = append(session, prices[i])
+		}
+	}
+
+	return session
+}
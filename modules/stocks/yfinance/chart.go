@@ -0,0 +1,80 @@
+package yfinance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/piquette/finance-go/chart"
+	"github.com/piquette/finance-go/datetime"
+	"github.com/piquette/finance-go/quote"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
+)
+
+// chartDays is how far back intraday charts go, so that the last session is found
+// over weekends and holidays
+const chartDays = 5
+
+// intraday returns the prices of a symbol during its last session, from the chart
+// endpoint
+func intraday(symbol string) ([]float64, error) {
+	end := time.Now()
+	start := end.AddDate(0, 0, -chartDays)
+
+	iter := chart.Get(&chart.Params{
+		Symbol:   symbol,
+		Start:    datetime.New(&start),
+		End:      datetime.New(&end),
+		Interval: datetime.FiveMins,
+	})
+
+	timestamps := []int64{}
+	prices := []float64{}
+	for iter.Next() {
+		bar := iter.Bar()
+
+		// Bars without trades have no price
+		price, _ := bar.Close.Float64()
+		if price == 0 {
+			continue
+		}
+
+		timestamps = append(timestamps, int64(bar.Timestamp))
+		prices = append(prices, price)
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return portfolio.LastSession(timestamps, prices, iter.Meta().Gmtoffset), nil
+}
+
+// intradays returns the intraday prices of the symbols, keyed by symbol. Symbols
+// without a chart are left out
+func intradays(symbols []string) map[string][]float64 {
+	charts := map[string][]float64{}
+
+	for _, symbol := range symbols {
+		prices, err := intraday(symbol)
+		if err == nil {
+			charts[symbol] = prices
+		}
+	}
+
+	return charts
+}
+
+// exchangeRate returns how much one unit of a currency is worth in another, from the
+// quote of the currency pair
+func exchangeRate(from, to string) (float64, error) {
+	q, err := quote.Get(fmt.Sprintf("%s%s=X", from, to))
+	if err != nil {
+		return 0, err
+	}
+
+	if q == nil {
+		return 0, fmt.Errorf("no quote for %s%s", from, to)
+	}
+
+	return q.RegularMarketPrice, nil
+}
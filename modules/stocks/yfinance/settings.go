@@ -1,8 +1,11 @@
 package yfinance
 
 import (
+	"strings"
+
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -22,9 +25,12 @@ type colors struct {
 type Settings struct {
 	common *cfg.Common
 
-	colors  colors
-	sort    bool
-	symbols []string `help:"An array of Yahoo Finance symbols (for example: DOCN, GME, GC=F)"`
+	baseCurrency   string `help:"The currency that the portfolio totals are converted to." optional:"true"`
+	colors         colors
+	holdings       []portfolio.Holding `help:"An array of holdings, each with a symbol, quantity, costBasis and optional currency. When set, the portfolio is shown instead of the symbols." optional:"true"`
+	showSparklines bool                `help:"Whether or not to show intraday sparklines from the chart endpoint." optional:"true"`
+	sort           bool
+	symbols        []string `help:"An array of Yahoo Finance symbols (for example: DOCN, GME, GC=F)"`
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -39,6 +45,9 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings.colors.up = ymlConfig.UString("colors.up", "green")
 	settings.colors.drop = ymlConfig.UString("colors.drop", "firebrick")
 	settings.colors.bigdrop = ymlConfig.UString("colors.bigdrop", "red")
+	settings.baseCurrency = strings.ToUpper(ymlConfig.UString("baseCurrency", "USD"))
+	settings.holdings = portfolio.HoldingsFromYAML(ymlConfig)
+	settings.showSparklines = ymlConfig.UBool("showSparklines", false)
 	settings.sort = ymlConfig.UBool("sort", false)
 	settings.symbols = utils.ToStrs(ymlConfig.UList("symbols"))
 	return &settings
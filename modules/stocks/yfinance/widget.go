@@ -6,9 +6,13 @@ import (
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/stocks/portfolio"
 	"github.com/wtfutil/wtf/view"
 )
 
+// sparklineWidth is how many bars the intraday sparklines of quotes have
+const sparklineWidth = 20
+
 // Widget is the container for your module's data
 type Widget struct {
 	view.TextWidget
@@ -39,13 +43,16 @@ func (widget *Widget) Refresh() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() string {
+	if len(widget.settings.holdings) > 0 {
+		return widget.portfolioContent()
+	}
+
 	yquotes := quotes(widget.settings.symbols)
+	colors := widget.colors()
 
-	colors := map[string]string{
-		"bigup":   widget.settings.colors.bigup,
-		"up":      widget.settings.colors.up,
-		"drop":    widget.settings.colors.drop,
-		"bigdrop": widget.settings.colors.bigdrop,
+	var charts map[string][]float64
+	if widget.settings.showSparklines {
+		charts = intradays(widget.settings.symbols)
 	}
 
 	if widget.settings.sort {
@@ -55,18 +62,68 @@ func (widget *Widget) content() string {
 	t := table.NewWriter()
 	t.SetStyle(tableStyle())
 	for _, yq := range yquotes {
-		t.AppendRow([]interface{}{
+		row := table.Row{
 			GetMarketIcon(yq.MarketState),
 			yq.Symbol,
 			fmt.Sprintf("%8.2f %s", yq.MarketPrice, yq.Currency),
+		}
+
+		if widget.settings.showSparklines {
+			row = append(row, portfolio.Sparkline(charts[yq.Symbol], sparklineWidth))
+		}
+
+		t.AppendRow(append(
+			row,
 			GetTrendIcon(yq.Trend),
 			fmt.Sprintf("[%s]%+6.2f (%+5.2f%%)[white]", colors[yq.Trend], yq.MarketChange, yq.MarketChangePct),
-		})
+		))
 	}
 
 	return t.Render()
 }
 
+// portfolioContent displays the value and profits of the holdings
+func (widget *Widget) portfolioContent() string {
+	symbols := portfolio.Symbols(widget.settings.holdings)
+
+	quoted := map[string]*portfolio.Quote{}
+	for _, yq := range quotes(symbols) {
+		if yq.Trend == "?" {
+			continue
+		}
+
+		quoted[yq.Symbol] = &portfolio.Quote{
+			Symbol:    yq.Symbol,
+			Currency:  yq.Currency,
+			Price:     yq.MarketPrice,
+			Change:    yq.MarketChange,
+			ChangePct: yq.MarketChangePct,
+		}
+	}
+
+	var charts map[string][]float64
+	if widget.settings.showSparklines {
+		charts = intradays(symbols)
+	}
+
+	holdings := portfolio.New(widget.settings.holdings, quoted, widget.settings.baseCurrency, exchangeRate)
+	if widget.settings.sort {
+		holdings.Sort()
+	}
+
+	colors := widget.colors()
+	return holdings.Render(charts, func(pct float64) string { return colors[GetTrend(pct)] })
+}
+
+func (widget *Widget) colors() map[string]string {
+	return map[string]string{
+		"bigup":   widget.settings.colors.bigup,
+		"up":      widget.settings.colors.up,
+		"drop":    widget.settings.colors.drop,
+		"bigdrop": widget.settings.colors.bigdrop,
+	}
+}
+
 func (widget *Widget) display() {
 	widget.Redraw(func() (string, string, bool) {
 		return widget.CommonSettings().Title, widget.content(), false
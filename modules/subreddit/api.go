@@ -16,11 +16,31 @@ func GetLinks(subreddit string, sortMode string, topTimePeriod string) ([]Link,
 		url = url + "?sort=top&t=" + topTimePeriod
 	}
 
-	request, err := http.NewRequest("GET", url, http.NoBody)
+	var m RedditDocument
+	err := redditRequest(url, &m)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(m.Data.Children) == 0 {
+		return nil, fmt.Errorf("no links")
+	}
+
+	var links []Link
+	for _, l := range m.Data.Children {
+		links = append(links, l.Data)
+	}
+	return links, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func redditRequest(url string, result interface{}) error {
+	request, err := http.NewRequest("GET", url, http.NoBody)
+	if err != nil {
+		return err
+	}
+
 	request.Header.Set("User-Agent", "wtfutil (https://github.com/wtfutil/wtf)")
 
 	// See https://www.reddit.com/r/redditdev/comments/t8e8hc/comment/i18yga2/?utm_source=share&utm_medium=web2x&context=3
@@ -32,27 +52,13 @@ func GetLinks(subreddit string, sortMode string, topTimePeriod string) ([]Link,
 	resp, err := client.Do(request)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode > 299 {
-		return nil, fmt.Errorf("%s", resp.Status)
-	}
-	var m RedditDocument
-	err = utils.ParseJSON(&m, resp.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if len(m.Data.Children) == 0 {
-		return nil, fmt.Errorf("no links")
+		return fmt.Errorf("%s", resp.Status)
 	}
 
-	var links []Link
-	for _, l := range m.Data.Children {
-		links = append(links, l.Data)
-	}
-	return links, nil
+	return utils.ParseJSON(result, resp.Body)
 }
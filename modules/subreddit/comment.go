@@ -0,0 +1,88 @@
+package subreddit
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/wtfutil/wtf/view"
+)
+
+var commentsRoot = "https://www.reddit.com"
+
+// Comment is a comment on a link
+type Comment struct {
+	Author     string  `json:"author"`
+	Body       string  `json:"body"`
+	CreatedUTC float64 `json:"created_utc"`
+	Score      int     `json:"score"`
+
+	// Replies is a listing of comments, or an empty string when there are none
+	Replies json.RawMessage `json:"replies"`
+}
+
+// CommentListing is a page of comments. Children of any kind other than "t1"
+// aren't comments, but links to load more of them
+type CommentListing struct {
+	Data struct {
+		Children []struct {
+			Kind string  `json:"kind"`
+			Data Comment `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// GetComments fetches the comments of a link as a thread
+func GetComments(link *Link) ([]*view.Comment, error) {
+	// The first listing is the link itself, the second its comments
+	var listings []CommentListing
+	err := redditRequest(commentsRoot+link.Permalink+".json", &listings)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(listings) < 2 {
+		return nil, fmt.Errorf("no comments listing")
+	}
+
+	return threadComments(&listings[1]), nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func threadComments(listing *CommentListing) []*view.Comment {
+	thread := []*view.Comment{}
+
+	for _, child := range listing.Data.Children {
+		if child.Kind != "t1" {
+			continue
+		}
+
+		comment := child.Data
+
+		var replies []*view.Comment
+		var repliesListing CommentListing
+		if json.Unmarshal(comment.Replies, &repliesListing) == nil {
+			replies = threadComments(&repliesListing)
+		}
+
+		thread = append(thread, &view.Comment{
+			Author:  comment.Author,
+			Info:    points(comment.Score),
+			Replies: replies,
+			Text:    html.UnescapeString(comment.Body),
+			Time:    time.Unix(int64(comment.CreatedUTC), 0),
+		})
+	}
+
+	return thread
+}
+
+func points(score int) string {
+	if score == 1 {
+		return "1 point"
+	}
+
+	return fmt.Sprintf("%d points", score)
+}
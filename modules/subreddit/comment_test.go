@@ -0,0 +1,40 @@
+package subreddit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetComments(t *testing.T) {
+	data, err := os.ReadFile("testdata/comments.json")
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/r/golang/comments/abc/a_link/.json", r.URL.Path)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	root := commentsRoot
+	commentsRoot = server.URL
+	defer func() { commentsRoot = root }()
+
+	comments, err := GetComments(&Link{Permalink: "/r/golang/comments/abc/a_link/"})
+	assert.NoError(t, err)
+
+	assert.Len(t, comments, 2)
+	assert.Equal(t, "alice", comments[0].Author)
+	assert.Equal(t, "Tom & Jerry", comments[0].Text)
+	assert.Equal(t, "12 points", comments[0].Info)
+	assert.Equal(t, int64(1700000000), comments[0].Time.Unix())
+
+	// Links to load more comments are left out
+	assert.Len(t, comments[0].Replies, 1)
+	assert.Equal(t, "-2 points", comments[0].Replies[0].Info)
+	assert.Equal(t, "1 point", comments[1].Info)
+	assert.Empty(t, comments[1].Replies)
+}
@@ -94,3 +94,13 @@ func (widget *Widget) openReddit() {
 		utils.OpenFile(fullLink)
 	}
 }
+
+func (widget *Widget) showComments() {
+	sel := widget.GetSelected()
+	if sel >= 0 && widget.links != nil && sel < len(widget.links) {
+		link := &widget.links[sel]
+		widget.ShowComments(link.Title, func() ([]*view.Comment, error) {
+			return GetComments(link)
+		})
+	}
+}
@@ -41,6 +41,7 @@ func (widget *Widget) content() (string, string, bool) {
 	if widget.settings.hiddenNumInTitle {
 		title += fmt.Sprintf(" (%d hidden)", hidden)
 	}
+	title += widget.dueTitle()
 
 	return title, str, false
 }
@@ -141,11 +142,28 @@ func (widget *Widget) formattedItemLine(idx int, hidden int, currItem *checklist
 	if widget.settings.parseDates && todoDate != nil {
 		row += fmt.Sprintf(
 			`[%s]%s `,
-			widget.settings.dateColor,
+			widget.dateColor(currItem),
 			widget.getDateString(todoDate),
 		)
 	}
 
+	if currItem.Priority > 0 {
+		priorityColor := widget.settings.priorityColor
+		if currItem.Priority > 1 {
+			priorityColor = widget.settings.priorityHighColor
+		}
+
+		row += fmt.Sprintf(
+			`[%s]%s `,
+			priorityColor,
+			currItem.PriorityString(),
+		)
+	}
+
+	if currItem.Recurrence != "" && !currItem.Checked {
+		row += fmt.Sprintf(`[%s]↻ `, widget.settings.dateColor)
+	}
+
 	tagsPart := ""
 	if len(currItem.Tags) > 0 {
 		tagsPart = fmt.Sprintf(
@@ -174,12 +192,16 @@ func (widget *Widget) formattedItemLine(idx int, hidden int, currItem *checklist
 
 func (widget *Widget) getDateString(date *time.Time) string {
 	now := getNowDate()
-	diff := int(date.Sub(now).Hours() / 24)
+	diff := daysUntil(date, now)
 	if diff == 0 {
 		return "today"
 	} else if diff == 1 {
 		return "tomorrow"
-	} else if diff <= widget.settings.switchToInDaysIn {
+	} else if diff == -1 {
+		return "yesterday"
+	} else if diff < 0 && -diff <= widget.settings.switchToInDaysIn {
+		return fmt.Sprintf("%d days ago", -diff)
+	} else if diff > 0 && diff <= widget.settings.switchToInDaysIn {
 		return fmt.Sprintf("in %d days", diff)
 	} else {
 		dateStr := ""
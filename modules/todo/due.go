@@ -0,0 +1,108 @@
+package todo
+
+import (
+	"fmt"
+	"math"
+	"os/exec"
+	"time"
+
+	"github.com/wtfutil/wtf/checklist"
+	"github.com/wtfutil/wtf/logger"
+)
+
+// daysUntil returns the number of days from today until the given date. Dates in the
+// past return a negative number
+func daysUntil(date *time.Time, today time.Time) int {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, today.Location())
+	return int(math.Round(day.Sub(today).Hours() / 24))
+}
+
+// dueCounts returns the number of unchecked items that are overdue and that are due
+// today
+func (widget *Widget) dueCounts() (int, int) {
+	today := getNowDate()
+	overdue, dueToday := 0, 0
+
+	for _, item := range widget.list.UncheckedItems() {
+		if item.Date == nil {
+			continue
+		}
+
+		switch days := daysUntil(item.Date, today); {
+		case days < 0:
+			overdue++
+		case days == 0:
+			dueToday++
+		}
+	}
+
+	return overdue, dueToday
+}
+
+// dueTitle returns the overdue and due today counts to append to the title, if any
+func (widget *Widget) dueTitle() string {
+	if !widget.settings.parseDates {
+		return ""
+	}
+
+	overdue, dueToday := widget.dueCounts()
+
+	title := ""
+	if overdue > 0 {
+		title += fmt.Sprintf(" [%s]%d overdue[-]", widget.settings.overdueColor, overdue)
+	}
+	if dueToday > 0 {
+		title += fmt.Sprintf(" [%s]%d today[-]", widget.settings.dueTodayColor, dueToday)
+	}
+
+	return title
+}
+
+// dateColor returns the color to display an item's date in, highlighting unchecked
+// items that are due today or overdue
+func (widget *Widget) dateColor(item *checklist.ChecklistItem) string {
+	if item.Checked || item.Date == nil {
+		return widget.settings.dateColor
+	}
+
+	switch days := daysUntil(item.Date, getNowDate()); {
+	case days < 0:
+		return widget.settings.overdueColor
+	case days == 0:
+		return widget.settings.dueTodayColor
+	default:
+		return widget.settings.dateColor
+	}
+}
+
+// notifyDue runs the notification command once for each unchecked item that is due
+// today or overdue, with the item's text as its last argument
+func (widget *Widget) notifyDue() {
+	if widget.settings.notifyCommand == "" || !widget.settings.parseDates {
+		return
+	}
+
+	today := getNowDate()
+
+	for _, item := range widget.list.UncheckedItems() {
+		if item.Date == nil || daysUntil(item.Date, today) > 0 {
+			continue
+		}
+
+		key := item.EditText()
+		if widget.notified[key] {
+			continue
+		}
+		widget.notified[key] = true
+
+		args := append(append([]string{}, widget.settings.notifyArgs...), item.Text)
+		cmd := exec.Command(widget.settings.notifyCommand, args...)
+
+		if err := cmd.Start(); err != nil {
+			logger.Log(fmt.Sprintf("todo: unable to run notification command: %s", err))
+			continue
+		}
+
+		go func() { _ = cmd.Wait() }()
+	}
+}
@@ -20,6 +20,7 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("o", widget.openFile, "Open file")
 	widget.SetKeyboardChar("#", widget.setTag, "Set tag(s) to show")
 	widget.SetKeyboardChar("f", widget.setFilter, "Filter shown items")
+	widget.SetKeyboardChar("A", widget.archiveDone, "Archive checked items to done file (todo.txt only)")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.NextTodo, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.PrevTodo, "Select previous item")
@@ -101,8 +102,8 @@ func (widget *Widget) makeSelectedLast() {
 		j = j + 1
 	}
 
-	if widget.settings.parseDates {
-		widget.Selected = widget.placeItemBasedOnDate(widget.Selected)
+	if widget.sortsItems() {
+		widget.Selected = widget.placeItem(widget.Selected)
 	}
 
 	widget.persist()
@@ -162,8 +163,8 @@ func (widget *Widget) makeSelectedFirst() {
 		j = j - 1
 	}
 
-	if widget.settings.parseDates {
-		widget.Selected = widget.placeItemBasedOnDate(widget.Selected)
+	if widget.sortsItems() {
+		widget.Selected = widget.placeItem(widget.Selected)
 	}
 
 	widget.persist()
@@ -178,8 +179,10 @@ func (widget *Widget) toggleChecked() {
 
 	selectedItem.Toggle()
 
-	if !selectedItem.Checked {
-		widget.Selected = widget.placeItemBasedOnDate(widget.Selected)
+	if selectedItem.Checked {
+		widget.respawnSelected()
+	} else if widget.sortsItems() {
+		widget.Selected = widget.placeItem(widget.Selected)
 	}
 
 	widget.persist()
@@ -0,0 +1,164 @@
+package todo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/checklist"
+)
+
+// recurrenceRegex matches a recurrence rule in the text of a todo, e.g. @daily, @weekly,
+// @monthly or @every 3 days
+var recurrenceRegex = regexp.MustCompile(`(?i)(^|\s)(@(daily|weekly|monthly|every\s+\d+\s+(day|week|month)s?))(\s|$)`)
+
+// priorityRegex matches a priority marker, ! or !!, in the text of a todo
+var priorityRegex = regexp.MustCompile(`(^|\s)(!{1,2})(\s|$)`)
+
+// getRecurrence extracts a recurrence rule from the text of a todo and returns the
+// text without it, along with the rule in its normalized form
+func getRecurrence(text string) (string, string) {
+	loc := recurrenceRegex.FindStringSubmatchIndex(text)
+	if loc == nil {
+		return text, ""
+	}
+
+	rule := strings.ToLower(strings.Join(strings.Fields(text[loc[6]:loc[7]]), " "))
+	if _, _, ok := parseRecurrence(rule); !ok {
+		return text, ""
+	}
+
+	return removeToken(text, loc[4], loc[5]), rule
+}
+
+// getPriority extracts a priority marker from the text of a todo and returns the text
+// without it, along with the priority: 2 for !!, 1 for ! and 0 if there is none
+func getPriority(text string) (string, int) {
+	loc := priorityRegex.FindStringSubmatchIndex(text)
+	if loc == nil {
+		return text, 0
+	}
+
+	return removeToken(text, loc[4], loc[5]), loc[5] - loc[4]
+}
+
+// removeToken removes text[start:end] along with the space that follows it, if any
+func removeToken(text string, start, end int) string {
+	if end < len(text) && text[end] == ' ' {
+		end++
+	}
+
+	return text[:start] + text[end:]
+}
+
+// parseRecurrence parses a normalized recurrence rule into the number of days or
+// months between occurrences
+func parseRecurrence(rule string) (days int, months int, ok bool) {
+	switch rule {
+	case "daily":
+		return 1, 0, true
+	case "weekly":
+		return 7, 0, true
+	case "monthly":
+		return 0, 1, true
+	}
+
+	parts := strings.Fields(rule)
+	if len(parts) != 3 || parts[0] != "every" {
+		return 0, 0, false
+	}
+
+	n, err := strconv.Atoi(parts[1])
+	if err != nil || n < 1 {
+		return 0, 0, false
+	}
+
+	switch strings.TrimSuffix(parts[2], "s") {
+	case "day":
+		return n, 0, true
+	case "week":
+		return 7 * n, 0, true
+	case "month":
+		return 0, n, true
+	}
+
+	return 0, 0, false
+}
+
+// nextOccurrence returns the first date after today on which a recurring todo is due
+// again, counting from its current due date so that the schedule doesn't drift when
+// it's completed late
+func nextOccurrence(rule string, due *time.Time, today time.Time) (*time.Time, error) {
+	days, months, ok := parseRecurrence(rule)
+	if !ok {
+		return nil, fmt.Errorf("invalid recurrence: %s", rule)
+	}
+
+	next := today
+	if due != nil {
+		next = time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, today.Location())
+	}
+
+	for {
+		next = next.AddDate(0, months, days)
+		if next.After(today) {
+			return &next, nil
+		}
+	}
+}
+
+// respawn returns the next occurrence of a recurring todo that has just been checked,
+// or nil if the todo doesn't recur
+func respawn(item *checklist.ChecklistItem, today time.Time) *checklist.ChecklistItem {
+	if item.Recurrence == "" {
+		return nil
+	}
+
+	date, err := nextOccurrence(item.Recurrence, item.Date, today)
+	if err != nil {
+		return nil
+	}
+
+	next := checklist.NewChecklistItem(false, date, append([]string{}, item.Tags...), item.Text, item.CheckedIcon, item.UncheckedIcon)
+	next.Priority = item.Priority
+	next.Recurrence = item.Recurrence
+
+	return next
+}
+
+// respawnSelected adds the next occurrence of the selected todo, which has just been
+// checked, if it recurs. The checked todo no longer recurs, so that unchecking and
+// checking it again doesn't add another occurrence
+func (widget *Widget) respawnSelected() {
+	selectedItem := widget.SelectedItem()
+	if selectedItem == nil {
+		return
+	}
+
+	next := respawn(selectedItem, getNowDate())
+	if next == nil {
+		return
+	}
+	selectedItem.Recurrence = ""
+
+	index := 0
+	if widget.settings.newPos == "first" {
+		widget.list.Items = append([]*checklist.ChecklistItem{next}, widget.list.Items...)
+	} else {
+		widget.list.Items = append(widget.list.Items, next)
+		index = widget.list.Len() - 1
+	}
+
+	widget.SetItemCount(len(widget.list.Items))
+
+	if widget.sortsItems() {
+		widget.placeItem(index)
+	}
+
+	// Keep the checked todo selected, wherever the new occurrence moved it to
+	if idx, ok := widget.list.IndexByItem(selectedItem); ok {
+		widget.Selected = idx
+	}
+}
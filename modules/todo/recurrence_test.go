@@ -0,0 +1,105 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtfutil/wtf/checklist"
+)
+
+func Test_getTodoAttributes(t *testing.T) {
+	tests := []struct {
+		text       string
+		expected   string
+		priority   int
+		recurrence string
+	}{
+		{"water plants", "water plants", 0, ""},
+		{"! water plants", "water plants", 1, ""},
+		{"water plants !!", "water plants", 2, ""},
+		{"hi! there", "hi! there", 0, ""},
+		{"!! @weekly take out the bins", "take out the bins", 2, "weekly"},
+		{"water plants @Every  3 Days", "water plants", 0, "every 3 days"},
+		{"water plants @every 2 weeks now", "water plants now", 0, "every 2 weeks"},
+		{"email me@example.com", "email me@example.com", 0, ""},
+		{"@every 0 days reset", "@every 0 days reset", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, priority, recurrence := getTodoAttributes(tt.text)
+
+			assert.Equal(t, tt.expected, text)
+			assert.Equal(t, tt.priority, priority)
+			assert.Equal(t, tt.recurrence, recurrence)
+		})
+	}
+}
+
+func Test_nextOccurrence(t *testing.T) {
+	today := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+		return &d
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		due      *time.Time
+		expected *time.Time
+	}{
+		{"daily on time", "daily", date(2024, 3, 10), date(2024, 3, 11)},
+		{"daily overdue", "daily", date(2024, 3, 5), date(2024, 3, 11)},
+		{"weekly keeps weekday", "weekly", date(2024, 3, 1), date(2024, 3, 15)},
+		{"monthly", "monthly", date(2024, 3, 10), date(2024, 4, 10)},
+		{"every n days early", "every 3 days", date(2024, 3, 12), date(2024, 3, 15)},
+		{"undated", "every 2 weeks", nil, date(2024, 3, 24)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := nextOccurrence(tt.rule, tt.due, today)
+			require.NoError(t, err)
+			assert.Equal(t, *tt.expected, *next)
+		})
+	}
+
+	_, err := nextOccurrence("fortnightly", nil, today)
+	assert.Error(t, err)
+}
+
+func Test_respawn(t *testing.T) {
+	today := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+
+	item := checklist.NewChecklistItem(true, &today, []string{"home"}, "water plants", "x", " ")
+	assert.Nil(t, respawn(item, today))
+
+	item.Priority = 1
+	item.Recurrence = "weekly"
+
+	next := respawn(item, today)
+	require.NotNil(t, next)
+
+	assert.False(t, next.Checked)
+	assert.Equal(t, "water plants", next.Text)
+	assert.Equal(t, []string{"home"}, next.Tags)
+	assert.Equal(t, 1, next.Priority)
+	assert.Equal(t, "weekly", next.Recurrence)
+	assert.Equal(t, today.AddDate(0, 0, 7), *next.Date)
+}
+
+func Test_daysUntil(t *testing.T) {
+	today := time.Date(2024, 3, 10, 0, 0, 0, 0, time.Local)
+
+	yesterday := time.Date(2024, 3, 9, 18, 30, 0, 0, time.Local)
+	assert.Equal(t, -1, daysUntil(&yesterday, today))
+
+	later := time.Date(2024, 3, 10, 23, 0, 0, 0, time.Local)
+	assert.Equal(t, 0, daysUntil(&later, today))
+
+	nextMonth := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 31, daysUntil(&nextMonth, today))
+}
@@ -3,6 +3,7 @@ package todo
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
@@ -15,6 +16,8 @@ type Settings struct {
 	*cfg.Common
 
 	filePath          string
+	format            string
+	doneFilename      string
 	checked           string
 	unchecked         string
 	newPos            string
@@ -30,6 +33,13 @@ type Settings struct {
 	tagsAtEnd         bool
 	hideTags          []interface{}
 	hiddenNumInTitle  bool
+	sortByPriority    bool
+	priorityColor     string
+	priorityHighColor string
+	dueTodayColor     string
+	overdueColor      string
+	notifyCommand     string
+	notifyArgs        []string
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -40,6 +50,8 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		Common: common,
 
 		filePath:          ymlConfig.UString("filename"),
+		format:            ymlConfig.UString("format", "yaml"),
+		doneFilename:      ymlConfig.UString("doneFilename", "done.txt"),
 		checked:           ymlConfig.UString("checkedIcon", common.Checkbox.Checked),
 		unchecked:         ymlConfig.UString("uncheckedIcon", common.Checkbox.Unchecked),
 		newPos:            ymlConfig.UString("newPos", "first"),
@@ -55,8 +67,20 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		tagsAtEnd:         ymlConfig.UString("tags.pos", "end") == "end",
 		hideTags:          ymlConfig.UList("tags.hide"),
 		hiddenNumInTitle:  ymlConfig.UBool("tags.hiddenInTitle", true),
+		sortByPriority:    ymlConfig.UBool("priorities.sort", true),
+		priorityColor:     ymlConfig.UString("colors.priority", "orange"),
+		priorityHighColor: ymlConfig.UString("colors.priorityHigh", "red"),
+		dueTodayColor:     ymlConfig.UString("colors.dueToday", "yellow"),
+		overdueColor:      ymlConfig.UString("colors.overdue", "red"),
+		notifyCommand:     ymlConfig.UString("notify.command"),
+		notifyArgs:        utils.ToStrs(ymlConfig.UList("notify.args")),
 	}
 
+	switch settings.format {
+	case "yaml", formatTodoTxt:
+	default:
+		settings.format = "yaml"
+	}
 	switch settings.newPos {
 	case "first", "last":
 	default:
@@ -0,0 +1,344 @@
+package todo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/checklist"
+)
+
+const (
+	formatTodoTxt  = "todotxt"
+	todoTxtDateFmt = "2006-01-02"
+)
+
+// todoTxtPriorityRegex matches a todo.txt priority, e.g. "(A) "
+var todoTxtPriorityRegex = regexp.MustCompile(`^\(([A-Z])\) `)
+
+// todoTxtRecRegex matches the value of a todo.txt rec: key that can be expressed as a
+// recurrence rule, e.g. 1d, 2w or +1m
+var todoTxtRecRegex = regexp.MustCompile(`^\+?(\d+)([dwm])$`)
+
+// todoTxtTask is a single line of a todo.txt file. See https://github.com/todotxt/todo.txt
+type todoTxtTask struct {
+	raw string
+
+	completed      bool
+	completionDate string
+	creationDate   string
+	priority       string
+	description    string
+}
+
+// parseTodoTxtLine parses a line of a todo.txt file
+func parseTodoTxtLine(line string) *todoTxtTask {
+	task := &todoTxtTask{raw: line}
+	rest := line
+
+	if strings.HasPrefix(rest, "x ") {
+		task.completed = true
+		rest = rest[2:]
+
+		if date, remainder, ok := cutTodoTxtDate(rest); ok {
+			task.completionDate = date
+			rest = remainder
+		}
+	} else if match := todoTxtPriorityRegex.FindStringSubmatch(rest); match != nil {
+		task.priority = match[1]
+		rest = rest[len(match[0]):]
+	}
+
+	if date, remainder, ok := cutTodoTxtDate(rest); ok {
+		task.creationDate = date
+		rest = remainder
+	}
+
+	task.description = rest
+
+	return task
+}
+
+// cutTodoTxtDate removes a leading YYYY-MM-DD date from text
+func cutTodoTxtDate(text string) (string, string, bool) {
+	if len(text) < 11 || text[10] != ' ' {
+		return "", text, false
+	}
+
+	if _, err := time.Parse(todoTxtDateFmt, text[:10]); err != nil {
+		return "", text, false
+	}
+
+	return text[:10], text[11:], true
+}
+
+// toItem converts a todo.txt task into a checklist item. Projects become tags, and the
+// due: and rec: keys become the item's date and recurrence. Completed tasks keep their
+// priority in a pri: key. Contexts and other keys stay in the text
+func (task *todoTxtTask) toItem() *checklist.ChecklistItem {
+	item := &checklist.ChecklistItem{
+		Checked:  task.completed,
+		Priority: todoTxtPriority(task.priority),
+		Tags:     []string{},
+	}
+
+	words := []string{}
+	for _, word := range strings.Fields(task.description) {
+		key, value, isKey := strings.Cut(word, ":")
+
+		switch {
+		case strings.HasPrefix(word, "+") && len(word) > 1:
+			item.Tags = append(item.Tags, word[1:])
+		case isKey && key == "due" && item.Date == nil:
+			date, err := time.ParseInLocation(todoTxtDateFmt, value, time.Local)
+			if err != nil {
+				words = append(words, word)
+				continue
+			}
+			item.Date = &date
+		case isKey && key == "rec" && item.Recurrence == "" && todoTxtRecurrence(value) != "":
+			item.Recurrence = todoTxtRecurrence(value)
+		case isKey && key == "pri" && task.completed && len(value) == 1:
+			item.Priority = todoTxtPriority(value)
+		default:
+			words = append(words, word)
+		}
+	}
+
+	item.Text = strings.Join(words, " ")
+
+	return item
+}
+
+// todoTxtLine returns the todo.txt line for an item. If the item hasn't changed since it
+// was read, its original line is returned unchanged so that reading and writing a file
+// is lossless
+func todoTxtLine(item *checklist.ChecklistItem, original *todoTxtTask, today time.Time) string {
+	if original != nil && sameItem(item, original.toItem()) {
+		return original.raw
+	}
+
+	priority := ""
+	creationDate := today.Format(todoTxtDateFmt)
+	completionDate := today.Format(todoTxtDateFmt)
+
+	if original != nil {
+		creationDate = original.creationDate
+
+		// Keep priorities beyond B, which items can't express, unless it was changed
+		if todoTxtPriority(original.priority) == item.Priority {
+			priority = original.priority
+		}
+
+		if original.completed {
+			completionDate = original.completionDate
+		}
+	}
+
+	if priority == "" && item.Priority > 0 {
+		priority = string(rune('A' + 2 - item.Priority))
+	}
+
+	parts := []string{}
+
+	if item.Checked {
+		parts = append(parts, "x", completionDate)
+	} else if priority != "" {
+		parts = append(parts, "("+priority+")")
+	}
+
+	if creationDate != "" {
+		parts = append(parts, creationDate)
+	}
+
+	if item.Text != "" {
+		parts = append(parts, item.Text)
+	}
+
+	for _, tag := range item.Tags {
+		parts = append(parts, "+"+tag)
+	}
+
+	if item.Date != nil {
+		parts = append(parts, "due:"+item.Date.Format(todoTxtDateFmt))
+	}
+
+	if rec := todoTxtRec(item.Recurrence); rec != "" {
+		parts = append(parts, "rec:"+rec)
+	}
+
+	if item.Checked && priority != "" {
+		parts = append(parts, "pri:"+priority)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// sameItem returns whether two items hold the same todo
+func sameItem(a, b *checklist.ChecklistItem) bool {
+	if a.Checked != b.Checked || a.Priority != b.Priority || a.Recurrence != b.Recurrence || a.Text != b.Text {
+		return false
+	}
+
+	if (a.Date == nil) != (b.Date == nil) || (a.Date != nil && a.Date.Format(todoTxtDateFmt) != b.Date.Format(todoTxtDateFmt)) {
+		return false
+	}
+
+	return strings.Join(a.Tags, " ") == strings.Join(b.Tags, " ")
+}
+
+// todoTxtPriority converts a todo.txt priority to an item priority. A is the highest,
+// B is the next highest, and lower priorities aren't distinguished from none
+func todoTxtPriority(priority string) int {
+	switch priority {
+	case "A":
+		return 2
+	case "B":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// todoTxtRecurrence converts the value of a rec: key to a recurrence rule, or returns an
+// empty string if it can't be expressed as one
+func todoTxtRecurrence(value string) string {
+	match := todoTxtRecRegex.FindStringSubmatch(value)
+	if match == nil {
+		return ""
+	}
+
+	n, _ := strconv.Atoi(match[1])
+	if n < 1 {
+		return ""
+	}
+
+	unit := map[string]string{"d": "day", "w": "week", "m": "month"}[match[2]]
+	if n == 1 {
+		return map[string]string{"d": "daily", "w": "weekly", "m": "monthly"}[match[2]]
+	}
+
+	return fmt.Sprintf("every %d %ss", n, unit)
+}
+
+// todoTxtRec converts a recurrence rule to the value of a rec: key
+func todoTxtRec(rule string) string {
+	days, months, ok := parseRecurrence(rule)
+	switch {
+	case !ok:
+		return ""
+	case months > 0:
+		return fmt.Sprintf("%dm", months)
+	case days%7 == 0:
+		return fmt.Sprintf("%dw", days/7)
+	default:
+		return fmt.Sprintf("%dd", days)
+	}
+}
+
+/* -------------------- Widget Functions -------------------- */
+
+// loadTodoTxt replaces the list with the tasks in a todo.txt file
+func (widget *Widget) loadTodoTxt(fileData []byte) {
+	widget.list.Items = []*checklist.ChecklistItem{}
+	widget.todoTxtTasks = map[*checklist.ChecklistItem]*todoTxtTask{}
+	widget.todoTxtOrder = []*checklist.ChecklistItem{}
+
+	for _, line := range strings.Split(string(fileData), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		task := parseTodoTxtLine(line)
+		item := task.toItem()
+
+		widget.list.Items = append(widget.list.Items, item)
+		widget.todoTxtTasks[item] = task
+		widget.todoTxtOrder = append(widget.todoTxtOrder, item)
+	}
+}
+
+// marshalTodoTxt returns the list as the contents of a todo.txt file. Lines stay in the
+// order they were read in, whatever order the list is sorted in, and new items are
+// added after them
+func (widget *Widget) marshalTodoTxt() []byte {
+	today := getNowDate()
+
+	listed := map[*checklist.ChecklistItem]bool{}
+	for _, item := range widget.list.Items {
+		listed[item] = true
+	}
+
+	order := []*checklist.ChecklistItem{}
+	for _, item := range widget.todoTxtOrder {
+		if listed[item] {
+			order = append(order, item)
+			delete(listed, item)
+		}
+	}
+	for _, item := range widget.list.Items {
+		if listed[item] {
+			order = append(order, item)
+		}
+	}
+	widget.todoTxtOrder = order
+
+	str := ""
+	for _, item := range order {
+		str += todoTxtLine(item, widget.todoTxtTasks[item], today) + "\n"
+	}
+
+	return []byte(str)
+}
+
+// archiveDone moves the checked items of a todo.txt list to the done file
+func (widget *Widget) archiveDone() {
+	if widget.settings.format != formatTodoTxt {
+		return
+	}
+
+	confDir, _ := cfg.WtfConfigDir()
+	donePath := filepath.Join(confDir, filepath.Dir(widget.filePath), widget.settings.doneFilename)
+
+	today := getNowDate()
+	archived := ""
+	remaining := []*checklist.ChecklistItem{}
+
+	for _, item := range widget.list.Items {
+		if item.Checked {
+			archived += todoTxtLine(item, widget.todoTxtTasks[item], today) + "\n"
+		} else {
+			remaining = append(remaining, item)
+		}
+	}
+
+	if archived == "" {
+		return
+	}
+
+	file, err := os.OpenFile(donePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err == nil {
+		_, err = file.WriteString(archived)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		widget.Error = err.Error()
+		widget.display()
+		return
+	}
+
+	widget.list.Items = remaining
+	widget.SetItemCount(len(widget.list.Items))
+	widget.Selected = -1
+
+	widget.persist()
+	widget.display()
+}
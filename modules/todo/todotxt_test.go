@@ -0,0 +1,145 @@
+package todo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTodoTxtLine(t *testing.T) {
+	task := parseTodoTxtLine("(A) 2024-03-01 Call Mom +Family @phone due:2024-03-12 rec:1w")
+
+	assert.False(t, task.completed)
+	assert.Equal(t, "A", task.priority)
+	assert.Equal(t, "2024-03-01", task.creationDate)
+	assert.Equal(t, "Call Mom +Family @phone due:2024-03-12 rec:1w", task.description)
+
+	item := task.toItem()
+	assert.Equal(t, 2, item.Priority)
+	assert.Equal(t, "Call Mom @phone", item.Text)
+	assert.Equal(t, []string{"Family"}, item.Tags)
+	assert.Equal(t, "weekly", item.Recurrence)
+	require.NotNil(t, item.Date)
+	assert.Equal(t, "2024-03-12", item.Date.Format(todoTxtDateFmt))
+
+	done := parseTodoTxtLine("x 2024-03-10 2024-03-01 Pay rent pri:B")
+	assert.True(t, done.completed)
+	assert.Equal(t, "2024-03-10", done.completionDate)
+	assert.Equal(t, "2024-03-01", done.creationDate)
+	assert.Equal(t, 1, done.toItem().Priority)
+	assert.Equal(t, "Pay rent", done.toItem().Text)
+
+	plain := parseTodoTxtLine("xylophone lessons (A) due:someday")
+	assert.False(t, plain.completed)
+	assert.Equal(t, "", plain.priority)
+	assert.Equal(t, "xylophone lessons (A) due:someday", plain.toItem().Text)
+}
+
+func Test_todoTxtLine_lossless(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.Local)
+
+	lines := []string{
+		"(A) 2024-03-01 Call Mom +Family @phone due:2024-03-12",
+		"x 2024-03-10 2024-03-01 (C) odd but valid   spacing  http://example.com",
+		"(Z) lowest priority t:2024-04-01 rec:b",
+		"x done without dates",
+		"2024-02-29 +b +a project order @home",
+	}
+
+	for _, line := range lines {
+		task := parseTodoTxtLine(line)
+		assert.Equal(t, line, todoTxtLine(task.toItem(), task, today))
+	}
+}
+
+func Test_todoTxtLine_edited(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.Local)
+
+	task := parseTodoTxtLine("(C) 2024-03-01 Call Mom +Family @phone due:2024-03-12")
+
+	item := task.toItem()
+	item.Text = "Call Dad @phone"
+	assert.Equal(t, "(C) 2024-03-01 Call Dad @phone +Family due:2024-03-12", todoTxtLine(item, task, today))
+
+	item.Toggle()
+	assert.Equal(t, "x 2024-03-15 2024-03-01 Call Dad @phone +Family due:2024-03-12 pri:C", todoTxtLine(item, task, today))
+
+	item = task.toItem()
+	item.Priority = 2
+	item.Recurrence = "every 2 weeks"
+	assert.Equal(t, "(A) 2024-03-01 Call Mom @phone +Family due:2024-03-12 rec:2w", todoTxtLine(item, task, today))
+
+	item = parseTodoTxtLine("new task").toItem()
+	item.Priority = 1
+	assert.Equal(t, "(B) 2024-03-15 new task", todoTxtLine(item, nil, today))
+}
+
+func Test_todoTxtRecurrence(t *testing.T) {
+	assert.Equal(t, "daily", todoTxtRecurrence("1d"))
+	assert.Equal(t, "every 3 days", todoTxtRecurrence("+3d"))
+	assert.Equal(t, "monthly", todoTxtRecurrence("1m"))
+	assert.Equal(t, "", todoTxtRecurrence("1y"))
+	assert.Equal(t, "", todoTxtRecurrence("0d"))
+
+	for _, value := range []string{"1d", "3d", "1w", "2w", "1m", "6m"} {
+		assert.Equal(t, value, todoTxtRec(todoTxtRecurrence(value)))
+	}
+}
+
+func Test_persist_todoTxtOrder(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+
+	filePath := filepath.Join(configDir, "wtf", "todo.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o700))
+
+	contents := strings.Join([]string{
+		"2024-03-01 no priority due:2024-03-20",
+		"(B) 2024-03-01 second priority +work",
+		"x 2024-03-10 2024-03-01 done first",
+		"(A) 2024-03-01 first priority due:2024-03-05",
+		"",
+	}, "\n")
+	require.NoError(t, os.WriteFile(filePath, []byte(contents), 0o600))
+
+	ymlConfig, _ := config.ParseYaml("filename: todo.txt\nformat: todotxt")
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+	settings := NewSettingsFromYAML("todo", ymlConfig, globalConfig)
+
+	widget := NewWidget(tview.NewApplication(), make(chan bool, 10), tview.NewPages(), settings)
+	require.NoError(t, widget.load())
+
+	// The list is sorted for display, but the file keeps its order
+	assert.Equal(t, "first priority", widget.list.Items[0].Text)
+
+	widget.persist()
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(data))
+
+	widget.list.Items[0].Text = "first priority edited"
+	widget.list.Add(false, nil, []string{}, "new task", "first")
+	widget.persist()
+
+	data, err = os.ReadFile(filePath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, "(A) 2024-03-01 first priority edited due:2024-03-05", lines[3])
+	assert.True(t, strings.HasSuffix(lines[4], " new task"))
+}
+
+func Test_getTodoTags_todoTxtProjects(t *testing.T) {
+	item := parseTodoTxtLine("Plan release +my-project +wtf.util").toItem()
+
+	text, tags := getTodoTags(item.EditText())
+	assert.Equal(t, "Plan release", strings.TrimSpace(text))
+	assert.Equal(t, []string{"my-project", "wtf.util"}, tags)
+}
@@ -35,6 +35,13 @@ type Widget struct {
 	tviewApp      *tview.Application
 	Error         string
 
+	// notified holds the due items the notification hook has already been run for
+	notified map[string]bool
+	// todoTxtTasks holds the todo.txt line each item was read from
+	todoTxtTasks map[*checklist.ChecklistItem]*todoTxtTask
+	// todoTxtOrder holds the items in the order of their lines in the todo.txt file
+	todoTxtOrder []*checklist.ChecklistItem
+
 	view.ScrollableWidget
 
 	// redrawChan chan bool
@@ -50,6 +57,8 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		filePath:      settings.filePath,
 		showTagPrefix: "",
 		list:          checklist.NewChecklist(settings.Checkbox.Checked, settings.Checkbox.Unchecked),
+		notified:      map[string]bool{},
+		todoTxtTasks:  map[*checklist.ChecklistItem]*todoTxtTask{},
 		pages:         pages,
 
 		// redrawChan: redrawChan,
@@ -86,6 +95,7 @@ func (widget *Widget) Refresh() {
 	if err != nil {
 		widget.Error = err.Error()
 	}
+	widget.notifyDue()
 	widget.display()
 }
 
@@ -107,7 +117,7 @@ func (widget *Widget) isItemSelected() bool {
 	return widget.Selected >= 0 && widget.Selected < len(widget.list.Items)
 }
 
-// Loads the todo list from3 Yaml file
+// Loads the todo list from the Yaml or todo.txt file
 func (widget *Widget) load() error {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s", confDir, widget.filePath)
@@ -118,17 +128,21 @@ func (widget *Widget) load() error {
 		return err
 	}
 
-	err = yaml.Unmarshal(fileData, &widget.list)
-	if err != nil {
-		return err
+	if widget.settings.format == formatTodoTxt {
+		widget.loadTodoTxt(fileData)
+	} else {
+		err = yaml.Unmarshal(fileData, &widget.list)
+		if err != nil {
+			return err
+		}
 	}
 
-	// do initial sort based on dates to make sure everything is correct
-	if widget.settings.parseDates {
+	// do initial sort based on priorities and dates to make sure everything is correct
+	if widget.sortsItems() {
 		i := 0
 		for i < widget.list.Len() {
 			for {
-				newIndex := widget.placeItemBasedOnDate(i)
+				newIndex := widget.placeItem(i)
 				if newIndex == i {
 					break
 				}
@@ -145,15 +159,20 @@ func (widget *Widget) load() error {
 func (widget *Widget) newItem() {
 	widget.processFormInput("New Todo:", "", func(t string) {
 		text, date, tags := widget.getTextComponents(t)
+		text, priority, recurrence := getTodoAttributes(text)
 
 		widget.list.Add(false, date, tags, text, widget.settings.newPos)
 		widget.SetItemCount(len(widget.list.Items))
-		if widget.settings.parseDates {
-			if widget.settings.newPos == "first" {
-				widget.placeItemBasedOnDate(0)
-			} else {
-				widget.placeItemBasedOnDate(widget.list.Len() - 1)
-			}
+
+		index := 0
+		if widget.settings.newPos != "first" {
+			index = widget.list.Len() - 1
+		}
+		widget.list.Items[index].Priority = priority
+		widget.list.Items[index].Recurrence = recurrence
+
+		if widget.sortsItems() {
+			widget.placeItem(index)
 		}
 		widget.persist()
 	})
@@ -174,9 +193,17 @@ func (widget *Widget) getTextComponents(text string) (string, *time.Time, []stri
 	return text, date, tags
 }
 
+// getTodoAttributes extracts the priority and recurrence rule from the text of a todo
+func getTodoAttributes(text string) (string, int, string) {
+	text, priority := getPriority(text)
+	text, recurrence := getRecurrence(text)
+
+	return strings.TrimSpace(text), priority, recurrence
+}
+
 func getTodoTags(text string) (string, []string) {
 	tags := make([]string, 0)
-	r, _ := regexp.Compile(`(?i)(^|\s)#[a-z0-9]+`)
+	r, _ := regexp.Compile(`(^|\s)#\S+`)
 	matches := r.FindAllString(text, -1)
 
 	for _, tag := range matches {
@@ -272,12 +299,17 @@ func (widget *Widget) getTextAndDate(text string) (string, *time.Time) {
 	return text, nil
 }
 
-// persist writes the todo list to Yaml file
+// persist writes the todo list to the Yaml or todo.txt file
 func (widget *Widget) persist() {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s", confDir, widget.filePath)
 
-	fileData, _ := yaml.Marshal(&widget.list)
+	var fileData []byte
+	if widget.settings.format == formatTodoTxt {
+		fileData = widget.marshalTodoTxt()
+	} else {
+		fileData, _ = yaml.Marshal(&widget.list)
+	}
 
 	err := os.WriteFile(filePath, fileData, 0644)
 
@@ -303,10 +335,11 @@ func (widget *Widget) updateSelected() {
 
 	widget.processFormInput("Edit:", widget.SelectedItem().EditText(), func(t string) {
 		text, date, tags := widget.getTextComponents(t)
+		text, priority, recurrence := getTodoAttributes(text)
 
-		widget.updateSelectedItem(text, date, tags)
-		if widget.settings.parseDates {
-			widget.Selected = widget.placeItemBasedOnDate(widget.Selected)
+		widget.updateSelectedItem(text, date, tags, priority, recurrence)
+		if widget.sortsItems() {
+			widget.Selected = widget.placeItem(widget.Selected)
 		}
 		widget.persist()
 	})
@@ -332,7 +365,7 @@ func (widget *Widget) processFormInput(prompt string, initValue string, onSave f
 }
 
 // updateSelectedItem update the text of the selected item.
-func (widget *Widget) updateSelectedItem(text string, date *time.Time, tags []string) {
+func (widget *Widget) updateSelectedItem(text string, date *time.Time, tags []string, priority int, recurrence string) {
 	selectedItem := widget.SelectedItem()
 	if selectedItem == nil {
 		return
@@ -341,23 +374,42 @@ func (widget *Widget) updateSelectedItem(text string, date *time.Time, tags []st
 	selectedItem.Text = text
 	selectedItem.Date = date
 	selectedItem.Tags = tags
+	selectedItem.Priority = priority
+	selectedItem.Recurrence = recurrence
+}
+
+// sortsItems returns whether items are kept sorted by priority or date
+func (widget *Widget) sortsItems() bool {
+	return widget.settings.parseDates || widget.settings.sortByPriority
 }
 
-func (widget *Widget) placeItemBasedOnDate(index int) int {
+// placeItem moves the item at index up or down the list to where its priority and date
+// place it, and returns its new index
+func (widget *Widget) placeItem(index int) int {
 	// potentially move todo up
-	for index > 0 && widget.todoDateIsEarlier(index, index-1) {
+	for index > 0 && widget.todoIsEarlier(index, index-1) {
 		widget.list.Swap(index, index-1)
 		index -= 1
 	}
 	// potentially move todo down
-	for index < widget.list.Len()-1 && widget.todoDateIsEarlier(index+1, index) {
+	for index < widget.list.Len()-1 && widget.todoIsEarlier(index+1, index) {
 		widget.list.Swap(index, index+1)
 		index += 1
 	}
 	return index
 }
 
-func (widget *Widget) todoDateIsEarlier(i, j int) bool {
+// todoIsEarlier returns whether the todo at i belongs before the one at j. Higher
+// priorities come first, then earlier dates
+func (widget *Widget) todoIsEarlier(i, j int) bool {
+	if widget.settings.sortByPriority && widget.list.Items[i].Priority != widget.list.Items[j].Priority {
+		return widget.list.Items[i].Priority > widget.list.Items[j].Priority
+	}
+
+	if !widget.settings.parseDates {
+		return false
+	}
+
 	if widget.list.Items[i].Date == nil && widget.list.Items[j].Date == nil {
 		return false
 	}
@@ -1,6 +1,10 @@
 package backend
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/olebedev/config"
 )
 
@@ -12,5 +16,69 @@ type Backend interface {
 	LoadTasks(string) ([]Task, error)
 	CloseTask(*Task) error
 	DeleteTask(*Task) error
+	CreateTask(string, *Task) error
+	UpdateTask(*Task) error
+	MoveTask(*Task, string) error
 	Sources() []string
 }
+
+// SecretSpec describes the secret a backend authenticates with, so that it can be read
+// from the environment or the secret store when it isn't in the backend settings
+type SecretSpec struct {
+	// Key is the backend setting that holds the secret
+	Key string
+	// EnvVar is the environment variable the secret is read from by default
+	EnvVar string
+	// ServiceKey is the backend setting with the service the secret is stored for, and
+	// DefaultService is the service when it isn't set
+	ServiceKey     string
+	DefaultService string
+}
+
+// registration is how a backend type was registered
+type registration struct {
+	factory func() Backend
+	secret  *SecretSpec
+}
+
+// registry maps backend types to their registrations
+var registry = map[string]registration{}
+
+// Register makes a backend available under the given type. Backends register
+// themselves from an init function, with the secret they authenticate with if they
+// read it from the secret store
+func Register(backendType string, factory func() Backend, secret *SecretSpec) {
+	if _, exists := registry[backendType]; exists {
+		panic("todo_plus: backend registered twice: " + backendType)
+	}
+
+	registry[backendType] = registration{factory: factory, secret: secret}
+}
+
+// New creates an unconfigured backend of the given type
+func New(backendType string) (Backend, error) {
+	reg, ok := registry[backendType]
+	if !ok {
+		return nil, fmt.Errorf("%s is not a supported backend, use one of: %s", backendType, strings.Join(types(), ", "))
+	}
+
+	return reg.factory(), nil
+}
+
+// Secret returns the secret a backend type authenticates with, or nil if it doesn't
+// read one from the secret store
+func Secret(backendType string) *SecretSpec {
+	return registry[backendType].secret
+}
+
+// types returns the registered backend types in alphabetical order
+func types() []string {
+	types := make([]string, 0, len(registry))
+	for backendType := range registry {
+		types = append(types, backendType)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
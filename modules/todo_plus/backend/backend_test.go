@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_New(t *testing.T) {
+	for _, backendType := range []string{"caldav", "github", "gitlab", "todoist", "trello"} {
+		todoBackend, err := New(backendType)
+		require.NoError(t, err, backendType)
+		assert.NotNil(t, todoBackend, backendType)
+	}
+
+	_, err := New("asana")
+	assert.EqualError(t, err, "asana is not a supported backend, use one of: caldav, github, gitlab, todoist, trello")
+}
+
+func Test_parseIssueQuery(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected issueQuery
+		name     string
+	}{
+		{
+			id:       "wtfutil/wtf",
+			expected: issueQuery{repository: "wtfutil/wtf"},
+			name:     "wtfutil/wtf",
+		},
+		{
+			id:       "wtfutil/wtf label:bug",
+			expected: issueQuery{repository: "wtfutil/wtf", label: "bug"},
+			name:     "wtfutil/wtf: bug",
+		},
+		{
+			id:       `milestone:"Version 2" group/sub/app label:"good first issue"`,
+			expected: issueQuery{repository: "group/sub/app", label: "good first issue", milestone: "Version 2"},
+			name:     "group/sub/app: good first issue, Version 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			query := parseIssueQuery(tt.id)
+			assert.Equal(t, tt.expected, query)
+			assert.Equal(t, tt.name, query.name())
+		})
+	}
+}
+
+func Test_splitRepository(t *testing.T) {
+	owner, name, err := splitRepository("wtfutil/wtf")
+	require.NoError(t, err)
+	assert.Equal(t, "wtfutil", owner)
+	assert.Equal(t, "wtf", name)
+
+	_, _, err = splitRepository("wtf")
+	assert.Error(t, err)
+}
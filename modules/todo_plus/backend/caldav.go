@@ -0,0 +1,406 @@
+package backend
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/calendar/caldav"
+)
+
+func init() {
+	Register("caldav", func() Backend { return &CalDAV{} }, &SecretSpec{
+		Key:        "password",
+		EnvVar:     "WTF_CALDAV_PASSWORD",
+		ServiceKey: "url",
+	})
+}
+
+// CalDAV treats the to-dos (VTODO) of CalDAV calendars, such as Nextcloud Tasks
+// lists, as tasks. Each project is a calendar, given by its name in the calendar
+// home set url or by its full URL
+type CalDAV struct {
+	baseURL  string
+	client   *caldav.Client
+	projects []interface{}
+
+	// objects is guarded by mutex, as tasks are changed while others are loaded
+	mutex   sync.Mutex
+	objects map[string]caldavObject
+}
+
+// caldavObject is a calendar object resource as last read from the server
+type caldavObject struct {
+	etag string
+	data string
+}
+
+func (todo *CalDAV) Title() string {
+	return "Tasks"
+}
+
+func (todo *CalDAV) Setup(config *config.Config) {
+	todo.baseURL = config.UString("url")
+	if !strings.HasSuffix(todo.baseURL, "/") {
+		todo.baseURL += "/"
+	}
+
+	todo.client = caldav.NewClient(
+		caldav.NewHTTPClient(config.UBool("verifyServerCertificate", true)),
+		config.UString("username"),
+		config.UString("password"),
+	)
+	todo.objects = map[string]caldavObject{}
+	todo.projects = config.UList("lists")
+}
+
+func (todo *CalDAV) BuildProjects() []*Project {
+	projects := []*Project{}
+
+	for _, id := range todo.Sources() {
+		projects = append(projects, todo.GetProject(id))
+	}
+	return projects
+}
+
+func (todo *CalDAV) GetProject(id string) *Project {
+	proj := &Project{
+		Index:   -1,
+		backend: todo,
+	}
+
+	collection, err := todo.resolve(id)
+	if err != nil {
+		proj.Err = err
+		return proj
+	}
+
+	if !strings.HasSuffix(collection, "/") {
+		collection += "/"
+	}
+
+	proj.ID = collection
+	proj.Name = todo.displayName(collection, id)
+
+	tasks, err := todo.LoadTasks(collection)
+	proj.Err = err
+	proj.Tasks = tasks
+
+	return proj
+}
+
+func (todo *CalDAV) LoadTasks(collection string) ([]Task, error) {
+	objects, err := todo.client.Todos(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalTasks []Task
+	for _, object := range objects {
+		vtodo, err := firstTodo(object.Data)
+		if err != nil || isFinished(vtodo) {
+			continue
+		}
+
+		href, err := todo.resolve(object.Href)
+		if err != nil {
+			return nil, err
+		}
+
+		todo.setObject(href, caldavObject{etag: object.ETag, data: object.Data})
+
+		finalTasks = append(finalTasks, Task{
+			ID:        href,
+			ProjectID: collection,
+			Name:      propertyValue(vtodo, ics.ComponentPropertySummary),
+			Due:       dueDate(vtodo),
+		})
+	}
+
+	// Servers return calendar objects in no particular order
+	sort.SliceStable(finalTasks, func(i, j int) bool {
+		return strings.ToLower(finalTasks[i].Name) < strings.ToLower(finalTasks[j].Name)
+	})
+
+	return finalTasks, nil
+}
+
+func (todo *CalDAV) CloseTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	return todo.updateObject(task, func(vtodo *ics.VTodo, now time.Time) {
+		vtodo.SetStatus(ics.ObjectStatusCompleted)
+		vtodo.SetCompletedAt(now)
+		vtodo.SetPercentComplete(100)
+	})
+}
+
+func (todo *CalDAV) DeleteTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	headers := map[string]string{}
+	if object, ok := todo.object(task.ID); ok && object.etag != "" {
+		headers["If-Match"] = object.etag
+	}
+
+	if _, _, err := todo.client.Request("DELETE", task.ID, "", headers); err != nil {
+		return err
+	}
+
+	todo.mutex.Lock()
+	delete(todo.objects, task.ID)
+	todo.mutex.Unlock()
+
+	return nil
+}
+
+func (todo *CalDAV) CreateTask(collection string, task *Task) error {
+	uid, err := newUID()
+	if err != nil {
+		return err
+	}
+
+	href, err := url.JoinPath(collection, uid+".ics")
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	cal := ics.NewCalendarFor("wtfutil")
+	vtodo := cal.AddTodo(uid)
+	vtodo.SetCreatedTime(now)
+	vtodo.SetDtStampTime(now)
+	vtodo.SetModifiedAt(now)
+	vtodo.SetSummary(task.Name)
+	vtodo.SetStatus(ics.ObjectStatusNeedsAction)
+	setDue(vtodo, task)
+
+	if err := todo.put(href, caldav.Serialize(cal), ""); err != nil {
+		return err
+	}
+
+	task.ID = href
+	task.ProjectID = collection
+	return nil
+}
+
+func (todo *CalDAV) UpdateTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	return todo.updateObject(task, func(vtodo *ics.VTodo, _ time.Time) {
+		vtodo.SetSummary(task.Name)
+		setDue(vtodo, task)
+	})
+}
+
+// MoveTask moves a to-do to another calendar by copying it there and deleting the
+// original
+func (todo *CalDAV) MoveTask(task *Task, collection string) error {
+	if task == nil {
+		return nil
+	}
+
+	object, ok := todo.object(task.ID)
+	if !ok {
+		return fmt.Errorf("unknown task: %s", task.ID)
+	}
+
+	href, err := url.JoinPath(collection, path.Base(task.ID))
+	if err != nil {
+		return err
+	}
+
+	if err := todo.put(href, object.data, ""); err != nil {
+		return err
+	}
+
+	if err := todo.DeleteTask(task); err != nil {
+		return err
+	}
+
+	task.ID = href
+	task.ProjectID = collection
+	return nil
+}
+
+func (todo *CalDAV) Sources() []string {
+	var result []string
+	for _, id := range todo.projects {
+		result = append(result, fmt.Sprintf("%v", id))
+	}
+	return result
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// updateObject changes the to-do behind a task and marks it as modified. The change
+// is only made if the to-do hasn't been changed on the server since it was loaded.
+// Everything else in the calendar object is kept as it is
+func (todo *CalDAV) updateObject(task *Task, change func(vtodo *ics.VTodo, now time.Time)) error {
+	object, ok := todo.object(task.ID)
+	if !ok {
+		return fmt.Errorf("unknown task: %s", task.ID)
+	}
+
+	cal, err := ics.ParseCalendar(strings.NewReader(object.data))
+	if err != nil {
+		return err
+	}
+
+	todos := cal.Todos()
+	if len(todos) == 0 {
+		return fmt.Errorf("%s has no to-do", task.ID)
+	}
+
+	now := time.Now()
+	todos[0].SetDtStampTime(now)
+	todos[0].SetModifiedAt(now)
+	change(todos[0], now)
+
+	return todo.put(task.ID, caldav.Serialize(cal), object.etag)
+}
+
+// put stores a calendar object. With an etag, the object is only replaced if it
+// hasn't changed since; without one, it's only stored if it doesn't exist yet
+func (todo *CalDAV) put(href, data, etag string) error {
+	headers := map[string]string{"Content-Type": "text/calendar; charset=utf-8"}
+	if etag != "" {
+		headers["If-Match"] = etag
+	} else if _, exists := todo.object(href); !exists {
+		headers["If-None-Match"] = "*"
+	}
+
+	_, respHeaders, err := todo.client.Request("PUT", href, data, headers)
+	if err != nil {
+		return err
+	}
+
+	// Servers that don't return the new ETag can't be asked to check for changes
+	todo.setObject(href, caldavObject{etag: respHeaders.Get("ETag"), data: data})
+	return nil
+}
+
+// object returns the calendar object last read from or written to href
+func (todo *CalDAV) object(href string) (caldavObject, bool) {
+	todo.mutex.Lock()
+	defer todo.mutex.Unlock()
+
+	object, ok := todo.objects[href]
+	return object, ok
+}
+
+// setObject records the calendar object last read from or written to href
+func (todo *CalDAV) setObject(href string, object caldavObject) {
+	todo.mutex.Lock()
+	defer todo.mutex.Unlock()
+
+	todo.objects[href] = object
+}
+
+// displayName returns the display name of a calendar, or fallback if it has none
+func (todo *CalDAV) displayName(collection, fallback string) string {
+	name, err := todo.client.DisplayName(collection)
+	if err != nil || name == "" {
+		return fallback
+	}
+
+	return name
+}
+
+// resolve returns the absolute URL of a calendar or calendar object given relative
+// to the configured URL
+func (todo *CalDAV) resolve(ref string) (string, error) {
+	base, err := url.Parse(todo.baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	target, err := base.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return target.String(), nil
+}
+
+// firstTodo returns the first to-do of an iCalendar object
+func firstTodo(data string) (*ics.VTodo, error) {
+	cal, err := ics.ParseCalendar(strings.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	todos := cal.Todos()
+	if len(todos) == 0 {
+		return nil, fmt.Errorf("no to-do")
+	}
+
+	return todos[0], nil
+}
+
+// dueDate returns when a to-do is due, in local time
+func dueDate(vtodo *ics.VTodo) *time.Time {
+	due, _, err := caldav.PropertyTime(vtodo.GetProperty(ics.ComponentPropertyDue), time.Local)
+	if err != nil {
+		return nil
+	}
+
+	due = due.Local()
+	return &due
+}
+
+// setDue sets when a to-do is due. To-dos that are due on a day, rather than at a
+// time, have a DATE value
+func setDue(vtodo *ics.VTodo, task *Task) {
+	switch {
+	case task.Due == nil:
+		vtodo.RemoveProperty(ics.ComponentPropertyDue)
+	case task.HasTime():
+		vtodo.SetDueAt(*task.Due)
+	default:
+		vtodo.SetAllDayDueAt(*task.Due)
+	}
+}
+
+// isFinished returns whether a to-do has been completed or cancelled
+func isFinished(vtodo *ics.VTodo) bool {
+	switch ics.ObjectStatus(strings.ToUpper(propertyValue(vtodo, ics.ComponentPropertyStatus))) {
+	case ics.ObjectStatusCompleted, ics.ObjectStatusCancelled:
+		return true
+	}
+
+	return vtodo.GetProperty(ics.ComponentPropertyCompleted) != nil
+}
+
+func propertyValue(vtodo *ics.VTodo, property ics.ComponentProperty) string {
+	if prop := vtodo.GetProperty(property); prop != nil {
+		return prop.Value
+	}
+
+	return ""
+}
+
+// newUID returns a random unique identifier for a new to-do
+func newUID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,239 @@
+package backend
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTodos = `<?xml version="1.0"?>
+<d:multistatus xmlns:d="DAV:" xmlns:cal="urn:ietf:params:xml:ns:caldav">
+  <d:response>
+    <d:href>/dav/calendars/alice/personal/b.ics</d:href>
+    <d:propstat>
+      <d:prop>
+        <d:getetag>"2"</d:getetag>
+        <cal:calendar-data>BEGIN:VCALENDAR&#13;
+BEGIN:VTODO&#13;
+UID:b&#13;
+SUMMARY:Buy milk\, eggs&#13;
+END:VTODO&#13;
+END:VCALENDAR&#13;
+</cal:calendar-data>
+      </d:prop>
+      <d:status>HTTP/1.1 200 OK</d:status>
+    </d:propstat>
+  </d:response>
+  <d:response>
+    <d:href>/dav/calendars/alice/personal/a.ics</d:href>
+    <d:propstat>
+      <d:prop>
+        <d:getetag>"1"</d:getetag>
+        <cal:calendar-data>BEGIN:VCALENDAR
+BEGIN:VTODO
+UID:a
+SUMMARY:A very long task name that has been folded over two lines by the serv
+ er
+X-CUSTOM;X-PARAM=a:kept
+END:VTODO
+END:VCALENDAR
+</cal:calendar-data>
+      </d:prop>
+      <d:status>HTTP/1.1 200 OK</d:status>
+    </d:propstat>
+  </d:response>
+  <d:response>
+    <d:href>/dav/calendars/alice/personal/c.ics</d:href>
+    <d:propstat>
+      <d:prop>
+        <d:getetag>"3"</d:getetag>
+        <cal:calendar-data>BEGIN:VCALENDAR
+BEGIN:VTODO
+UID:c
+SUMMARY:Done already
+STATUS:COMPLETED
+END:VTODO
+END:VCALENDAR
+</cal:calendar-data>
+      </d:prop>
+      <d:status>HTTP/1.1 200 OK</d:status>
+    </d:propstat>
+  </d:response>
+</d:multistatus>`
+
+func Test_CalDAV(t *testing.T) {
+	puts := map[string]string{}
+	headers := map[string]http.Header{}
+	deletes := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "alice", user)
+		assert.Equal(t, "secret", pass)
+
+		switch {
+		case r.Method == "PROPFIND" && r.URL.Path == "/dav/calendars/alice/personal/":
+			assert.Equal(t, "0", r.Header.Get("Depth"))
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(`<d:multistatus xmlns:d="DAV:"><d:response><d:href>/dav/calendars/alice/personal/</d:href>
+				<d:propstat><d:prop><d:displayname>Personal</d:displayname></d:prop></d:propstat></d:response></d:multistatus>`))
+		case r.Method == "REPORT" && r.URL.Path == "/dav/calendars/alice/personal/":
+			assert.Equal(t, "1", r.Header.Get("Depth"))
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(testTodos))
+		case r.Method == "PUT":
+			body, _ := io.ReadAll(r.Body)
+			puts[r.URL.Path] = string(body)
+			headers[r.URL.Path] = r.Header
+			w.Header().Set("ETag", `"new"`)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == "DELETE":
+			deletes = append(deletes, r.URL.Path+" "+r.Header.Get("If-Match"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg, _ := config.ParseYaml("url: " + server.URL + "/dav/calendars/alice\nusername: alice\npassword: secret\nlists: [personal]")
+
+	todo := &CalDAV{}
+	todo.Setup(cfg)
+
+	projects := todo.BuildProjects()
+	require.Len(t, projects, 1)
+
+	proj := projects[0]
+	require.NoError(t, proj.Err)
+	assert.Equal(t, server.URL+"/dav/calendars/alice/personal/", proj.ID)
+	assert.Equal(t, "Personal", proj.Name)
+
+	require.Len(t, proj.Tasks, 2)
+	assert.Equal(t, "A very long task name that has been folded over two lines by the server", proj.Tasks[0].Name)
+	assert.Equal(t, server.URL+"/dav/calendars/alice/personal/a.ics", proj.Tasks[0].ID)
+	assert.Equal(t, "Buy milk, eggs", proj.Tasks[1].Name)
+
+	require.NoError(t, todo.CloseTask(&proj.Tasks[1]))
+
+	closed := puts["/dav/calendars/alice/personal/b.ics"]
+	assert.Equal(t, `"2"`, headers["/dav/calendars/alice/personal/b.ics"].Get("If-Match"))
+	assert.Contains(t, closed, "STATUS:COMPLETED\r\n")
+	assert.Contains(t, closed, "PERCENT-COMPLETE:100\r\n")
+	assert.Contains(t, closed, "SUMMARY:Buy milk\\, eggs\r\n")
+
+	due := time.Date(2024, 3, 12, 0, 0, 0, 0, time.Local)
+
+	renamedTask := proj.Tasks[0]
+	renamedTask.Name = "Renamed; again"
+	renamedTask.Due = &due
+	require.NoError(t, todo.UpdateTask(&renamedTask))
+
+	renamed := puts["/dav/calendars/alice/personal/a.ics"]
+	assert.Contains(t, renamed, "SUMMARY:Renamed\\; again\r\n")
+	assert.Contains(t, renamed, "DUE;VALUE=DATE:20240312\r\n")
+	assert.Contains(t, renamed, "X-CUSTOM;X-PARAM=a:kept\r\n")
+	assert.Equal(t, 1, strings.Count(renamed, "SUMMARY"))
+
+	// A second change is made against the ETag returned by the first
+	renamedTask.Due = nil
+	require.NoError(t, todo.UpdateTask(&renamedTask))
+	assert.Equal(t, `"new"`, headers["/dav/calendars/alice/personal/a.ics"].Get("If-Match"))
+	assert.NotContains(t, puts["/dav/calendars/alice/personal/a.ics"], "DUE")
+
+	require.NoError(t, todo.MoveTask(&renamedTask, server.URL+"/dav/calendars/alice/work/"))
+	assert.Equal(t, server.URL+"/dav/calendars/alice/work/a.ics", renamedTask.ID)
+	assert.Equal(t, server.URL+"/dav/calendars/alice/work/", renamedTask.ProjectID)
+	assert.Contains(t, puts["/dav/calendars/alice/work/a.ics"], "SUMMARY:Renamed\\; again\r\n")
+	assert.Equal(t, []string{`/dav/calendars/alice/personal/a.ics "new"`}, deletes)
+
+	task := &Task{Name: "New task"}
+	require.NoError(t, todo.CreateTask(proj.ID, task))
+	assert.True(t, strings.HasPrefix(task.ID, proj.ID))
+	assert.Equal(t, "*", headers[strings.TrimPrefix(task.ID, server.URL)].Get("If-None-Match"))
+	assert.Contains(t, puts[strings.TrimPrefix(task.ID, server.URL)], "SUMMARY:New task\r\n")
+}
+
+func Test_dueDate(t *testing.T) {
+	date := &ics.VTodo{}
+	date.SetProperty(ics.ComponentPropertyDue, "20240312", ics.WithValue(string(ics.ValueDataTypeDate)))
+	require.NotNil(t, dueDate(date))
+	assert.Equal(t, time.Date(2024, 3, 12, 0, 0, 0, 0, time.Local), *dueDate(date))
+
+	zoned := &ics.VTodo{}
+	zoned.SetProperty(ics.ComponentPropertyDue, "20240312T150000", &ics.KeyValues{Key: string(ics.ParameterTzid), Value: []string{"Europe/Berlin"}})
+	require.NotNil(t, dueDate(zoned))
+	assert.True(t, dueDate(zoned).Equal(time.Date(2024, 3, 12, 14, 0, 0, 0, time.UTC)))
+
+	assert.Nil(t, dueDate(&ics.VTodo{}))
+
+	timed := time.Date(2024, 3, 12, 9, 30, 0, 0, time.UTC)
+	setDue(date, &Task{Due: &timed})
+	assert.Equal(t, "20240312T093000Z", date.GetProperty(ics.ComponentPropertyDue).Value)
+	assert.Empty(t, date.GetProperty(ics.ComponentPropertyDue).ICalParameters)
+}
+
+func Test_isFinished(t *testing.T) {
+	vtodo := &ics.VTodo{}
+	assert.False(t, isFinished(vtodo))
+
+	vtodo.SetStatus(ics.ObjectStatusCancelled)
+	assert.True(t, isFinished(vtodo))
+
+	vtodo = &ics.VTodo{}
+	vtodo.SetCompletedAt(time.Now())
+	assert.True(t, isFinished(vtodo))
+}
+
+func Test_CalDAV_concurrentChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "REPORT":
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte(testTodos))
+		case "PUT":
+			w.Header().Set("ETag", `"new"`)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg, _ := config.ParseYaml("url: " + server.URL + "/dav/calendars/alice")
+
+	todo := &CalDAV{}
+	todo.Setup(cfg)
+
+	collection := server.URL + "/dav/calendars/alice/personal/"
+	tasks, err := todo.LoadTasks(collection)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+
+	// Tasks are changed while the widget refreshes
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := todo.LoadTasks(collection)
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			task := tasks[0]
+			assert.NoError(t, todo.UpdateTask(&task))
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,259 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	ghb "github.com/google/go-github/v32/github"
+	"github.com/olebedev/config"
+	"golang.org/x/oauth2"
+)
+
+func init() {
+	Register("github", func() Backend { return &GitHub{} }, &SecretSpec{
+		Key:        "apiKey",
+		EnvVar:     "WTF_GITHUB_TOKEN",
+		ServiceKey: "baseURL",
+	})
+}
+
+// GitHub treats the open issues of GitHub repositories as tasks. Each project is a
+// repository, optionally filtered by label or milestone, e.g. "wtfutil/wtf label:bug"
+type GitHub struct {
+	client   *ghb.Client
+	err      error
+	projects []interface{}
+
+	// milestones is guarded by mutex, as tasks are changed while others are loaded
+	mutex      sync.Mutex
+	milestones map[string]int
+}
+
+func (todo *GitHub) Title() string {
+	return "GitHub Issues"
+}
+
+func (todo *GitHub) Setup(config *config.Config) {
+	todo.projects = config.UList("projects")
+	todo.milestones = map[string]int{}
+
+	httpClient := http.DefaultClient
+	if apiKey := config.UString("apiKey"); apiKey != "" {
+		tokenService := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiKey})
+		httpClient = oauth2.NewClient(context.Background(), tokenService)
+	}
+
+	baseURL := config.UString("baseURL")
+	if baseURL == "" {
+		todo.client = ghb.NewClient(httpClient)
+		return
+	}
+
+	todo.client, todo.err = ghb.NewEnterpriseClient(baseURL, config.UString("uploadURL", baseURL), httpClient)
+}
+
+func (todo *GitHub) BuildProjects() []*Project {
+	projects := []*Project{}
+
+	for _, id := range todo.Sources() {
+		projects = append(projects, todo.GetProject(id))
+	}
+	return projects
+}
+
+func (todo *GitHub) GetProject(id string) *Project {
+	proj := &Project{
+		ID:      id,
+		Name:    parseIssueQuery(id).name(),
+		Index:   -1,
+		backend: todo,
+	}
+
+	tasks, err := todo.LoadTasks(id)
+	proj.Err = err
+	proj.Tasks = tasks
+
+	return proj
+}
+
+func (todo *GitHub) LoadTasks(id string) ([]Task, error) {
+	if todo.err != nil {
+		return nil, todo.err
+	}
+
+	query := parseIssueQuery(id)
+	owner, repo, err := splitRepository(query.repository)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &ghb.IssueListByRepoOptions{
+		State:       "open",
+		ListOptions: ghb.ListOptions{PerPage: 100},
+	}
+
+	if query.label != "" {
+		opts.Labels = []string{query.label}
+	}
+
+	if query.milestone != "" {
+		number, err := todo.milestoneNumber(owner, repo, query.milestone)
+		if err != nil {
+			return nil, err
+		}
+		opts.Milestone = strconv.Itoa(number)
+	}
+
+	issues, _, err := todo.client.Issues.ListByRepo(context.Background(), owner, repo, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalTasks []Task
+	for _, issue := range issues {
+		// The issues API also returns pull requests
+		if issue.IsPullRequest() {
+			continue
+		}
+
+		finalTasks = append(finalTasks, Task{
+			ID:        strconv.Itoa(issue.GetNumber()),
+			ProjectID: id,
+			Name:      issue.GetTitle(),
+		})
+	}
+	return finalTasks, nil
+}
+
+func (todo *GitHub) CloseTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	return todo.editIssue(task, &ghb.IssueRequest{State: ghb.String("closed")})
+}
+
+func (todo *GitHub) DeleteTask(_ *Task) error {
+	return errors.New("GitHub issues can't be deleted, close them instead")
+}
+
+func (todo *GitHub) CreateTask(projectID string, task *Task) error {
+	query := parseIssueQuery(projectID)
+	owner, repo, err := splitRepository(query.repository)
+	if err != nil {
+		return err
+	}
+
+	if task.Due != nil {
+		return errors.New("GitHub issues don't have due dates")
+	}
+
+	request := &ghb.IssueRequest{Title: ghb.String(task.Name)}
+
+	if query.label != "" {
+		request.Labels = &[]string{query.label}
+	}
+
+	if query.milestone != "" {
+		number, err := todo.milestoneNumber(owner, repo, query.milestone)
+		if err != nil {
+			return err
+		}
+		request.Milestone = &number
+	}
+
+	issue, _, err := todo.client.Issues.Create(context.Background(), owner, repo, request)
+	if err != nil {
+		return err
+	}
+
+	task.ID = strconv.Itoa(issue.GetNumber())
+	task.ProjectID = projectID
+	return nil
+}
+
+func (todo *GitHub) UpdateTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	if task.Due != nil {
+		return errors.New("GitHub issues don't have due dates")
+	}
+
+	return todo.editIssue(task, &ghb.IssueRequest{Title: ghb.String(task.Name)})
+}
+
+func (todo *GitHub) MoveTask(_ *Task, _ string) error {
+	return errors.New("GitHub issues can't be moved to another project")
+}
+
+func (todo *GitHub) Sources() []string {
+	return issueSources(todo.projects)
+}
+
+// editIssue applies a change to the issue behind a task
+func (todo *GitHub) editIssue(task *Task, request *ghb.IssueRequest) error {
+	owner, repo, err := splitRepository(parseIssueQuery(task.ProjectID).repository)
+	if err != nil {
+		return err
+	}
+
+	number, err := issueNumber(task)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = todo.client.Issues.Edit(context.Background(), owner, repo, number, request)
+	return err
+}
+
+// milestoneNumber looks up the number of an open milestone by its title
+func (todo *GitHub) milestoneNumber(owner, repo, title string) (int, error) {
+	key := owner + "/" + repo + "/" + title
+
+	todo.mutex.Lock()
+	number, ok := todo.milestones[key]
+	todo.mutex.Unlock()
+
+	if ok {
+		return number, nil
+	}
+
+	opts := &ghb.MilestoneListOptions{
+		State:       "open",
+		ListOptions: ghb.ListOptions{PerPage: 100},
+	}
+
+	milestones, _, err := todo.client.Issues.ListMilestones(context.Background(), owner, repo, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, milestone := range milestones {
+		if milestone.GetTitle() == title {
+			todo.mutex.Lock()
+			todo.milestones[key] = milestone.GetNumber()
+			todo.mutex.Unlock()
+
+			return milestone.GetNumber(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("could not find milestone %s in %s/%s", title, owner, repo)
+}
+
+// splitRepository splits a repository such as wtfutil/wtf into its owner and name
+func splitRepository(repository string) (string, string, error) {
+	owner, name, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || name == "" {
+		return "", "", fmt.Errorf("invalid repository: %q, use owner/name", repository)
+	}
+
+	return owner, name, nil
+}
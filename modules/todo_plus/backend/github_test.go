@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GitHub(t *testing.T) {
+	var created map[string]interface{}
+	var edited map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v3/repos/wtfutil/wtf/milestones":
+			_, _ = w.Write([]byte(`[{"number": 3, "title": "v1.0"}, {"number": 4, "title": "v2.0"}]`))
+		case r.Method == "GET" && r.URL.Path == "/api/v3/repos/wtfutil/wtf/issues":
+			assert.Equal(t, "open", r.URL.Query().Get("state"))
+			assert.Equal(t, "bug", r.URL.Query().Get("labels"))
+			assert.Equal(t, "4", r.URL.Query().Get("milestone"))
+			_, _ = w.Write([]byte(`[
+				{"number": 12, "title": "Crash on start"},
+				{"number": 13, "title": "Fix crash", "pull_request": {"url": "https://example.com"}}
+			]`))
+		case r.Method == "POST" && r.URL.Path == "/api/v3/repos/wtfutil/wtf/issues":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"number": 14, "title": "New bug"}`))
+		case r.Method == "PATCH" && r.URL.Path == "/api/v3/repos/wtfutil/wtf/issues/12":
+			_ = json.NewDecoder(r.Body).Decode(&edited)
+			_, _ = w.Write([]byte(`{"number": 12}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg, _ := config.ParseYaml("apiKey: secret\nbaseURL: " + server.URL + "\nprojects: ['wtfutil/wtf label:bug milestone:v2.0']")
+
+	todo := &GitHub{}
+	todo.Setup(cfg)
+
+	projects := todo.BuildProjects()
+	require.Len(t, projects, 1)
+
+	proj := projects[0]
+	require.NoError(t, proj.Err)
+	assert.Equal(t, "wtfutil/wtf: bug, v2.0", proj.Name)
+	require.Len(t, proj.Tasks, 1)
+	assert.Equal(t, "12", proj.Tasks[0].ID)
+	assert.Equal(t, "Crash on start", proj.Tasks[0].Name)
+
+	task := &Task{Name: "New bug"}
+	require.NoError(t, todo.CreateTask(proj.ID, task))
+	assert.Equal(t, "14", task.ID)
+	assert.Equal(t, "New bug", created["title"])
+	assert.Equal(t, []interface{}{"bug"}, created["labels"])
+	assert.Equal(t, float64(4), created["milestone"])
+
+	require.NoError(t, todo.CloseTask(&proj.Tasks[0]))
+	assert.Equal(t, "closed", edited["state"])
+
+	assert.Error(t, todo.DeleteTask(&proj.Tasks[0]))
+}
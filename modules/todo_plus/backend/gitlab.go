@@ -0,0 +1,284 @@
+package backend
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/olebedev/config"
+	glb "github.com/xanzy/go-gitlab"
+)
+
+func init() {
+	Register("gitlab", func() Backend { return &GitLab{} }, &SecretSpec{
+		Key:            "apiKey",
+		EnvVar:         "WTF_GITLAB_TOKEN",
+		ServiceKey:     "domain",
+		DefaultService: "https://gitlab.com",
+	})
+}
+
+// GitLab treats the open issues of GitLab projects as tasks. Each project is a
+// project path, optionally filtered by label or milestone, e.g. "group/app label:bug"
+type GitLab struct {
+	client     *glb.Client
+	err        error
+	milestones map[string]int
+	projects   []interface{}
+}
+
+func (todo *GitLab) Title() string {
+	return "GitLab Issues"
+}
+
+func (todo *GitLab) Setup(config *config.Config) {
+	todo.projects = config.UList("projects")
+	todo.milestones = map[string]int{}
+
+	todo.client, todo.err = glb.NewClient(
+		config.UString("apiKey"),
+		glb.WithBaseURL(config.UString("domain", "https://gitlab.com")),
+	)
+}
+
+func (todo *GitLab) BuildProjects() []*Project {
+	projects := []*Project{}
+
+	for _, id := range todo.Sources() {
+		projects = append(projects, todo.GetProject(id))
+	}
+	return projects
+}
+
+func (todo *GitLab) GetProject(id string) *Project {
+	proj := &Project{
+		ID:      id,
+		Name:    parseIssueQuery(id).name(),
+		Index:   -1,
+		backend: todo,
+	}
+
+	tasks, err := todo.LoadTasks(id)
+	proj.Err = err
+	proj.Tasks = tasks
+
+	return proj
+}
+
+func (todo *GitLab) LoadTasks(id string) ([]Task, error) {
+	if todo.err != nil {
+		return nil, todo.err
+	}
+
+	query := parseIssueQuery(id)
+
+	opts := &glb.ListProjectIssuesOptions{
+		ListOptions: glb.ListOptions{PerPage: 100},
+		State:       glb.Ptr("opened"),
+	}
+
+	if query.label != "" {
+		opts.Labels = &glb.LabelOptions{query.label}
+	}
+
+	if query.milestone != "" {
+		opts.Milestone = glb.Ptr(query.milestone)
+	}
+
+	issues, _, err := todo.client.Issues.ListProjectIssues(query.repository, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var finalTasks []Task
+	for _, issue := range issues {
+		finalTasks = append(finalTasks, Task{
+			ID:        strconv.Itoa(issue.IID),
+			ProjectID: id,
+			Name:      issue.Title,
+			Due:       gitlabDue(issue.DueDate),
+		})
+	}
+	return finalTasks, nil
+}
+
+func (todo *GitLab) CloseTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	return todo.updateIssue(task, &glb.UpdateIssueOptions{StateEvent: glb.Ptr("close")})
+}
+
+func (todo *GitLab) DeleteTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	number, err := issueNumber(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = todo.client.Issues.DeleteIssue(parseIssueQuery(task.ProjectID).repository, number)
+	return err
+}
+
+func (todo *GitLab) CreateTask(projectID string, task *Task) error {
+	query := parseIssueQuery(projectID)
+
+	opts := &glb.CreateIssueOptions{Title: glb.Ptr(task.Name), DueDate: gitlabDueDate(task.Due)}
+
+	if query.label != "" {
+		opts.Labels = &glb.LabelOptions{query.label}
+	}
+
+	if query.milestone != "" {
+		milestoneID, err := todo.milestoneID(query.repository, query.milestone)
+		if err != nil {
+			return err
+		}
+		opts.MilestoneID = &milestoneID
+	}
+
+	issue, _, err := todo.client.Issues.CreateIssue(query.repository, opts)
+	if err != nil {
+		return err
+	}
+
+	task.ID = strconv.Itoa(issue.IID)
+	task.ProjectID = projectID
+	return nil
+}
+
+func (todo *GitLab) UpdateTask(task *Task) error {
+	if task == nil {
+		return nil
+	}
+
+	opts := &glb.UpdateIssueOptions{
+		Title: glb.Ptr(task.Name),
+		// A zero date clears the due date
+		DueDate: &glb.ISOTime{},
+	}
+
+	if dueDate := gitlabDueDate(task.Due); dueDate != nil {
+		opts.DueDate = dueDate
+	}
+
+	return todo.updateIssue(task, opts)
+}
+
+// MoveTask moves an issue to another project. If the project is a different filter of
+// the same GitLab project, the issue's label and milestone are changed to match it;
+// otherwise the issue is moved to the other GitLab project
+func (todo *GitLab) MoveTask(task *Task, projectID string) error {
+	if task == nil {
+		return nil
+	}
+
+	from, to := parseIssueQuery(task.ProjectID), parseIssueQuery(projectID)
+
+	number, err := issueNumber(task)
+	if err != nil {
+		return err
+	}
+
+	if from.repository != to.repository {
+		target, _, err := todo.client.Projects.GetProject(to.repository, nil)
+		if err != nil {
+			return err
+		}
+
+		issue, _, err := todo.client.Issues.MoveIssue(from.repository, number, &glb.MoveIssueOptions{ToProjectID: &target.ID})
+		if err != nil {
+			return err
+		}
+
+		number = issue.IID
+	}
+
+	opts := &glb.UpdateIssueOptions{}
+
+	if to.label != "" {
+		opts.AddLabels = &glb.LabelOptions{to.label}
+	}
+
+	if from.label != "" && from.label != to.label {
+		opts.RemoveLabels = &glb.LabelOptions{from.label}
+	}
+
+	if to.milestone != "" {
+		milestoneID, err := todo.milestoneID(to.repository, to.milestone)
+		if err != nil {
+			return err
+		}
+		opts.MilestoneID = &milestoneID
+	}
+
+	task.ID = strconv.Itoa(number)
+	task.ProjectID = projectID
+
+	if opts.AddLabels == nil && opts.RemoveLabels == nil && opts.MilestoneID == nil {
+		return nil
+	}
+
+	return todo.updateIssue(task, opts)
+}
+
+func (todo *GitLab) Sources() []string {
+	return issueSources(todo.projects)
+}
+
+// updateIssue applies a change to the issue behind a task
+func (todo *GitLab) updateIssue(task *Task, opts *glb.UpdateIssueOptions) error {
+	number, err := issueNumber(task)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = todo.client.Issues.UpdateIssue(parseIssueQuery(task.ProjectID).repository, number, opts)
+	return err
+}
+
+// gitlabDue converts the due date of an issue
+func gitlabDue(dueDate *glb.ISOTime) *time.Time {
+	if dueDate == nil || time.Time(*dueDate).IsZero() {
+		return nil
+	}
+
+	date := time.Time(*dueDate)
+	local := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	return &local
+}
+
+// gitlabDueDate converts a due date into the due date of an issue. Issues are due on
+// a day, so the time of day is dropped
+func gitlabDueDate(due *time.Time) *glb.ISOTime {
+	if due == nil {
+		return nil
+	}
+
+	date := glb.ISOTime(time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC))
+	return &date
+}
+
+// milestoneID looks up the ID of a project milestone by its title
+func (todo *GitLab) milestoneID(repository, title string) (int, error) {
+	key := repository + "/" + title
+	if id, ok := todo.milestones[key]; ok {
+		return id, nil
+	}
+
+	milestones, _, err := todo.client.Milestones.ListMilestones(repository, &glb.ListMilestonesOptions{Title: glb.Ptr(title)})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(milestones) == 0 {
+		return 0, fmt.Errorf("could not find milestone %s in %s", title, repository)
+	}
+
+	todo.milestones[key] = milestones[0].ID
+	return milestones[0].ID, nil
+}
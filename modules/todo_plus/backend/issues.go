@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// issueFilterRegex matches a label or milestone filter in an issue project, e.g.
+// label:bug or milestone:"Version 2"
+var issueFilterRegex = regexp.MustCompile(`(label|milestone):("[^"]*"|\S+)`)
+
+// issueQuery is a project of an issue tracker backend: the open issues of a
+// repository, optionally only those with a label or in a milestone. It's written as
+// the repository followed by its filters, e.g. "wtfutil/wtf label:bug"
+type issueQuery struct {
+	repository string
+	label      string
+	milestone  string
+}
+
+// parseIssueQuery parses the ID of an issue project
+func parseIssueQuery(id string) issueQuery {
+	query := issueQuery{}
+
+	for _, match := range issueFilterRegex.FindAllStringSubmatch(id, -1) {
+		value := strings.Trim(match[2], `"`)
+
+		switch match[1] {
+		case "label":
+			query.label = value
+		case "milestone":
+			query.milestone = value
+		}
+	}
+
+	rest := issueFilterRegex.ReplaceAllString(id, "")
+	if fields := strings.Fields(rest); len(fields) > 0 {
+		query.repository = fields[0]
+	}
+
+	return query
+}
+
+// name returns the name of the project shown in the widget's title
+func (query issueQuery) name() string {
+	filters := []string{}
+
+	if query.label != "" {
+		filters = append(filters, query.label)
+	}
+
+	if query.milestone != "" {
+		filters = append(filters, query.milestone)
+	}
+
+	if len(filters) == 0 {
+		return query.repository
+	}
+
+	return fmt.Sprintf("%s: %s", query.repository, strings.Join(filters, ", "))
+}
+
+// issueNumber parses the ID of an issue task
+func issueNumber(task *Task) (int, error) {
+	number, err := strconv.Atoi(task.ID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid issue number: %s", task.ID)
+	}
+
+	return number, nil
+}
+
+// issueSources returns the IDs of the configured issue projects
+func issueSources(projects []interface{}) []string {
+	var result []string
+	for _, id := range projects {
+		result = append(result, fmt.Sprintf("%v", id))
+	}
+	return result
+}
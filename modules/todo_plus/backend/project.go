@@ -1,9 +1,23 @@
 package backend
 
+import "time"
+
 type Task struct {
 	ID        string
+	ProjectID string
 	Completed bool
 	Name      string
+	Due       *time.Time
+}
+
+// HasTime returns whether the task is due at a time of day rather than just on a day
+func (task *Task) HasTime() bool {
+	if task.Due == nil {
+		return false
+	}
+
+	hour, min, sec := task.Due.Clock()
+	return hour != 0 || min != 0 || sec != 0
 }
 
 type Project struct {
@@ -20,12 +34,6 @@ func (proj *Project) IsLast() bool {
 	return proj.Index >= len(proj.Tasks)-1
 }
 
-func (proj *Project) loadTasks() {
-	Tasks, err := proj.backend.LoadTasks(proj.ID)
-	proj.Err = err
-	proj.Tasks = Tasks
-}
-
 func (proj *Project) LongestLine() int {
 	maxLen := 0
 
@@ -38,29 +46,60 @@ func (proj *Project) LongestLine() int {
 	return maxLen
 }
 
-func (proj *Project) currentTask() *Task {
-	if proj.Index < 0 {
+// SelectedTask returns the currently-selected task, or nil if there is none
+func (proj *Project) SelectedTask() *Task {
+	if proj.Index < 0 || proj.Index >= len(proj.Tasks) {
 		return nil
 	}
 
 	return &proj.Tasks[proj.Index]
 }
 
-func (proj *Project) CloseSelectedTask() {
-	currTask := proj.currentTask()
+// TaskIndex returns the position of the task with the given ID, or -1 if the
+// project doesn't have it
+func (proj *Project) TaskIndex(id string) int {
+	for idx, task := range proj.Tasks {
+		if task.ID == id {
+			return idx
+		}
+	}
 
-	if currTask != nil {
-		_ = proj.backend.CloseTask(currTask)
-		proj.loadTasks()
+	return -1
+}
+
+// InsertTask adds a task to the project at the given position, or at the end if
+// the position is out of range
+func (proj *Project) InsertTask(idx int, task Task) {
+	if idx < 0 || idx > len(proj.Tasks) {
+		idx = len(proj.Tasks)
 	}
+
+	proj.Tasks = append(proj.Tasks[:idx], append([]Task{task}, proj.Tasks[idx:]...)...)
 }
 
-func (proj *Project) DeleteSelectedTask() {
-	currTask := proj.currentTask()
+// RemoveTask removes the task with the given ID from the project and returns it
+// along with the position it had
+func (proj *Project) RemoveTask(id string) (Task, int, bool) {
+	idx := proj.TaskIndex(id)
+	if idx < 0 {
+		return Task{}, -1, false
+	}
+
+	task := proj.Tasks[idx]
+	proj.Tasks = append(proj.Tasks[:idx], proj.Tasks[idx+1:]...)
 
-	if currTask != nil {
-		_ = proj.backend.DeleteTask(currTask)
+	return task, idx, true
+}
 
-		proj.loadTasks()
+// ReplaceTask replaces the task with the given ID and returns the task it replaced
+func (proj *Project) ReplaceTask(id string, task Task) (Task, bool) {
+	idx := proj.TaskIndex(id)
+	if idx < 0 {
+		return Task{}, false
 	}
+
+	previous := proj.Tasks[idx]
+	proj.Tasks[idx] = task
+
+	return previous, true
 }
@@ -0,0 +1,57 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func taskIDs(proj *Project) []string {
+	ids := []string{}
+	for _, task := range proj.Tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func Test_Project_tasks(t *testing.T) {
+	proj := &Project{Index: -1, Tasks: []Task{{ID: "a"}, {ID: "b"}, {ID: "c"}}}
+
+	assert.Nil(t, proj.SelectedTask())
+	proj.Index = 1
+	assert.Equal(t, "b", proj.SelectedTask().ID)
+
+	removed, idx, ok := proj.RemoveTask("b")
+	assert.True(t, ok)
+	assert.Equal(t, "b", removed.ID)
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, []string{"a", "c"}, taskIDs(proj))
+
+	_, _, ok = proj.RemoveTask("x")
+	assert.False(t, ok)
+
+	proj.InsertTask(idx, removed)
+	assert.Equal(t, []string{"a", "b", "c"}, taskIDs(proj))
+
+	proj.InsertTask(-1, Task{ID: "d"})
+	assert.Equal(t, []string{"a", "b", "c", "d"}, taskIDs(proj))
+
+	previous, ok := proj.ReplaceTask("d", Task{ID: "e", Name: "renamed"})
+	assert.True(t, ok)
+	assert.Equal(t, "d", previous.ID)
+	assert.Equal(t, 3, proj.TaskIndex("e"))
+	assert.Equal(t, -1, proj.TaskIndex("d"))
+
+	proj.Index = 10
+	assert.Nil(t, proj.SelectedTask())
+}
+
+func Test_Task_HasTime(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	timed := time.Date(2024, 3, 1, 9, 30, 0, 0, time.Local)
+
+	assert.False(t, (&Task{}).HasTime())
+	assert.False(t, (&Task{Due: &day}).HasTime())
+	assert.True(t, (&Task{Due: &timed}).HasTime())
+}
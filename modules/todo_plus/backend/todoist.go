@@ -1,12 +1,23 @@
 package backend
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/todoist"
 )
 
+// todoistSyncURL is the Sync API endpoint, used for what the REST API can't do
+var todoistSyncURL = "https://api.todoist.com/sync/v9/sync"
+
+func init() {
+	Register("todoist", func() Backend { return &Todoist{} }, nil)
+}
+
 type Todoist struct {
 	projects []interface{}
 }
@@ -57,8 +68,39 @@ func (todo *Todoist) GetProject(id string) *Project {
 func toTask(task todoist.Task) Task {
 	return Task{
 		ID:        task.ID,
+		ProjectID: task.ProjectID,
 		Completed: task.Completed,
 		Name:      task.Content,
+		Due:       todoistDue(task.Due),
+	}
+}
+
+// todoistDue converts the due date of a Todoist task, which is either a day or a
+// time of day
+func todoistDue(due todoist.Due) *time.Time {
+	if !due.Datetime.IsZero() {
+		local := due.Datetime.Local()
+		return &local
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", due.Date, time.Local)
+	if err != nil {
+		return nil
+	}
+
+	return &date
+}
+
+// todoistDueString returns the due string that sets a task's due date. Todoist
+// parses it like a date typed into its own apps
+func todoistDueString(task *Task) string {
+	switch {
+	case task.Due == nil:
+		return "no date"
+	case task.HasTime():
+		return task.Due.Format("2006-01-02 15:04")
+	default:
+		return task.Due.Format("2006-01-02")
 	}
 }
 
@@ -91,6 +133,84 @@ func (todo *Todoist) DeleteTask(task *Task) error {
 	return nil
 }
 
+func (todo *Todoist) CreateTask(projectID string, task *Task) error {
+	internal := todoist.Task{ProjectID: projectID, Content: task.Name}
+	if task.Due != nil {
+		internal.Due.String = todoistDueString(task)
+	}
+
+	created, err := todoist.CreateTask(internal)
+	if err != nil {
+		return err
+	}
+
+	task.ID = created.ID
+	task.ProjectID = projectID
+	return nil
+}
+
+func (todo *Todoist) UpdateTask(task *Task) error {
+	if task != nil {
+		internal := todoist.Task{ID: task.ID, Content: task.Name}
+		internal.Due.String = todoistDueString(task)
+		return internal.Update()
+	}
+	return nil
+}
+
+// MoveTask moves a task to another project. The REST API can't move tasks, so this
+// goes through the Sync API
+func (todo *Todoist) MoveTask(task *Task, projectID string) error {
+	// Commands are deduplicated by their UUID, so every move needs a new one
+	uuid, err := newUID()
+	if err != nil {
+		return err
+	}
+
+	command := map[string]interface{}{
+		"type": "item_move",
+		"uuid": uuid,
+		"args": map[string]string{"id": task.ID, "project_id": projectID},
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"commands": []interface{}{command}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, todoistSyncURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+todoist.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("moving task: %s", resp.Status)
+	}
+
+	result := struct {
+		SyncStatus map[string]json.RawMessage `json:"sync_status"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	// The status of each command is either "ok" or an error object
+	if status := result.SyncStatus[uuid]; string(status) != `"ok"` {
+		return fmt.Errorf("moving task: %s", status)
+	}
+
+	task.ProjectID = projectID
+	return nil
+}
+
 func (todo *Todoist) Sources() []string {
 	var result []string
 	for _, id := range todo.projects {
@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtfutil/todoist"
+)
+
+func Test_Todoist_MoveTask(t *testing.T) {
+	status := `"ok"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		body := struct {
+			Commands []struct {
+				Type string            `json:"type"`
+				UUID string            `json:"uuid"`
+				Args map[string]string `json:"args"`
+			} `json:"commands"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Commands, 1)
+
+		command := body.Commands[0]
+		assert.Equal(t, "item_move", command.Type)
+		assert.Equal(t, map[string]string{"id": "42", "project_id": "7"}, command.Args)
+
+		_, _ = w.Write([]byte(`{"sync_status": {"` + command.UUID + `": ` + status + `}}`))
+	}))
+	defer server.Close()
+
+	defer func(url string) { todoistSyncURL = url }(todoistSyncURL)
+	todoistSyncURL = server.URL
+	todoist.Token = "secret"
+
+	task := &Task{ID: "42", ProjectID: "3"}
+	require.NoError(t, (&Todoist{}).MoveTask(task, "7"))
+	assert.Equal(t, "7", task.ProjectID)
+
+	status = `{"error": "Project not found"}`
+	assert.EqualError(t, (&Todoist{}).MoveTask(&Task{ID: "42"}, "7"), `moving task: {"error": "Project not found"}`)
+}
+
+func Test_todoistDue(t *testing.T) {
+	assert.Nil(t, todoistDue(todoist.Due{}))
+
+	date := todoistDue(todoist.Due{Date: "2024-03-12"})
+	require.NotNil(t, date)
+	assert.Equal(t, "2024-03-12", todoistDueString(&Task{Due: date}))
+
+	timed := todoistDue(todoist.Due{Date: "2024-03-12", Datetime: time.Date(2024, 3, 12, 14, 0, 0, 0, time.Local)})
+	require.NotNil(t, timed)
+	assert.Equal(t, "2024-03-12 14:00", todoistDueString(&Task{Due: timed}))
+
+	assert.Equal(t, "no date", todoistDueString(&Task{}))
+}
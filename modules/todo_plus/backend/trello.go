@@ -3,17 +3,26 @@ package backend
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/adlio/trello"
 	"github.com/olebedev/config"
 )
 
+func init() {
+	Register("trello", func() Backend { return &Trello{} }, nil)
+}
+
 type Trello struct {
 	username  string
 	boardName string
 	client    *trello.Client
 	board     string
 	projects  []interface{}
+
+	// archiveOnDelete archives cards when their task is deleted. Trello can't undo a
+	// deletion, so cards are left alone unless this is opted into
+	archiveOnDelete bool
 }
 
 func (todo *Trello) Title() string {
@@ -33,6 +42,7 @@ func (todo *Trello) Setup(config *config.Config) {
 	}
 	todo.board = board
 	todo.projects = config.UList("lists")
+	todo.archiveOnDelete = config.UBool("archiveOnDelete", false)
 }
 
 func getBoardID(client *trello.Client, username, boardName string) (string, error) {
@@ -123,11 +133,22 @@ func (todo *Trello) GetProject(id string) *Project {
 func fromTrello(task *trello.Card) Task {
 	return Task{
 		ID:        task.ID,
+		ProjectID: task.IDList,
 		Completed: task.Closed,
 		Name:      task.Name,
+		Due:       trelloDue(task.Due),
 	}
 }
 
+func trelloDue(due *time.Time) *time.Time {
+	if due == nil {
+		return nil
+	}
+
+	local := due.Local()
+	return &local
+}
+
 func (todo *Trello) LoadTasks(id string) ([]Task, error) {
 	tasks, err := getCardsOnList(todo.client, id)
 
@@ -157,7 +178,64 @@ func (todo *Trello) CloseTask(task *Task) error {
 	return nil
 }
 
-func (todo *Trello) DeleteTask(_ *Task) error {
+func (todo *Trello) DeleteTask(task *Task) error {
+	if task == nil || !todo.archiveOnDelete {
+		return nil
+	}
+
+	internal, err := todo.client.GetCard(task.ID, trello.Arguments{})
+	if err != nil {
+		return err
+	}
+	return internal.Archive()
+}
+
+func (todo *Trello) CreateTask(listID string, task *Task) error {
+	card := &trello.Card{
+		Name:   task.Name,
+		IDList: listID,
+		Due:    task.Due,
+	}
+
+	if err := todo.client.CreateCard(card, trello.Arguments{"pos": "bottom"}); err != nil {
+		return err
+	}
+
+	task.ID = card.ID
+	task.ProjectID = listID
+	return nil
+}
+
+func (todo *Trello) UpdateTask(task *Task) error {
+	if task != nil {
+		internal, err := todo.client.GetCard(task.ID, trello.Arguments{})
+		if err != nil {
+			return err
+		}
+
+		due := "null"
+		if task.Due != nil {
+			due = task.Due.Format(time.RFC3339)
+		}
+
+		return internal.Update(trello.Arguments{"name": task.Name, "due": due})
+	}
+	return nil
+}
+
+func (todo *Trello) MoveTask(task *Task, listID string) error {
+	if task != nil {
+		internal, err := todo.client.GetCard(task.ID, trello.Arguments{})
+		if err != nil {
+			return err
+		}
+
+		if err := internal.MoveToList(listID, trello.Arguments{"pos": "bottom"}); err != nil {
+			return err
+		}
+
+		task.ProjectID = listID
+	}
 	return nil
 }
 
@@ -2,12 +2,20 @@ package todo_plus
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 )
 
 func (widget *Widget) content() (string, string, bool) {
+	if widget.err != nil {
+		return widget.CommonSettings().Title, widget.err.Error(), true
+	}
+
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
 	proj := widget.CurrentProject()
 
 	if proj == nil {
@@ -25,19 +33,51 @@ func (widget *Widget) content() (string, string, bool) {
 
 	str := ""
 
+	if widget.actionErr != nil {
+		str += fmt.Sprintf("[%s]%s[white]\n", widget.settings.overdueColor, tview.Escape(widget.actionErr.Error()))
+	}
+
+	now := time.Now()
+
 	for idx, item := range proj.Tasks {
+		due := dueLabel(item.Due, now)
+		if due != "" {
+			due = " " + due
+		}
+
 		row := fmt.Sprintf(
-			`[%s]| | %s[%s]`,
+			`[%s]| | %s[%s]%s[%s]`,
 			widget.RowColor(idx),
 			tview.Escape(item.Name),
+			widget.dueColor(item.Due, now),
+			due,
 			widget.RowColor(idx),
 		)
 
-		str += utils.HighlightableHelper(widget.View, row, idx, len(item.Name))
+		str += utils.HighlightableHelper(widget.View, row, idx, len(item.Name)+len(due))
 	}
 	return title, str, false
 }
 
+// dueColor returns the color of a due date: overdue, due today, or later
+func (widget *Widget) dueColor(due *time.Time, now time.Time) string {
+	if due == nil {
+		return widget.settings.dateColor
+	}
+
+	today := due.Format("2006-01-02") == now.Format("2006-01-02")
+	timed := due.Hour() != 0 || due.Minute() != 0
+
+	switch {
+	case today && (!timed || due.After(now)):
+		return widget.settings.dueTodayColor
+	case due.Before(now):
+		return widget.settings.overdueColor
+	default:
+		return widget.settings.dateColor
+	}
+}
+
 func (widget *Widget) display() {
 	widget.Redraw(widget.content)
 }
@@ -0,0 +1,185 @@
+package todo_plus
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dueTimeRegex matches a time of day at the end of a due date, e.g. 15:30, 3pm or 9:15am
+var dueTimeRegex = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+
+// dueOffsetRegex matches a due date relative to today, e.g. +3d, 2w or in 3 days
+var dueOffsetRegex = regexp.MustCompile(`^(?:\+|in\s+)?(\d+)\s*(d|days?|w|weeks?|m|months?)$`)
+
+// dueDateFormats are the absolute due dates that are understood. Dates without a year
+// are in the coming year
+var dueDateFormats = []string{"2006-01-02", "2006/01/02", "Jan 2 2006", "2 Jan 2006", "Jan 2", "2 Jan"}
+
+// parseDue parses the due date typed into the task form, relative to now. The date is
+// either absolute, e.g. 2024-03-12 or Mar 12, a weekday, today or tomorrow, or an
+// offset such as +3d or in 2 weeks. It can be followed by a time of day. An empty
+// string clears the due date
+func parseDue(text string, now time.Time) (*time.Time, error) {
+	text = strings.ToLower(strings.Join(strings.Fields(text), " "))
+	if text == "" {
+		return nil, nil
+	}
+
+	hour, min, datePart, err := cutDueTime(text)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	date, ok := dueDay(datePart, today)
+	if !ok {
+		return nil, fmt.Errorf("invalid due date: %s", text)
+	}
+
+	due := time.Date(date.Year(), date.Month(), date.Day(), hour, min, 0, 0, now.Location())
+	return &due, nil
+}
+
+// cutDueTime removes a time of day from the end of a due date. A time on its own is
+// for today
+func cutDueTime(text string) (int, int, string, error) {
+	datePart, timePart := "today", text
+	if idx := strings.LastIndex(text, " "); idx >= 0 {
+		datePart, timePart = text[:idx], text[idx+1:]
+
+		// Allow a space before am/pm, e.g. 3 pm
+		if timePart == "am" || timePart == "pm" {
+			hourPart := datePart
+			datePart = "today"
+			if idx := strings.LastIndex(hourPart, " "); idx >= 0 {
+				datePart, hourPart = hourPart[:idx], hourPart[idx+1:]
+			}
+			timePart = hourPart + timePart
+		}
+	}
+
+	match := dueTimeRegex.FindStringSubmatch(timePart)
+	if match == nil || (match[2] == "" && match[3] == "") {
+		return 0, 0, text, nil
+	}
+
+	hour, _ := strconv.Atoi(match[1])
+	min, _ := strconv.Atoi(match[2])
+
+	switch {
+	case match[3] != "" && (hour < 1 || hour > 12):
+		return 0, 0, "", fmt.Errorf("invalid time: %s", timePart)
+	case match[3] == "pm" && hour != 12:
+		hour += 12
+	case match[3] == "am" && hour == 12:
+		hour = 0
+	}
+
+	if hour > 23 || min > 59 {
+		return 0, 0, "", fmt.Errorf("invalid time: %s", timePart)
+	}
+
+	return hour, min, datePart, nil
+}
+
+// dueDay parses the day part of a due date
+func dueDay(text string, today time.Time) (time.Time, bool) {
+	switch text {
+	case "today", "tod":
+		return today, true
+	case "tomorrow", "tom":
+		return today.AddDate(0, 0, 1), true
+	}
+
+	// The next such weekday, a week from today if it's today
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if text == name || text == name[:3] {
+			days := (int(day)-int(today.Weekday())+6)%7 + 1
+			return today.AddDate(0, 0, days), true
+		}
+	}
+
+	if match := dueOffsetRegex.FindStringSubmatch(text); match != nil {
+		n, _ := strconv.Atoi(match[1])
+
+		switch match[2][0] {
+		case 'd':
+			return today.AddDate(0, 0, n), true
+		case 'w':
+			return today.AddDate(0, 0, 7*n), true
+		default:
+			return today.AddDate(0, n, 0), true
+		}
+	}
+
+	for _, format := range dueDateFormats {
+		date, err := time.ParseInLocation(format, text, today.Location())
+		if err != nil {
+			continue
+		}
+
+		if !strings.Contains(format, "2006") {
+			date = date.AddDate(today.Year(), 0, 0)
+			if date.Before(today) {
+				date = date.AddDate(1, 0, 0)
+			}
+		}
+
+		return date, true
+	}
+
+	return time.Time{}, false
+}
+
+// formatDue returns a due date in a form that parseDue understands, to fill in the
+// task form
+func formatDue(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+
+	if due.Hour() == 0 && due.Minute() == 0 {
+		return due.Format("2006-01-02")
+	}
+
+	return due.Format("2006-01-02 15:04")
+}
+
+// dueLabel returns a short description of a due date relative to today, e.g. today,
+// tomorrow 15:00, Fri or Mar 12
+func dueLabel(due *time.Time, now time.Time) string {
+	if due == nil {
+		return ""
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	day := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, now.Location())
+	days := int(day.Sub(today).Hours() / 24)
+
+	var label string
+	switch {
+	case days == 0:
+		label = "today"
+	case days == 1:
+		label = "tomorrow"
+	case days == -1:
+		label = "yesterday"
+	case days > 1 && days < 7:
+		label = due.Format("Mon")
+	case due.Year() == now.Year():
+		label = due.Format("Jan 2")
+	default:
+		label = due.Format("Jan 2 2006")
+	}
+
+	if due.Hour() != 0 || due.Minute() != 0 {
+		label += " " + due.Format("15:04")
+	}
+
+	return label
+}
@@ -0,0 +1,81 @@
+package todo_plus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseDue(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2024, 3, 13, 10, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{text: "today", expected: "2024-03-13 00:00"},
+		{text: "Tomorrow", expected: "2024-03-14 00:00"},
+		{text: "fri", expected: "2024-03-15 00:00"},
+		{text: "wednesday", expected: "2024-03-20 00:00"},
+		{text: "+3d", expected: "2024-03-16 00:00"},
+		{text: "in 2 weeks", expected: "2024-03-27 00:00"},
+		{text: "1m", expected: "2024-04-13 00:00"},
+		{text: "2024-05-01", expected: "2024-05-01 00:00"},
+		{text: "mar 20", expected: "2024-03-20 00:00"},
+		{text: "1 Mar", expected: "2025-03-01 00:00"},
+		{text: "tomorrow 15:30", expected: "2024-03-14 15:30"},
+		{text: "mon 9am", expected: "2024-03-18 09:00"},
+		{text: "2024-05-01 12 pm", expected: "2024-05-01 12:00"},
+		{text: "6:15pm", expected: "2024-03-13 18:15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			due, err := parseDue(tt.text, now)
+			require.NoError(t, err)
+			require.NotNil(t, due)
+			assert.Equal(t, tt.expected, due.Format("2006-01-02 15:04"))
+		})
+	}
+
+	due, err := parseDue("  ", now)
+	assert.NoError(t, err)
+	assert.Nil(t, due)
+
+	for _, text := range []string{"someday", "tomorrow 25:00", "13pm", "2024-02-30"} {
+		_, err := parseDue(text, now)
+		assert.Error(t, err, text)
+	}
+}
+
+func Test_formatDue(t *testing.T) {
+	now := time.Date(2024, 3, 13, 10, 30, 0, 0, time.Local)
+
+	for _, text := range []string{"2024-03-20", "2024-03-20 09:45"} {
+		due, err := parseDue(text, now)
+		require.NoError(t, err)
+		assert.Equal(t, text, formatDue(due))
+	}
+
+	assert.Equal(t, "", formatDue(nil))
+}
+
+func Test_dueLabel(t *testing.T) {
+	now := time.Date(2024, 3, 13, 10, 30, 0, 0, time.Local)
+
+	date := func(year int, month time.Month, day, hour, min int) *time.Time {
+		due := time.Date(year, month, day, hour, min, 0, 0, time.Local)
+		return &due
+	}
+
+	assert.Equal(t, "", dueLabel(nil, now))
+	assert.Equal(t, "today", dueLabel(date(2024, 3, 13, 0, 0), now))
+	assert.Equal(t, "tomorrow 09:00", dueLabel(date(2024, 3, 14, 9, 0), now))
+	assert.Equal(t, "yesterday", dueLabel(date(2024, 3, 12, 0, 0), now))
+	assert.Equal(t, "Sat", dueLabel(date(2024, 3, 16, 0, 0), now))
+	assert.Equal(t, "Apr 1", dueLabel(date(2024, 4, 1, 0, 0), now))
+	assert.Equal(t, "Jan 5 2025", dueLabel(date(2025, 1, 5, 0, 0), now))
+}
@@ -7,11 +7,13 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.InitializeRefreshKeyboardControl(widget.Refresh)
 
 	widget.SetKeyboardChar("d", widget.Delete, "Delete item")
+	widget.SetKeyboardChar("e", widget.EditTask, "Edit, reschedule or move item")
 	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("k", widget.Next, "Select next item")
 	widget.SetKeyboardChar("h", widget.PrevSource, "Select previous project")
 	widget.SetKeyboardChar("c", widget.Close, "Close item")
 	widget.SetKeyboardChar("l", widget.NextSource, "Select next project")
+	widget.SetKeyboardChar("n", widget.NewTask, "Add new item")
 	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
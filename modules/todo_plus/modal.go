@@ -0,0 +1,94 @@
+package todo_plus
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/todo_plus/backend"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// taskForm holds the values entered in the task form
+type taskForm struct {
+	name    string
+	due     *time.Time
+	project *backend.Project
+}
+
+// showTaskForm opens a form to edit the name, due date and project of a task, and
+// calls onSave with the entered values
+func (widget *Widget) showTaskForm(title string, task backend.Task, proj *backend.Project, onSave func(taskForm)) {
+	// Projects that failed to load can't take tasks
+	projects := []*backend.Project{}
+	names := []string{}
+	selected := 0
+
+	for _, candidate := range widget.projects {
+		if candidate.ID == "" {
+			continue
+		}
+
+		if candidate == proj {
+			selected = len(projects)
+		}
+
+		projects = append(projects, candidate)
+		names = append(names, candidate.Name)
+	}
+
+	form := view.NewModalForm(widget.settings.Common)
+	form.AddInputField("Name:", task.Name, 60, nil, nil)
+	form.AddInputField("Due:", formatDue(task.Due), 60, nil, nil)
+	form.AddDropDown("Project:", names, selected, nil)
+
+	frame := view.NewModalFrame(form, view.ModalWidth, view.FormModalHeight+form.GetFormItemCount()*2)
+	showMessage := func(message string) {
+		frame.Clear()
+		frame.AddText(title, true, tview.AlignCenter, wtf.ColorFor(widget.settings.Colors.Title))
+		if message != "" {
+			frame.AddText(message, false, tview.AlignCenter, wtf.ColorFor(widget.settings.overdueColor))
+		}
+	}
+	showMessage("")
+
+	closeFn := func() {
+		widget.pages.RemovePage("modal")
+		widget.tviewApp.SetFocus(widget.View)
+		widget.display()
+	}
+
+	saveFn := func() {
+		name := strings.TrimSpace(form.GetFormItem(0).(*tview.InputField).GetText())
+		if name == "" {
+			showMessage("A task needs a name")
+			return
+		}
+
+		due, err := parseDue(form.GetFormItem(1).(*tview.InputField).GetText(), time.Now())
+		if err != nil {
+			showMessage(err.Error())
+			return
+		}
+
+		idx, _ := form.GetFormItem(2).(*tview.DropDown).GetCurrentOption()
+		if idx < 0 || idx >= len(projects) {
+			showMessage("Select a project")
+			return
+		}
+
+		closeFn()
+		onSave(taskForm{name: name, due: due, project: projects[idx]})
+	}
+
+	form.AddButton("Save", saveFn)
+	form.AddButton("Cancel", closeFn)
+	form.SetCancelFunc(closeFn)
+
+	widget.pages.AddPage("modal", frame, false, true)
+	widget.tviewApp.SetFocus(frame)
+
+	// Tell the app to force redraw the screen
+	widget.RedrawChan <- true
+}
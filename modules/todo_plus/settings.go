@@ -5,6 +5,7 @@ import (
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/modules/todo_plus/backend"
 )
 
 const (
@@ -17,20 +18,35 @@ type Settings struct {
 
 	backendType     string
 	backendSettings *config.Config
+	dateColor       string
+	dueTodayColor   string
+	overdueColor    string
 }
 
-func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+// newSettings returns the settings common to all backends
+func newSettings(name, backendType string, backendSettings *config.Config, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	return &Settings{
+		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
-	backend, _ := ymlConfig.Get("backendSettings")
+		backendType:     backendType,
+		backendSettings: backendSettings,
+		dateColor:       ymlConfig.UString("colors.date", "chartreuse"),
+		dueTodayColor:   ymlConfig.UString("colors.dueToday", "yellow"),
+		overdueColor:    ymlConfig.UString("colors.overdue", "red"),
+	}
+}
 
-	settings := Settings{
-		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	backendType := ymlConfig.UString("backendType")
 
-		backendType:     ymlConfig.UString("backendType"),
-		backendSettings: backend,
+	backend, err := ymlConfig.Get("backendSettings")
+	if err != nil {
+		backend, _ = config.ParseYaml("{}")
 	}
 
-	return &settings
+	loadBackendSecret(name, backendType, backend, globalConfig)
+
+	return newSettings(name, backendType, backend, ymlConfig, globalConfig)
 }
 
 func FromTodoist(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -40,14 +56,7 @@ func FromTodoist(name string, ymlConfig *config.Config, globalConfig *config.Con
 	backend, _ := config.ParseYaml("apiKey: " + apiKey)
 	_ = backend.Set(".projects", projects)
 
-	settings := Settings{
-		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
-
-		backendType:     "todoist",
-		backendSettings: backend,
-	}
-
-	return &settings
+	return newSettings(name, "todoist", backend, ymlConfig, globalConfig)
 }
 
 func FromTrello(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -69,13 +78,25 @@ func FromTrello(name string, ymlConfig *config.Config, globalConfig *config.Conf
 	_ = backend.Set(".board", board)
 	_ = backend.Set(".username", username)
 	_ = backend.Set(".lists", lists)
+	_ = backend.Set(".archiveOnDelete", ymlConfig.UBool("archiveOnDelete", false))
 
-	settings := Settings{
-		Common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+	return newSettings(name, "trello", backend, ymlConfig, globalConfig)
+}
+
+/* -------------------- Unexported Functions -------------------- */
 
-		backendType:     "trello",
-		backendSettings: backend,
+// loadBackendSecret reads the secret that a backend authenticates with from the
+// environment or the secret store when it isn't in the backend settings, the same way
+// the module of the backend's service does
+func loadBackendSecret(name, backendType string, backendSettings *config.Config, globalConfig *config.Config) {
+	spec := backend.Secret(backendType)
+	if spec == nil {
+		return
 	}
 
-	return &settings
+	secret := backendSettings.UString(spec.Key, os.Getenv(spec.EnvVar))
+	service := backendSettings.UString(spec.ServiceKey, spec.DefaultService)
+
+	cfg.ModuleSecret(name, globalConfig, &secret).Service(service).Load()
+	_ = backendSettings.Set("."+spec.Key, secret)
 }
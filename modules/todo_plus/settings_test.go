@@ -0,0 +1,38 @@
+package todo_plus
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewSettingsFromYAML_secrets(t *testing.T) {
+	t.Setenv("WTF_GITHUB_TOKEN", "from-env")
+	t.Setenv("WTF_GITLAB_TOKEN", "from-env")
+	t.Setenv("WTF_CALDAV_PASSWORD", "from-env")
+
+	globalConfig, err := config.ParseYaml("wtf: {}")
+	require.NoError(t, err)
+
+	tests := []struct {
+		yaml     string
+		key      string
+		expected string
+	}{
+		{yaml: "backendType: github", key: "apiKey", expected: "from-env"},
+		{yaml: "backendType: github\nbackendSettings:\n  apiKey: from-config", key: "apiKey", expected: "from-config"},
+		{yaml: "backendType: gitlab\nbackendSettings:\n  domain: https://gitlab.example.com", key: "apiKey", expected: "from-env"},
+		{yaml: "backendType: caldav\nbackendSettings:\n  url: https://dav.example.com/", key: "password", expected: "from-env"},
+		{yaml: "backendType: trello", key: "apiKey", expected: ""},
+	}
+
+	for _, tt := range tests {
+		ymlConfig, err := config.ParseYaml(tt.yaml)
+		require.NoError(t, err)
+
+		settings := NewSettingsFromYAML("todo_plus", ymlConfig, globalConfig)
+		assert.Equal(t, tt.expected, settings.backendSettings.UString(tt.key), tt.yaml)
+	}
+}
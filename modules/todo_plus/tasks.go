@@ -0,0 +1,235 @@
+package todo_plus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wtfutil/wtf/modules/todo_plus/backend"
+)
+
+// pendingPrefix starts the IDs of new tasks, which are shown while they're being created
+const pendingPrefix = "pending:"
+
+// Close closes the currently-selected task in the currently-selected project
+func (widget *Widget) Close() {
+	if widget.backend == nil {
+		return
+	}
+
+	widget.removeSelected(widget.backend.CloseTask)
+}
+
+// Delete deletes the currently-selected task in the currently-selected project
+func (widget *Widget) Delete() {
+	if widget.backend == nil {
+		return
+	}
+
+	widget.removeSelected(widget.backend.DeleteTask)
+}
+
+// NewTask opens the task form to add a task to the currently-selected project
+func (widget *Widget) NewTask() {
+	proj := widget.CurrentProject()
+	if proj == nil || proj.ID == "" {
+		return
+	}
+
+	widget.showTaskForm("New task", backend.Task{}, proj, func(form taskForm) {
+		widget.pending++
+
+		target := form.project
+		task := backend.Task{
+			ID:        fmt.Sprintf("%s%d", pendingPrefix, widget.pending),
+			ProjectID: target.ID,
+			Name:      form.name,
+			Due:       form.due,
+		}
+
+		widget.mutex.Lock()
+		target.InsertTask(-1, task)
+		widget.mutex.Unlock()
+
+		widget.save(
+			func() error {
+				created := task
+				if err := widget.backend.CreateTask(target.ID, &created); err != nil {
+					return err
+				}
+
+				widget.mutex.Lock()
+				target.ReplaceTask(task.ID, created)
+				widget.mutex.Unlock()
+				return nil
+			},
+			func() {
+				target.RemoveTask(task.ID)
+			},
+		)
+	})
+}
+
+// EditTask opens the task form to rename, reschedule or move the currently-selected task
+func (widget *Widget) EditTask() {
+	proj, original := widget.selectedTask()
+	if original == nil {
+		return
+	}
+
+	widget.showTaskForm("Edit task", *original, proj, func(form taskForm) {
+		updated := *original
+		updated.Name = form.name
+		updated.Due = form.due
+
+		target := form.project
+		edited := updated.Name != original.Name || !sameDue(updated.Due, original.Due)
+		moved := target != proj
+
+		if !edited && !moved {
+			return
+		}
+
+		widget.mutex.Lock()
+		idx := proj.TaskIndex(original.ID)
+		if moved {
+			proj.RemoveTask(original.ID)
+			target.InsertTask(-1, updated)
+		} else {
+			proj.ReplaceTask(original.ID, updated)
+		}
+		widget.mutex.Unlock()
+
+		// The task stays renamed and rescheduled if only moving it fails
+		restored := *original
+
+		widget.save(
+			func() error {
+				if edited {
+					if err := widget.backend.UpdateTask(&updated); err != nil {
+						return err
+					}
+					restored = updated
+				}
+
+				if moved {
+					movedTask := updated
+					if err := widget.backend.MoveTask(&movedTask, target.ID); err != nil {
+						return err
+					}
+
+					widget.mutex.Lock()
+					target.ReplaceTask(updated.ID, movedTask)
+					widget.mutex.Unlock()
+				}
+
+				return nil
+			},
+			func() {
+				if moved {
+					target.RemoveTask(updated.ID)
+					proj.InsertTask(idx, restored)
+				} else {
+					proj.ReplaceTask(original.ID, restored)
+				}
+			},
+		)
+	})
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// removeSelected takes the currently-selected task off the list right away, and then
+// closes or deletes it on the backend
+func (widget *Widget) removeSelected(remove func(*backend.Task) error) {
+	proj, task := widget.selectedTask()
+	if task == nil {
+		return
+	}
+
+	widget.mutex.Lock()
+	removed, idx, _ := proj.RemoveTask(task.ID)
+	widget.mutex.Unlock()
+
+	widget.save(
+		func() error {
+			return remove(&removed)
+		},
+		func() {
+			proj.InsertTask(idx, removed)
+		},
+	)
+}
+
+// save makes a change on the backend in the background. The widget's tasks already
+// show the outcome of the change; if it fails, rollback undoes that and the error
+// is shown
+func (widget *Widget) save(action func() error, rollback func()) {
+	widget.keepSelection()
+	widget.display()
+
+	go func() {
+		err := action()
+
+		widget.mutex.Lock()
+		widget.actionErr = err
+		if err != nil {
+			rollback()
+		}
+		widget.mutex.Unlock()
+
+		widget.keepSelection()
+		widget.display()
+	}()
+}
+
+// keepSelection keeps the selection within the tasks of the current project after
+// tasks were added or removed
+func (widget *Widget) keepSelection() {
+	proj := widget.CurrentProject()
+	if proj == nil {
+		return
+	}
+
+	widget.mutex.Lock()
+	count := len(proj.Tasks)
+	widget.mutex.Unlock()
+
+	widget.SetItemCount(count)
+	if widget.Selected >= count {
+		widget.Selected = count - 1
+	}
+	proj.Index = widget.Selected
+}
+
+// selectedTask returns the current project and a copy of its selected task, or nil
+// if no task is selected or it's still being created
+func (widget *Widget) selectedTask() (*backend.Project, *backend.Task) {
+	proj := widget.CurrentProject()
+	if proj == nil {
+		return nil, nil
+	}
+
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	task := proj.SelectedTask()
+	if task == nil || isPending(task) {
+		return proj, nil
+	}
+
+	selected := *task
+	return proj, &selected
+}
+
+func isPending(task *backend.Task) bool {
+	return strings.HasPrefix(task.ID, pendingPrefix)
+}
+
+func sameDue(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
@@ -1,7 +1,7 @@
 package todo_plus
 
 import (
-	"log"
+	"sync"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/modules/todo_plus/backend"
@@ -16,6 +16,16 @@ type Widget struct {
 	projects []*backend.Project
 	settings *Settings
 	backend  backend.Backend
+	err      error
+	pages    *tview.Pages
+	tviewApp *tview.Application
+
+	// actionErr is the error of the last change that failed to save
+	actionErr error
+	// mutex guards the tasks of the projects, which changes being saved in the
+	// background update
+	mutex   sync.Mutex
+	pending int
 }
 
 // NewWidget creates a new instance of a widget
@@ -25,11 +35,15 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 		ScrollableWidget:  view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
 		settings: settings,
+		pages:    pages,
+		tviewApp: tviewApp,
 	}
 
-	widget.backend = getBackend(settings.backendType)
-	widget.backend.Setup(settings.backendSettings)
-	widget.CommonSettings().Title = widget.backend.Title()
+	widget.backend, widget.err = backend.New(settings.backendType)
+	if widget.err == nil {
+		widget.backend.Setup(settings.backendSettings)
+		widget.CommonSettings().Title = widget.backend.Title()
+	}
 
 	widget.SetRenderFunction(widget.display)
 	widget.initializeKeyboardControls()
@@ -38,21 +52,6 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	return &widget
 }
 
-func getBackend(backendType string) backend.Backend {
-	switch backendType {
-	case "trello":
-		backend := &backend.Trello{}
-		return backend
-	case "todoist":
-		backend := &backend.Todoist{}
-		return backend
-	default:
-		log.Fatal(backendType + " is not a supported backend")
-		return nil
-	}
-
-}
-
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) CurrentProject() *backend.Project {
@@ -72,9 +71,22 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	widget.projects = widget.backend.BuildProjects()
+	if widget.backend == nil {
+		widget.display()
+		return
+	}
+
+	projects := widget.backend.BuildProjects()
+
+	widget.mutex.Lock()
+	widget.projects = projects
+	widget.actionErr = nil
+	widget.mutex.Unlock()
+
 	widget.Sources = widget.backend.Sources()
-	widget.SetItemCount(len(widget.CurrentProject().Tasks))
+	if proj := widget.CurrentProject(); proj != nil {
+		widget.SetItemCount(len(proj.Tasks))
+	}
 	widget.display()
 }
 
@@ -107,30 +119,3 @@ func (widget *Widget) Unselect() {
 	widget.CurrentProject().Index = -1
 	widget.RenderFunction()
 }
-
-/* -------------------- Keyboard Movement -------------------- */
-
-// Close closes the currently-selected task in the currently-selected project
-func (w *Widget) Close() {
-	w.CurrentProject().CloseSelectedTask()
-	w.SetItemCount(len(w.CurrentProject().Tasks))
-
-	if w.CurrentProject().IsLast() {
-		w.Prev()
-		return
-	}
-	w.CurrentProject().Index = w.Selected
-	w.RenderFunction()
-}
-
-// Delete deletes the currently-selected task in the currently-selected project
-func (w *Widget) Delete() {
-	w.CurrentProject().DeleteSelectedTask()
-	w.SetItemCount(len(w.CurrentProject().Tasks))
-
-	if w.CurrentProject().IsLast() {
-		w.Prev()
-	}
-	w.CurrentProject().Index = w.Selected
-	w.RenderFunction()
-}
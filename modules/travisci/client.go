@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/wtfutil/wtf/utils"
 )
@@ -13,20 +14,38 @@ var TRAVIS_HOSTS = map[bool]string{
 	true:  "travis-ci.com",
 }
 
-func BuildsFor(settings *Settings) (*Builds, error) {
-	builds := &Builds{}
+// Client talks to the TravisCI API, or to a TravisCI Enterprise one when a base URL
+// is configured
+type Client struct {
+	apiKey string
+	apiURL *url.URL
+	webURL *url.URL
+}
 
-	travisAPIURL.Host = "api." + TRAVIS_HOSTS[settings.pro]
-	if settings.baseURL != "" {
-		travisAPIURL.Host = settings.baseURL
+// NewClient creates a client for the TravisCI instance the settings point to
+func NewClient(settings *Settings) *Client {
+	client := Client{
+		apiKey: settings.apiKey,
+		apiURL: &url.URL{Scheme: "https", Host: "api." + TRAVIS_HOSTS[settings.pro], Path: "/"},
+		webURL: &url.URL{Scheme: "https", Host: TRAVIS_HOSTS[settings.pro], Path: "/"},
 	}
 
-	resp, err := travisBuildRequest(settings)
-	if err != nil {
-		return builds, err
+	if settings.baseURL != "" {
+		client.apiURL = &url.URL{Scheme: "https", Host: settings.baseURL, Path: "/api/"}
+		client.webURL = &url.URL{Scheme: "https", Host: settings.baseURL, Path: "/"}
 	}
 
-	err = utils.ParseJSON(&builds, resp.Body)
+	return &client
+}
+
+// Builds returns the most recent builds of the repositories the user can see
+func (client *Client) Builds(limit int, sortBy string) (*Builds, error) {
+	params := url.Values{}
+	params.Add("limit", strconv.Itoa(limit))
+	params.Add("sort_by", sortBy)
+
+	builds := &Builds{}
+	err := client.travisRequest("GET", "builds", params, builds)
 	if err != nil {
 		return builds, err
 	}
@@ -34,45 +53,48 @@ func BuildsFor(settings *Settings) (*Builds, error) {
 	return builds, nil
 }
 
-/* -------------------- Unexported Functions -------------------- */
-
-var (
-	travisAPIURL = &url.URL{Scheme: "https", Path: "/"}
-)
+// RestartBuild restarts a build
+func (client *Client) RestartBuild(build *Build) error {
+	return client.travisRequest("POST", fmt.Sprintf("build/%d/restart", build.ID), url.Values{}, nil)
+}
 
-func travisBuildRequest(settings *Settings) (*http.Response, error) {
+// BuildURL returns the link to a build's page
+func (client *Client) BuildURL(build *Build) string {
+	return client.webURL.ResolveReference(&url.URL{Path: fmt.Sprintf("%s/builds/%d", build.Repository.Slug, build.ID)}).String()
+}
 
-	path := "builds"
-	if settings.baseURL != "" {
-		travisAPIURL.Path = "/api/"
-	}
-	params := url.Values{}
-	params.Add("limit", settings.limit)
-	params.Add("sort_by", settings.sort_by)
+/* -------------------- Unexported Functions -------------------- */
 
-	requestUrl := travisAPIURL.ResolveReference(&url.URL{Path: path, RawQuery: params.Encode()})
+// travisRequest sends a request to the API and decodes the JSON response into result,
+// unless result is nil
+func (client *Client) travisRequest(method, path string, params url.Values, result interface{}) error {
+	requestURL := client.apiURL.ResolveReference(&url.URL{Path: path, RawQuery: params.Encode()})
 
-	req, err := http.NewRequest("GET", requestUrl.String(), http.NoBody)
+	req, err := http.NewRequest(method, requestURL.String(), http.NoBody)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Travis-API-Version", "3")
 
-	bearer := fmt.Sprintf("token %s", settings.apiKey)
+	bearer := fmt.Sprintf("token %s", client.apiKey)
 	req.Header.Add("Authorization", bearer)
 
 	httpClient := &http.Client{}
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, fmt.Errorf("%s", resp.Status)
+		return fmt.Errorf("%s", resp.Status)
+	}
+
+	if result == nil {
+		return nil
 	}
 
-	return resp, nil
+	return utils.ParseJSON(result, resp.Body)
 }
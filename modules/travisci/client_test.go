@@ -0,0 +1,66 @@
+package travisci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewClient(t *testing.T) {
+	client := NewClient(&Settings{pro: true})
+	build := &Build{ID: 99, Repository: Repository{Slug: "wtfutil/wtf"}}
+
+	assert.Equal(t, "https://api.travis-ci.com/", client.apiURL.String())
+	assert.Equal(t, "https://travis-ci.com/wtfutil/wtf/builds/99", client.BuildURL(build))
+
+	client = NewClient(&Settings{baseURL: "travis.example.com"})
+
+	assert.Equal(t, "https://travis.example.com/api/", client.apiURL.String())
+	assert.Equal(t, "https://travis.example.com/wtfutil/wtf/builds/99", client.BuildURL(build))
+}
+
+func Test_Client(t *testing.T) {
+	var restarted string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "3", r.Header.Get("Travis-API-Version"))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/builds":
+			assert.Equal(t, "5", r.URL.Query().Get("limit"))
+			assert.Equal(t, "id:desc", r.URL.Query().Get("sort_by"))
+			_, _ = w.Write([]byte(`{"builds": [
+				{"id": 99, "number": "41", "state": "passed", "branch": {"name": "main"},
+				 "repository": {"slug": "wtfutil/wtf"}, "started_at": "2024-01-01T10:00:00Z", "duration": 120}
+			]}`))
+		case r.Method == "POST":
+			restarted = r.URL.Path
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&Settings{apiKey: "secret"})
+	client.apiURL, _ = url.Parse(server.URL + "/")
+
+	builds, err := client.Builds(5, "id:desc")
+	require.NoError(t, err)
+	require.Len(t, builds.Builds, 1)
+
+	build := &builds.Builds[0]
+	assert.Equal(t, "41", build.Number)
+	assert.Equal(t, "main", build.Branch.Name)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), build.StartedAt)
+	assert.Equal(t, int64(120), build.Duration)
+
+	require.NoError(t, client.RestartBuild(build))
+	assert.Equal(t, "/build/99/restart", restarted)
+}
@@ -18,7 +18,7 @@ type Settings struct {
 	apiKey  string
 	baseURL string `help:"Your TravisCI Enterprise API URL." optional:"true"`
 	compact bool
-	limit   string
+	limit   int
 	pro     bool
 	sort_by string
 }
@@ -31,7 +31,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		baseURL: ymlConfig.UString("baseURL", ymlConfig.UString("baseURL", os.Getenv("WTF_TRAVIS_BASE_URL"))),
 		pro:     ymlConfig.UBool("pro", false),
 		compact: ymlConfig.UBool("compact", false),
-		limit:   ymlConfig.UString("limit", "10"),
+		limit:   ymlConfig.UInt("limit", 10),
 		sort_by: ymlConfig.UString("sort_by", "id:desc"),
 	}
 
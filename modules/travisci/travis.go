@@ -1,5 +1,7 @@
 package travisci
 
+import "time"
+
 type Builds struct {
 	Builds []Build `json:"builds"`
 }
@@ -12,6 +14,8 @@ type Build struct {
 	Repository Repository `json:"repository"`
 	Commit     Commit     `json:"commit"`
 	State      string     `json:"state"`
+	StartedAt  time.Time  `json:"started_at"`
+	Duration   int64      `json:"duration"`
 }
 
 type Owner struct {
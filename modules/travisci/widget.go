@@ -13,6 +13,7 @@ type Widget struct {
 	view.ScrollableWidget
 
 	builds   *Builds
+	client   *Client
 	settings *Settings
 	err      error
 }
@@ -21,6 +22,7 @@ func NewWidget(tviewApp *tview.Application, redrawChan chan bool, pages *tview.P
 	widget := Widget{
 		ScrollableWidget: view.NewScrollableWidget(tviewApp, redrawChan, pages, settings.Common),
 
+		client:   NewClient(settings),
 		settings: settings,
 	}
 
@@ -37,7 +39,7 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	builds, err := BuildsFor(widget.settings)
+	builds, err := widget.client.Builds(widget.settings.limit, widget.settings.sort_by)
 
 	if err != nil {
 		widget.err = err
@@ -112,7 +114,6 @@ func (widget *Widget) openBuild() {
 	sel := widget.GetSelected()
 	if sel >= 0 && widget.builds != nil && sel < len(widget.builds.Builds) {
 		build := &widget.builds.Builds[sel]
-		travisHost := TRAVIS_HOSTS[widget.settings.pro]
-		utils.OpenFile(fmt.Sprintf("https://%s/%s/%s/%d", travisHost, build.Repository.Slug, "builds", build.ID))
+		utils.OpenFile(widget.client.BuildURL(build))
 	}
 }
@@ -168,6 +168,40 @@ func (base *Base) ShowHelp() {
 	base.RedrawChan <- true
 }
 
+// ShowComments displays a discussion in a modal comment thread. Its comments are
+// loaded in the background, so that the modal shows up right away
+func (base *Base) ShowComments(title string, load func() ([]*Comment, error)) {
+	if base.pages == nil {
+		return
+	}
+
+	closeFunc := func() {
+		base.pages.RemovePage(commentsPage)
+		base.tviewApp.SetFocus(base.view)
+	}
+
+	thread := NewCommentThread(title, closeFunc)
+
+	base.pages.AddPage(commentsPage, thread, false, true)
+	base.tviewApp.SetFocus(thread.textView)
+
+	go func() {
+		comments, err := load()
+
+		base.tviewApp.QueueUpdateDraw(func() {
+			if err != nil {
+				thread.SetError(err)
+				return
+			}
+
+			thread.SetComments(comments)
+		})
+	}()
+
+	// Tell the app to force redraw the screen
+	base.RedrawChan <- true
+}
+
 func (base *Base) Stop() {
 	base.enabledMutex.Lock()
 	base.enabled = false
@@ -5,10 +5,6 @@ import (
 	"github.com/rivo/tview"
 )
 
-const offscreen = -1000
-const modalWidth = 80
-const modalHeight = 22
-
 // NewBillboardModal creates and returns a modal dialog suitable for displaying
 // a wall of text
 // An example of this is the keyboard help modal that shows up for all widgets
@@ -37,18 +33,5 @@ func NewBillboardModal(text string, closeFunc func()) *tview.Frame {
 	textView.SetText(text)
 	textView.SetWrap(true)
 
-	frame := tview.NewFrame(textView)
-	frame.SetRect(offscreen, offscreen, modalWidth, modalHeight)
-
-	drawFunc := func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
-		w, h := screen.Size()
-		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
-		return x, y, width, height
-	}
-
-	frame.SetBorder(true)
-	frame.SetBorders(1, 1, 0, 0, 1, 1)
-	frame.SetDrawFunc(drawFunc)
-
-	return frame
+	return NewModalFrame(textView, ModalWidth, modalHeight)
 }
@@ -0,0 +1,359 @@
+package view
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"jaytaylor.com/html2text"
+)
+
+const (
+	commentsPage = "comments"
+
+	// commentThreadScale is how much of the screen the comment thread covers
+	commentThreadScale = 0.8
+
+	commentThreadHelp = "j/k: select  space: collapse/expand  h/l: collapse/expand  g/G: first/last  Esc: close"
+)
+
+// Comment is a comment of a discussion, along with its replies
+type Comment struct {
+	Author string
+	// Info is shown next to the author and the time, for example the comment's score
+	Info    string
+	Replies []*Comment
+	// Text is the comment as plain text
+	Text string
+	Time time.Time
+}
+
+// Count returns how many replies the comment has, including replies to replies
+func (comment *Comment) Count() int {
+	count := len(comment.Replies)
+	for _, reply := range comment.Replies {
+		count += reply.Count()
+	}
+
+	return count
+}
+
+// CommentText converts the HTML of a comment to plain text
+func CommentText(text string) string {
+	plain, err := html2text.FromString(text, html2text.Options{})
+	if err != nil {
+		return html.UnescapeString(text)
+	}
+
+	return strings.TrimSpace(plain)
+}
+
+// commentRow is a comment that's displayed, and how deep in the thread it is
+type commentRow struct {
+	comment *Comment
+	depth   int
+}
+
+// CommentThread is a modal that displays a discussion as a tree of comments.
+// Comments are indented under the ones they reply to, and their replies can be
+// collapsed and expanded
+type CommentThread struct {
+	*tview.Frame
+
+	closeFunc func()
+	collapsed map[*Comment]bool
+	comments  []*Comment
+	message   string
+	rows      []commentRow
+	selected  int
+	textView  *tview.TextView
+	width     int
+}
+
+// NewCommentThread creates and returns a comment thread, which displays a loading
+// message until its comments are set
+func NewCommentThread(title string, closeFunc func()) *CommentThread {
+	thread := &CommentThread{
+		closeFunc: closeFunc,
+		collapsed: map[*Comment]bool{},
+		message:   "Loading comments...",
+		textView:  tview.NewTextView(),
+		width:     ModalWidth,
+	}
+
+	thread.textView.SetDynamicColors(true)
+	thread.textView.SetRegions(true)
+	thread.textView.SetScrollable(true)
+	thread.textView.SetWrap(false)
+	thread.textView.SetInputCapture(thread.keyboardIntercept)
+
+	thread.Frame = tview.NewFrame(thread.textView)
+	thread.Frame.SetRect(offscreen, offscreen, ModalWidth, modalHeight)
+	thread.Frame.SetBorder(true)
+	thread.Frame.SetBorders(0, 0, 0, 1, 1, 1)
+	thread.Frame.SetTitle(" " + tview.Escape(title) + " ")
+	thread.Frame.AddText(commentThreadHelp, false, tview.AlignCenter, tcell.ColorGray)
+	thread.Frame.SetDrawFunc(thread.draw)
+
+	thread.render()
+
+	return thread
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// SetComments displays the comments of the discussion
+func (thread *CommentThread) SetComments(comments []*Comment) {
+	thread.comments = comments
+	thread.collapsed = map[*Comment]bool{}
+	thread.message = ""
+	if len(comments) == 0 {
+		thread.message = "No comments"
+	}
+
+	thread.selected = 0
+	thread.render()
+}
+
+// SetError displays why the comments couldn't be loaded
+func (thread *CommentThread) SetError(err error) {
+	thread.comments = nil
+	thread.message = fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error()))
+
+	thread.render()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (thread *CommentThread) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEsc:
+		thread.closeFunc()
+	case tcell.KeyDown:
+		thread.selectRow(thread.selected + 1)
+	case tcell.KeyUp:
+		thread.selectRow(thread.selected - 1)
+	case tcell.KeyLeft:
+		thread.collapse()
+	case tcell.KeyRight:
+		thread.expand()
+	case tcell.KeyEnter:
+		thread.toggle()
+	case tcell.KeyHome:
+		thread.selectRow(0)
+	case tcell.KeyEnd:
+		thread.selectRow(len(thread.rows) - 1)
+	case tcell.KeyTab:
+		// Keeps the focus in the modal
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'q':
+			thread.closeFunc()
+		case 'j':
+			thread.selectRow(thread.selected + 1)
+		case 'k':
+			thread.selectRow(thread.selected - 1)
+		case 'h':
+			thread.collapse()
+		case 'l':
+			thread.expand()
+		case ' ':
+			thread.toggle()
+		case 'g':
+			thread.selectRow(0)
+		case 'G':
+			thread.selectRow(len(thread.rows) - 1)
+		default:
+			return event
+		}
+	default:
+		// Lets the text view page through the thread
+		return event
+	}
+
+	return nil
+}
+
+// draw centers the thread on the screen, and lays out the comments again when the
+// width available to them has changed
+func (thread *CommentThread) draw(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+	w, h := screen.Size()
+	threadWidth, threadHeight := int(float64(w)*commentThreadScale), int(float64(h)*commentThreadScale)
+	thread.Frame.SetRect((w-threadWidth)/2, (h-threadHeight)/2, threadWidth, threadHeight)
+
+	// Within the border and the frame's own margins
+	if textWidth := threadWidth - 4; textWidth != thread.width {
+		thread.width = textWidth
+		thread.render()
+	}
+
+	// Within the border
+	return x + 1, y + 1, width - 2, height - 2
+}
+
+// selectedComment returns the comment that's selected, if any
+func (thread *CommentThread) selectedComment() *Comment {
+	if thread.selected < 0 || thread.selected >= len(thread.rows) {
+		return nil
+	}
+
+	return thread.rows[thread.selected].comment
+}
+
+func (thread *CommentThread) selectRow(idx int) {
+	if len(thread.rows) == 0 {
+		return
+	}
+
+	thread.selected = max(0, min(idx, len(thread.rows)-1))
+	thread.highlight()
+}
+
+// collapse hides the replies of the selected comment. If they're already hidden,
+// the comment it replies to is selected instead
+func (thread *CommentThread) collapse() {
+	comment := thread.selectedComment()
+	if comment == nil {
+		return
+	}
+
+	if len(comment.Replies) > 0 && !thread.collapsed[comment] {
+		thread.collapsed[comment] = true
+		thread.render()
+		return
+	}
+
+	depth := thread.rows[thread.selected].depth
+	for idx := thread.selected - 1; idx >= 0; idx-- {
+		if thread.rows[idx].depth < depth {
+			thread.selectRow(idx)
+			return
+		}
+	}
+}
+
+// expand shows the replies of the selected comment
+func (thread *CommentThread) expand() {
+	comment := thread.selectedComment()
+	if comment == nil || !thread.collapsed[comment] {
+		return
+	}
+
+	delete(thread.collapsed, comment)
+	thread.render()
+}
+
+func (thread *CommentThread) toggle() {
+	comment := thread.selectedComment()
+	if comment == nil {
+		return
+	}
+
+	if thread.collapsed[comment] {
+		thread.expand()
+	} else {
+		thread.collapse()
+	}
+}
+
+// visibleRows lists the comments whose ancestors are all expanded, in thread order
+func (thread *CommentThread) visibleRows(comments []*Comment, depth int) []commentRow {
+	rows := []commentRow{}
+
+	for _, comment := range comments {
+		rows = append(rows, commentRow{comment: comment, depth: depth})
+
+		if !thread.collapsed[comment] {
+			rows = append(rows, thread.visibleRows(comment.Replies, depth+1)...)
+		}
+	}
+
+	return rows
+}
+
+// render lays out the comments, keeping the same comment selected
+func (thread *CommentThread) render() {
+	selected := thread.selectedComment()
+
+	thread.rows = thread.visibleRows(thread.comments, 0)
+
+	thread.selected = 0
+	for idx, row := range thread.rows {
+		if row.comment == selected {
+			thread.selected = idx
+		}
+	}
+
+	if thread.message != "" {
+		thread.textView.SetText(thread.message)
+		return
+	}
+
+	var str strings.Builder
+	for idx, row := range thread.rows {
+		str.WriteString(thread.commentText(idx, row))
+	}
+
+	thread.textView.SetText(str.String())
+	thread.highlight()
+}
+
+func (thread *CommentThread) highlight() {
+	thread.textView.Highlight(strconv.Itoa(thread.selected))
+	thread.textView.ScrollToHighlight()
+}
+
+// commentText displays a comment's header, then its text wrapped to the width of
+// the thread and indented under the comment it replies to
+func (thread *CommentThread) commentText(idx int, row commentRow) string {
+	comment := row.comment
+	indent := "[gray]" + strings.Repeat("│ ", row.depth) + "[-]"
+
+	marker := "•"
+	if len(comment.Replies) > 0 {
+		marker = "▾"
+		if thread.collapsed[comment] {
+			marker = "▸"
+		}
+	}
+
+	author := comment.Author
+	if author == "" {
+		author = "[deleted]"
+	}
+
+	details := []string{}
+	if !comment.Time.IsZero() {
+		details = append(details, humanize.Time(comment.Time))
+	}
+	if comment.Info != "" {
+		details = append(details, comment.Info)
+	}
+	if thread.collapsed[comment] {
+		details = append(details, fmt.Sprintf("%d hidden", comment.Count()))
+	}
+
+	str := fmt.Sprintf(
+		"%s%s [\"%d\"][yellow::b]%s[-::-][\"\"] [gray]%s[-]\n",
+		indent,
+		marker,
+		idx,
+		tview.Escape(author),
+		tview.Escape(strings.Join(details, " · ")),
+	)
+
+	if !thread.collapsed[comment] {
+		width := max(thread.width-2*(row.depth+1), 20)
+
+		for _, line := range tview.WordWrap(tview.Escape(strings.TrimSpace(comment.Text)), width) {
+			str += indent + "  " + line + "\n"
+		}
+	}
+
+	return str + "\n"
+}
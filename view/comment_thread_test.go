@@ -0,0 +1,112 @@
+package view
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testComments() []*Comment {
+	return []*Comment{
+		{
+			Author: "alice",
+			Text:   "First",
+			Replies: []*Comment{
+				{
+					Author:  "bob",
+					Text:    "Reply",
+					Replies: []*Comment{{Author: "carol", Text: "Nested"}},
+				},
+			},
+		},
+		{Author: "dave", Info: "3 points", Text: "Second"},
+	}
+}
+
+func pressRune(thread *CommentThread, r rune) {
+	thread.keyboardIntercept(tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+}
+
+func Test_CommentCount(t *testing.T) {
+	comments := testComments()
+
+	assert.Equal(t, 2, comments[0].Count())
+	assert.Equal(t, 0, comments[1].Count())
+}
+
+func Test_CommentThread(t *testing.T) {
+	closed := false
+	thread := NewCommentThread("Story", func() { closed = true })
+
+	assert.Equal(t, "Loading comments...", thread.textView.GetText(true))
+
+	thread.SetComments(testComments())
+	assert.Equal(t, 4, len(thread.rows))
+	assert.Equal(t, 2, thread.rows[2].depth)
+
+	text := thread.textView.GetText(true)
+	assert.Contains(t, text, "▾ alice")
+	assert.Contains(t, text, "│ │ • carol")
+	assert.Contains(t, text, "• dave 3 points")
+
+	// Collapsing a comment hides its replies and keeps it selected
+	pressRune(thread, 'j')
+	assert.Equal(t, "bob", thread.selectedComment().Author)
+
+	pressRune(thread, ' ')
+	assert.Equal(t, 3, len(thread.rows))
+	assert.Equal(t, "bob", thread.selectedComment().Author)
+	assert.Contains(t, thread.textView.GetText(true), "▸ bob 1 hidden")
+
+	// Collapsing a collapsed comment selects the one it replies to
+	pressRune(thread, 'h')
+	assert.Equal(t, "alice", thread.selectedComment().Author)
+
+	pressRune(thread, 'j')
+	pressRune(thread, 'l')
+	assert.Equal(t, 4, len(thread.rows))
+
+	pressRune(thread, 'G')
+	assert.Equal(t, "dave", thread.selectedComment().Author)
+	pressRune(thread, 'j')
+	assert.Equal(t, "dave", thread.selectedComment().Author)
+
+	pressRune(thread, 'q')
+	assert.True(t, closed)
+}
+
+func Test_CommentThread_wrapping(t *testing.T) {
+	thread := NewCommentThread("Story", func() {})
+	thread.width = 30
+
+	thread.SetComments([]*Comment{
+		{
+			Author:  "alice",
+			Text:    strings.Repeat("word ", 10),
+			Replies: []*Comment{{Author: "bob", Text: "[not a color tag]"}},
+		},
+	})
+
+	lines := strings.Split(thread.textView.GetText(true), "\n")
+	assert.Equal(t, "  word word word word word", lines[1])
+	assert.Equal(t, "  word word word word word", lines[2])
+	assert.Equal(t, "│   [not a color tag]", lines[5])
+}
+
+func Test_CommentThread_error(t *testing.T) {
+	thread := NewCommentThread("Story", func() {})
+	thread.SetError(errors.New("fetching comments failed"))
+
+	assert.Equal(t, "fetching comments failed", thread.textView.GetText(true))
+
+	thread.SetComments(nil)
+	assert.Equal(t, "No comments", thread.textView.GetText(true))
+}
+
+func Test_CommentText(t *testing.T) {
+	assert.Equal(t, "Nice & short", CommentText("Nice &amp; <i>short</i>"))
+	assert.Equal(t, "Agreed\n\nSecond paragraph", CommentText("<p>Agreed</p><p>Second paragraph</p>"))
+}
@@ -0,0 +1,118 @@
+package view
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	// FormModalHeight is the height of a modal form with a single field
+	FormModalHeight = 7
+
+	// ModalWidth is the width of modal dialogs
+	ModalWidth = 80
+
+	// modalHeight is the height of modals that display a wall of text
+	modalHeight = 22
+
+	// modalPage is the name of the page modal forms are displayed in
+	modalPage = "modal"
+
+	// confirmPage is the name of the page confirmation dialogs are displayed in
+	confirmPage = "confirm"
+
+	offscreen = -1000
+)
+
+// NewModalForm creates and returns an empty form drawn in the module's colors, to be
+// displayed in a modal
+func NewModalForm(commonSettings *cfg.Common) *tview.Form {
+	form := tview.NewForm()
+	form.SetFieldBackgroundColor(wtf.ColorFor(commonSettings.Colors.Background))
+	form.SetButtonsAlign(tview.AlignCenter)
+	form.SetButtonTextColor(wtf.ColorFor(commonSettings.Colors.Text))
+
+	return form
+}
+
+// NewModalFrame wraps a primitive in a bordered frame of the given size, which is kept
+// centered on the screen
+func NewModalFrame(primitive tview.Primitive, width, height int) *tview.Frame {
+	frame := tview.NewFrame(primitive)
+	frame.SetRect(offscreen, offscreen, width, height)
+	frame.SetBorder(true)
+	frame.SetBorders(1, 1, 0, 0, 1, 1)
+
+	drawFunc := func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
+		return x, y, width, height
+	}
+
+	frame.SetDrawFunc(drawFunc)
+
+	return frame
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// ShowInputForm displays a modal form with a single input field and calls onSave
+// with the text entered, once the form is closed
+func (base *Base) ShowInputForm(prompt string, onSave func(string)) {
+	if base.pages == nil {
+		return
+	}
+
+	form := NewModalForm(base.commonSettings)
+	form.AddInputField(prompt, "", 60, nil, nil)
+
+	closeFn := func() {
+		base.pages.RemovePage(modalPage)
+		base.tviewApp.SetFocus(base.view)
+	}
+
+	saveFn := func() {
+		text := form.GetFormItem(0).(*tview.InputField).GetText()
+		closeFn()
+		onSave(text)
+	}
+
+	form.AddButton("Save", saveFn)
+	form.AddButton("Cancel", closeFn)
+	form.SetCancelFunc(closeFn)
+
+	frame := NewModalFrame(form, ModalWidth, FormModalHeight)
+	base.pages.AddPage(modalPage, frame, false, true)
+	base.tviewApp.SetFocus(frame)
+
+	// Tell the app to force redraw the screen
+	base.RedrawChan <- true
+}
+
+// ShowConfirm displays a yes/no dialog with the given text and calls onConfirm if yes
+// is chosen
+func (base *Base) ShowConfirm(text string, onConfirm func()) {
+	if base.pages == nil {
+		return
+	}
+
+	modal := tview.NewModal()
+	modal.SetText(text)
+	modal.AddButtons([]string{"Yes", "No"})
+	modal.SetDoneFunc(func(_ int, label string) {
+		base.pages.RemovePage(confirmPage)
+		base.tviewApp.SetFocus(base.view)
+
+		if label == "Yes" {
+			onConfirm()
+		}
+	})
+
+	base.pages.AddPage(confirmPage, modal, false, true)
+	base.tviewApp.SetFocus(modal)
+
+	// Tell the app to force redraw the screen
+	base.RedrawChan <- true
+}
@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+func Test_NewModalFrame(t *testing.T) {
+	frame := NewModalFrame(tview.NewTextView(), ModalWidth, FormModalHeight)
+
+	x, y, width, height := frame.GetRect()
+	assert.Equal(t, offscreen, x)
+	assert.Equal(t, offscreen, y)
+	assert.Equal(t, ModalWidth, width)
+	assert.Equal(t, FormModalHeight, height)
+}
+
+func Test_ShowInputForm(t *testing.T) {
+	pages := tview.NewPages()
+	base := NewBase(tview.NewApplication(), make(chan bool, 1), pages, &cfg.Common{})
+	base.ShowInputForm("Name:", func(string) {})
+
+	assert.True(t, pages.HasPage(modalPage))
+	assert.True(t, <-base.RedrawChan)
+}